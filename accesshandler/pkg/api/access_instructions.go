@@ -1,7 +1,10 @@
 package api
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
@@ -28,7 +31,33 @@ func (a *API) GetAccessInstructions(w http.ResponseWriter, r *http.Request, prov
 		return
 	}
 
-	instructions, err := i.Instructions(ctx, params.Subject, []byte(params.Args), params.GrantId)
+	// the grant is loaded from the runtime rather than trusting the query parameters,
+	// as providers may issue credentials which are scoped to the subject, arguments and timing of the grant.
+	grant, err := a.runtime.GetGrant(ctx, params.GrantId)
+	if err == types.ErrGrantNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	if grant.Provider != providerId || !strings.EqualFold(string(grant.Subject), params.Subject) {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("the grant does not match the provider and subject"), http.StatusNotFound))
+		return
+	}
+	args, err := json.Marshal(grant.With)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	ctx = providers.WithGrant(ctx, *grant)
+	instructions, err := i.Instructions(ctx, string(grant.Subject), args, grant.ID)
+	if errors.Is(err, providers.ErrGrantNotActive) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
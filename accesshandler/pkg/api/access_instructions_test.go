@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/config"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
+)
+
+// grantRuntime is a runtime which returns a fixed grant.
+type grantRuntime struct {
+	Runtime
+	grant types.Grant
+}
+
+func (r *grantRuntime) GetGrant(ctx context.Context, grantID string) (*types.Grant, error) {
+	if grantID != r.grant.ID {
+		return nil, types.ErrGrantNotFound
+	}
+	g := r.grant
+	return &g, nil
+}
+
+// instructionsProvider records the grant and arguments it was called with.
+type instructionsProvider struct {
+	grant types.Grant
+	args  string
+}
+
+func (p *instructionsProvider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	return nil
+}
+
+func (p *instructionsProvider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	return nil
+}
+
+func (p *instructionsProvider) Instructions(ctx context.Context, subject string, args []byte, grantID string) (string, error) {
+	g, _ := providers.GrantFromContext(ctx)
+	p.grant = g
+	p.args = string(args)
+	if g.Status != types.GrantStatusACTIVE {
+		return "", providers.ErrGrantNotActive
+	}
+	return "instructions", nil
+}
+
+func TestGetAccessInstructions(t *testing.T) {
+	type testcase struct {
+		name     string
+		url      string
+		status   types.GrantStatus
+		wantCode int
+	}
+
+	grant := types.Grant{
+		ID:       "gra_1",
+		Provider: "test",
+		Subject:  "alice@example.com",
+		Status:   types.GrantStatusACTIVE,
+		Start:    iso8601.New(time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)),
+		End:      iso8601.New(time.Date(2022, 1, 1, 11, 0, 0, 0, time.UTC)),
+		With:     types.Grant_With{AdditionalProperties: map[string]string{"role": "reader"}},
+	}
+
+	testcases := []testcase{
+		{name: "ok", url: `/api/v1/providers/test/access-instructions?subject=alice@example.com&grantId=gra_1&args={"role":"admin"}`, status: types.GrantStatusACTIVE, wantCode: http.StatusOK},
+		{name: "grant not found", url: `/api/v1/providers/test/access-instructions?subject=alice@example.com&grantId=gra_2&args={}`, status: types.GrantStatusACTIVE, wantCode: http.StatusNotFound},
+		{name: "different subject", url: `/api/v1/providers/test/access-instructions?subject=bob@example.com&grantId=gra_1&args={}`, status: types.GrantStatusACTIVE, wantCode: http.StatusNotFound},
+		{name: "grant not active", url: `/api/v1/providers/test/access-instructions?subject=alice@example.com&grantId=gra_1&args={}`, status: types.GrantStatusREVOKED, wantCode: http.StatusBadRequest},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			prov := &instructionsProvider{}
+			config.ConfigureTestProviders([]config.Provider{{ID: "test", Type: "test", Provider: prov}})
+
+			g := grant
+			g.Status = tc.status
+			handler := newTestServer(t, withRuntime(&grantRuntime{grant: g}))
+
+			req, err := http.NewRequest("GET", tc.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tc.wantCode, rr.Code, rr.Body.String())
+
+			if tc.wantCode == http.StatusOK {
+				var res types.AccessInstructions
+				err = json.Unmarshal(rr.Body.Bytes(), &res)
+				assert.NoError(t, err)
+				assert.Equal(t, "instructions", *res.Instructions)
+				// the grant and its arguments come from the runtime, not the query string.
+				assert.Equal(t, g, prov.grant)
+				assert.Equal(t, `{"role":"reader"}`, prov.args)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/common-fate/apikit/apio"
@@ -14,8 +15,31 @@ import (
 
 // List Grants
 // (GET /api/v1/grants)
-func (a *API) GetGrants(w http.ResponseWriter, r *http.Request) {
+func (a *API) GetGrants(w http.ResponseWriter, r *http.Request, params types.GetGrantsParams) {
+	ctx := r.Context()
+
+	opts := types.ListGrantsOptsFromParams(params)
+	if opts.Start != nil && opts.End != nil && !opts.Start.Before(*opts.End) {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("start must be earlier than end"), http.StatusBadRequest))
+		return
+	}
+	if opts.Limit < 1 || opts.Limit > types.MaxListGrantsLimit {
+		apio.Error(ctx, w, apio.NewRequestError(fmt.Errorf("limit must be between 1 and %d", types.MaxListGrantsLimit), http.StatusBadRequest))
+		return
+	}
 
+	grants, next, err := a.runtime.ListGrants(ctx, opts)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	res := types.ListGrantsResponse{
+		Grants: grants,
+		Next:   next,
+	}
+
+	apio.JSON(ctx, w, res, http.StatusOK)
 }
 
 // Create Grant
@@ -50,6 +74,10 @@ func (a *API) PostGrants(w http.ResponseWriter, r *http.Request) {
 	}
 
 	grant, err := a.runtime.CreateGrant(ctx, *g)
+	if err == types.ErrGrantAlreadyExists {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusConflict))
+		return
+	}
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
@@ -75,7 +103,49 @@ func (a *API) PostGrantsRevoke(w http.ResponseWriter, r *http.Request, grantId s
 	}
 
 	g, err := a.runtime.RevokeGrant(ctx, grantId, b.RevokerId)
+	if err == types.ErrGrantNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
 
+	res := types.GrantResponse{
+		Grant: *g,
+	}
+
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Extend grant
+// (POST /api/v1/grants/{grantId}/extend)
+func (a *API) PostGrantsExtend(w http.ResponseWriter, r *http.Request, grantId string) {
+	ctx := r.Context()
+	var b types.PostGrantsExtendJSONRequestBody
+
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	if !b.End.After(a.Clock.Now()) {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("end must be in the future"), http.StatusBadRequest))
+		return
+	}
+
+	g, err := a.runtime.ExtendGrant(ctx, grantId, b.End.Time)
+	if err == types.ErrGrantNotFound {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	}
+	var terr types.ErrInvalidGrantTime
+	if err == types.ErrGrantNotExtendable || errors.As(err, &terr) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
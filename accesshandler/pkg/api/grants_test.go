@@ -14,6 +14,7 @@ import (
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/config"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers/okta"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers/testvault"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/iso8601"
 
 	"github.com/stretchr/testify/assert"
@@ -135,6 +136,66 @@ func TestRevokeGrant(t *testing.T) {
 	}
 }
 
+func TestExtendGrant(t *testing.T) {
+	type testcase struct {
+		name       string
+		grantID    string
+		extendBody string
+		wantCode   int
+		wantErr    string
+	}
+
+	// the local runtime uses the real time to run grants, so the grant is created in the future to keep it pending.
+	now := time.Now().Truncate(time.Second)
+	clk := clock.NewMock()
+	clk.Set(now)
+
+	start := iso8601.New(now.Add(time.Hour))
+	end := iso8601.New(now.Add(2 * time.Hour))
+	createBody := fmt.Sprintf(`{"id":"abcd","subject":"chris@commonfate.io","provider":"okta","with":{"group":"Admins"},"start":"%s","end":"%s"}`, start, end)
+
+	testcases := []testcase{
+		{name: "ok", grantID: "abcd", extendBody: fmt.Sprintf(`{"end":"%s"}`, iso8601.New(now.Add(3*time.Hour))), wantCode: http.StatusOK},
+		{name: "end before current end", grantID: "abcd", extendBody: fmt.Sprintf(`{"end":"%s"}`, iso8601.New(now.Add(90*time.Minute))), wantCode: http.StatusBadRequest, wantErr: "grant end time can only be moved later"},
+		{name: "end in the past", grantID: "abcd", extendBody: fmt.Sprintf(`{"end":"%s"}`, iso8601.New(now.Add(-time.Hour))), wantCode: http.StatusBadRequest, wantErr: "end must be in the future"},
+		{name: "grant not found", grantID: "other", extendBody: fmt.Sprintf(`{"end":"%s"}`, iso8601.New(now.Add(3*time.Hour))), wantCode: http.StatusNotFound, wantErr: "grant not found"},
+	}
+	config.ConfigureTestProviders([]config.Provider{
+		{
+			ID:       "okta",
+			Type:     "okta",
+			Provider: &okta.Provider{},
+		},
+	})
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newTestServer(t, withClock(clk))
+
+			req, err := http.NewRequest("POST", "/api/v1/grants", strings.NewReader(createBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, http.StatusCreated, rr.Code)
+
+			req, err = http.NewRequest("POST", "/api/v1/grants/"+tc.grantID+"/extend", strings.NewReader(tc.extendBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr = httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			var apiErr apio.ErrorResponse
+			_ = json.NewDecoder(rr.Body).Decode(&apiErr)
+			assert.Equal(t, tc.wantErr, apiErr.Error)
+		})
+	}
+}
+
 func TestValidateGrant(t *testing.T) {
 	type testcase struct {
 		name     string
@@ -189,3 +250,92 @@ func TestValidateGrant(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGrants(t *testing.T) {
+	type testcase struct {
+		name      string
+		query     string
+		wantCode  int
+		wantCount int
+		wantNext  bool
+		wantErr   string
+	}
+
+	TenAM := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	clk := clock.NewMock()
+	clk.Set(TenAM)
+
+	// grants are created far in the future so that the local runtime doesn't activate them during the test.
+	start := time.Now().Add(time.Hour)
+	startISO := iso8601.New(start)
+	endISO := iso8601.New(start.Add(time.Hour))
+
+	bodies := []string{
+		fmt.Sprintf(`{"id":"a","subject":"chris@commonfate.io","provider":"okta","with":{"group":"Admins"},"start":"%s","end":"%s"}`, startISO, endISO),
+		fmt.Sprintf(`{"id":"b","subject":"josh@commonfate.io","provider":"okta","with":{"group":"Admins"},"start":"%s","end":"%s"}`, startISO, endISO),
+	}
+
+	testcases := []testcase{
+		{name: "ok", query: "", wantCode: http.StatusOK, wantCount: 2},
+		{name: "filter by subject", query: "?subject=chris@commonfate.io", wantCode: http.StatusOK, wantCount: 1},
+		{name: "filter by provider", query: "?provider=other", wantCode: http.StatusOK, wantCount: 0},
+		{name: "filter by status", query: "?status=PENDING", wantCode: http.StatusOK, wantCount: 2},
+		{name: "paginated", query: "?limit=1", wantCode: http.StatusOK, wantCount: 1, wantNext: true},
+		{name: "invalid status", query: "?status=INVALID", wantCode: http.StatusBadRequest, wantErr: `parameter "status" in query has an error: value is not one of the allowed values`},
+		{name: "limit too large", query: "?limit=1001", wantCode: http.StatusBadRequest, wantErr: `parameter "limit" in query has an error: number must be at most 100`},
+		{name: "limit too small", query: "?limit=0", wantCode: http.StatusBadRequest, wantErr: `parameter "limit" in query has an error: number must be at least 1`},
+		{name: "start after end", query: "?start=2022-01-02T00:00:00Z&end=2022-01-01T00:00:00Z", wantCode: http.StatusBadRequest, wantErr: "start must be earlier than end"},
+	}
+
+	config.ConfigureTestProviders([]config.Provider{
+		{
+			ID:       "okta",
+			Type:     "okta",
+			Provider: &okta.Provider{},
+		},
+	})
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newTestServer(t, withClock(clk))
+
+			for _, b := range bodies {
+				req, err := http.NewRequest("POST", "/api/v1/grants", strings.NewReader(b))
+				if err != nil {
+					t.Fatal(err)
+				}
+				req.Header.Add("Content-Type", "application/json")
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, req)
+				if rr.Code != http.StatusCreated {
+					t.Fatalf("failed to create grant: %s", rr.Body.String())
+				}
+			}
+
+			req, err := http.NewRequest("GET", "/api/v1/grants"+tc.query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			if tc.wantErr != "" {
+				var apiErr apio.ErrorResponse
+				_ = json.NewDecoder(rr.Body).Decode(&apiErr)
+				assert.Equal(t, tc.wantErr, apiErr.Error)
+				return
+			}
+
+			var res types.ListGrantsResponse
+			err = json.NewDecoder(rr.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Len(t, res.Grants, tc.wantCount)
+			assert.Equal(t, tc.wantNext, res.Next != nil)
+		})
+	}
+}
@@ -3,16 +3,19 @@ package api
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/runtime/lambda"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/runtime/local"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/runtime/selfhosted"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 )
 
 // A runtime is responsible for the actual execution of a grant and are tied to the
 // hosting environment the Access Handler is running in.
 //
-// Example runtimes are local (for testing only), and AWS Lambda with Step Functions.
+// Example runtimes are local (for testing only), self-hosted with an embedded database,
+// and AWS Lambda with Step Functions.
 type Runtime interface {
 	// Init contains any runtime-specific initialisation logic.
 	Init(ctx context.Context) error
@@ -25,13 +28,25 @@ type Runtime interface {
 	// initiating an AWS Step Functions workflow.
 	// Revokes a grant and terminates the previous create grant workflow
 	RevokeGrant(ctx context.Context, grantID string, revoker string) (*types.Grant, error)
+
+	// ExtendGrant moves the end time of a pending or active grant later, rescheduling
+	// the deactivation of the grant without reprovisioning access.
+	ExtendGrant(ctx context.Context, grantID string, end time.Time) (*types.Grant, error)
+
+	// ListGrants returns a page of grants matching the provided filters, along with
+	// a token to fetch the next page. The token is nil if there are no more results.
+	ListGrants(ctx context.Context, opts types.ListGrantsOpts) ([]types.Grant, *string, error)
+
+	// GetGrant returns the current state of a grant, or types.ErrGrantNotFound if it doesn't exist.
+	GetGrant(ctx context.Context, grantID string) (*types.Grant, error)
 }
 
 // runtimes is a map of the supported runtime environments
 // for the API.
 var runtimes = map[string]Runtime{
-	"local":  &local.Runtime{},
-	"lambda": &lambda.Runtime{},
+	"local":      &local.Runtime{},
+	"lambda":     &lambda.Runtime{},
+	"selfhosted": &selfhosted.Runtime{},
 }
 
 // validRuntimes returns a comma-separated list of accepted runtime arguments.
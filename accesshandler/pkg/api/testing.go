@@ -63,3 +63,10 @@ func withClock(c clock.Clock) func(*API) {
 		a.Clock = c
 	}
 }
+
+// withRuntime allows a runtime to be injected to the test API.
+func withRuntime(rt Runtime) func(*API) {
+	return func(a *API) {
+		a.runtime = rt
+	}
+}
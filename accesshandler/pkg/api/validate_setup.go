@@ -1,6 +1,7 @@
 package api
 
 import (
+	"io"
 	"net/http"
 	"sync"
 
@@ -36,6 +37,10 @@ func (a *API) ValidateSetup(w http.ResponseWriter, r *http.Request) {
 	}
 
 	p := rp.Provider
+	// plugin providers run in a separate process which we stop once validation is complete.
+	if c, ok := p.(io.Closer); ok {
+		defer c.Close()
+	}
 	cv, ok := p.(providers.ConfigValidator)
 	if !ok {
 		// show a success message, but note that validation has been skipped because the provider doesn't support it.
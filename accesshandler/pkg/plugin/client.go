@@ -0,0 +1,499 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/diagnostics"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// DefaultDir is the directory plugin binaries are looked up in
+	// if GRANTED_PLUGIN_DIR is not set.
+	DefaultDir = "/opt/granted/plugins"
+
+	// handshakeTimeout is how long we wait for a plugin to report its address after launching it.
+	handshakeTimeout = 10 * time.Second
+
+	// launchRetryInterval is how long to wait after launching a plugin fails before trying to launch it again.
+	launchRetryInterval = 30 * time.Second
+)
+
+// Dir returns the directory which plugin binaries are loaded from.
+func Dir() string {
+	if d := os.Getenv("GRANTED_PLUGIN_DIR"); d != "" {
+		return d
+	}
+	return DefaultDir
+}
+
+var (
+	// nameRegex matches valid plugin names.
+	nameRegex = regexp.MustCompile(`^[a-z0-9-]+$`)
+	// versionRegex matches valid plugin versions, which are semantic versions with an optional "v" prefix, like "v1" or "1.2.3-beta.1".
+	versionRegex = regexp.MustCompile(`^v?[0-9]+(\.[0-9]+){0,2}(-[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`)
+)
+
+// BinaryPath returns the path to the binary for a plugin.
+// The name and version are checked so that the path can't be outside of the plugin directory.
+func BinaryPath(name, version string) (string, error) {
+	if !nameRegex.MatchString(name) {
+		return "", fmt.Errorf("invalid plugin name %q: plugin names may only contain lowercase letters, numbers and dashes", name)
+	}
+	if !versionRegex.MatchString(version) {
+		return "", fmt.Errorf("invalid plugin version %q: plugin versions must be semantic versions, like v1 or 1.2.3", version)
+	}
+	return filepath.Join(Dir(), fmt.Sprintf("granted-provider-%s_%s", name, version)), nil
+}
+
+// Client is an Access Provider which forwards calls to a plugin process.
+//
+// The plugin process is launched lazily the first time the Client is used,
+// so that looking up a plugin in the registry doesn't start a process.
+// If the plugin process exits, it is launched again and reconfigured the next time the Client is used.
+type Client struct {
+	Name    string
+	Version string
+	// Path to the plugin binary.
+	Path string
+
+	// mu guards the fields below. Calls to the plugin hold a read lock,
+	// so that the connection isn't replaced while it is in use.
+	mu  sync.RWMutex
+	cmd *exec.Cmd
+	// exited is closed once the plugin process has exited.
+	exited chan struct{}
+	conn   *grpc.ClientConn
+	desc   *DescribeResponse
+	cfg    gconfig.Config
+	// configured is true once Init has configured the plugin, so that
+	// the configuration can be sent again if the plugin is relaunched.
+	configured bool
+	err        error
+	// errAt is when launching the plugin last failed.
+	errAt  time.Time
+	closed bool
+}
+
+var (
+	_ providers.Accessor        = &Client{}
+	_ providers.ArgSchemarer    = &Client{}
+	_ providers.ArgOptioner     = &Client{}
+	_ providers.Instructioner   = &Client{}
+	_ providers.GrantValidator  = &Client{}
+	_ providers.ConfigValidator = &Client{}
+	_ gconfig.Configer          = &Client{}
+	_ gconfig.Initer            = &Client{}
+	_ io.Closer                 = &Client{}
+)
+
+// NewClient returns a Client for the plugin with the given name and version.
+// It returns an error if the name or version is invalid.
+func NewClient(name, version string) (*Client, error) {
+	path, err := BinaryPath(name, version)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		Name:    name,
+		Version: version,
+		Path:    path,
+	}, nil
+}
+
+// start launches the plugin if it isn't running yet, or if the plugin process has exited.
+// If launching the plugin fails, the error is stored and returned on subsequent calls
+// until launchRetryInterval has passed, after which launching the plugin is tried again.
+func (c *Client) start(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return fmt.Errorf("plugin %s@%s has been closed", c.Name, c.Version)
+	}
+	if c.conn != nil {
+		select {
+		case <-c.exited:
+			zap.S().Warnw("plugin exited, relaunching it", "plugin", c.Name, "version", c.Version)
+			c.stop()
+		default:
+			return nil
+		}
+	}
+	if c.err != nil && time.Since(c.errAt) < launchRetryInterval {
+		return c.err
+	}
+
+	c.err = c.launch(ctx)
+	if c.err == nil && c.configured {
+		c.err = invoke(ctx, c.conn, "Configure", &ConfigureRequest{Values: c.configValues()}, &Empty{})
+		if c.err != nil {
+			c.stop()
+		}
+	}
+	if c.err != nil {
+		c.errAt = time.Now()
+	}
+	return c.err
+}
+
+// launch runs the plugin binary and connects to it using the address from the handshake.
+// If the plugin can't be connected to, the process is stopped.
+func (c *Client) launch(ctx context.Context) error {
+	log := zap.S().With("plugin", c.Name, "version", c.Version)
+
+	cmd := exec.Command(c.Path)
+	cmd.Env = append(os.Environ(), MagicCookieKey+"="+MagicCookieValue)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+	err = cmd.Start()
+	if err != nil {
+		return fmt.Errorf("launching plugin %s: %w", c.Path, err)
+	}
+
+	// the output of the plugin must be read before waiting for the process to exit.
+	var output sync.WaitGroup
+	output.Add(2)
+	go func() {
+		defer output.Done()
+		s := bufio.NewScanner(stderr)
+		for s.Scan() {
+			log.Infow("plugin output", "msg", s.Text())
+		}
+	}()
+
+	lines := make(chan string, 1)
+	go func() {
+		defer output.Done()
+		s := bufio.NewScanner(stdout)
+		if s.Scan() {
+			lines <- s.Text()
+		}
+		close(lines)
+		// anything the plugin writes to stdout after the handshake is forwarded to our logs.
+		for s.Scan() {
+			log.Infow("plugin output", "msg", s.Text())
+		}
+	}()
+
+	exited := make(chan struct{})
+	go func() {
+		output.Wait()
+		err := cmd.Wait()
+		log.Infow("plugin process exited", "error", err)
+		close(exited)
+	}()
+	kill := func() {
+		_ = cmd.Process.Kill()
+		<-exited
+	}
+
+	var line string
+	select {
+	case l, ok := <-lines:
+		if !ok {
+			<-exited
+			return fmt.Errorf("plugin %s exited before completing the handshake", c.Path)
+		}
+		line = l
+	case <-time.After(handshakeTimeout):
+		kill()
+		return fmt.Errorf("timed out waiting for plugin %s to complete the handshake", c.Path)
+	}
+
+	network, addr, err := parseHandshake(line)
+	if err != nil {
+		kill()
+		return fmt.Errorf("plugin %s: %w", c.Path, err)
+	}
+
+	err = c.connect(ctx, network, addr)
+	if err != nil {
+		if c.conn != nil {
+			_ = c.conn.Close()
+			c.conn = nil
+		}
+		kill()
+		return err
+	}
+	c.cmd = cmd
+	c.exited = exited
+	return nil
+}
+
+// stop closes the connection to the plugin and stops the plugin process.
+// c.mu must be held by the caller.
+func (c *Client) stop() {
+	if c.conn != nil {
+		_ = c.conn.Close()
+		c.conn = nil
+	}
+	if c.cmd != nil && c.cmd.Process != nil {
+		_ = c.cmd.Process.Kill()
+		<-c.exited
+		c.cmd = nil
+		c.exited = nil
+	}
+}
+
+// parseHandshake parses a handshake line written by a plugin, in the format
+//
+//	<protocol version>|<network>|<address>|grpc
+func parseHandshake(line string) (network string, addr string, err error) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 4 {
+		return "", "", fmt.Errorf("invalid handshake %q", line)
+	}
+	v, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return "", "", fmt.Errorf("invalid protocol version in handshake %q", line)
+	}
+	if v != ProtocolVersion {
+		return "", "", fmt.Errorf("plugin uses protocol version %d but the Access Handler supports version %d", v, ProtocolVersion)
+	}
+	if parts[3] != "grpc" {
+		return "", "", fmt.Errorf("unsupported plugin protocol %s", parts[3])
+	}
+	return parts[1], parts[2], nil
+}
+
+// connect dials the plugin and describes it.
+// If the plugin has been described before, the existing config is kept, as it may have been loaded already.
+func (c *Client) connect(ctx context.Context, network, addr string) error {
+	conn, err := grpc.Dial(network+"://"+addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(jsonCodec{})),
+	)
+	if err != nil {
+		return err
+	}
+	c.conn = conn
+
+	var desc DescribeResponse
+	err = invoke(ctx, conn, "Describe", &DescribeRequest{}, &desc)
+	if err != nil {
+		return fmt.Errorf("describing plugin: %w", err)
+	}
+	if c.desc != nil {
+		return nil
+	}
+	c.desc = &desc
+
+	for _, f := range desc.Config {
+		var opts []gconfig.FieldOptFunc
+		if f.Default != "" {
+			def := f.Default
+			opts = append(opts, gconfig.WithDefaultFunc(func() string { return def }))
+		}
+		switch {
+		case f.Secret:
+			c.cfg = append(c.cfg, gconfig.SecretStringField(f.Key, &gconfig.SecretStringValue{}, f.Description, gconfig.WithArgs("/granted/providers/%s/"+f.Key, 1), opts...))
+		case f.Optional:
+			c.cfg = append(c.cfg, gconfig.OptionalStringField(f.Key, &gconfig.OptionalStringValue{}, f.Description, opts...))
+		default:
+			c.cfg = append(c.cfg, gconfig.StringField(f.Key, &gconfig.StringValue{}, f.Description, opts...))
+		}
+	}
+	return nil
+}
+
+// invoke calls a method of the plugin.
+func (c *Client) invoke(ctx context.Context, method string, req interface{}, res interface{}) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.conn == nil {
+		return fmt.Errorf("plugin %s@%s is not running", c.Name, c.Version)
+	}
+	return invoke(ctx, c.conn, method, req, res)
+}
+
+func invoke(ctx context.Context, conn *grpc.ClientConn, method string, req interface{}, res interface{}) error {
+	err := conn.Invoke(ctx, "/"+serviceName+"/"+method, req, res)
+	if err != nil {
+		return fromStatus(err)
+	}
+	return nil
+}
+
+// Close stops the plugin process.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	c.stop()
+	return nil
+}
+
+// Config launches the plugin and returns its configuration variables.
+// If the plugin can't be launched, an empty config is returned and the
+// error is surfaced when Init is called.
+func (c *Client) Config() gconfig.Config {
+	if err := c.start(context.Background()); err != nil {
+		return gconfig.Config{}
+	}
+	return c.cfg
+}
+
+// Init sends the loaded configuration to the plugin, which initialises itself.
+func (c *Client) Init(ctx context.Context) error {
+	if err := c.start(ctx); err != nil {
+		return err
+	}
+	err := c.invoke(ctx, "Configure", &ConfigureRequest{Values: c.configValues()}, &Empty{})
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.configured = true
+	c.mu.Unlock()
+	return nil
+}
+
+// configValues returns the loaded values of the config variables of the plugin.
+func (c *Client) configValues() map[string]string {
+	values := make(map[string]string)
+	for _, f := range c.cfg {
+		v := f.Get()
+		if f.IsOptional() && v == "" {
+			continue
+		}
+		values[f.Key()] = v
+	}
+	return values
+}
+
+// newAccessRequest builds a request to send to the plugin, including the grant from the context if it is set.
+func newAccessRequest(ctx context.Context, subject string, args []byte, grantID string) *AccessRequest {
+	req := AccessRequest{Subject: subject, Args: args, GrantID: grantID}
+	if g, ok := providers.GrantFromContext(ctx); ok {
+		req.Grant = &g
+	}
+	return &req
+}
+
+func (c *Client) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	if err := c.start(ctx); err != nil {
+		return err
+	}
+	return c.invoke(ctx, "Grant", newAccessRequest(ctx, subject, args, grantID), &Empty{})
+}
+
+func (c *Client) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	if err := c.start(ctx); err != nil {
+		return err
+	}
+	return c.invoke(ctx, "Revoke", newAccessRequest(ctx, subject, args, grantID), &Empty{})
+}
+
+func (c *Client) ArgSchema() providers.ArgSchema {
+	if err := c.start(context.Background()); err != nil {
+		return providers.ArgSchema{}
+	}
+	if c.desc.ArgSchema == nil {
+		return providers.ArgSchema{}
+	}
+	return c.desc.ArgSchema
+}
+
+func (c *Client) Options(ctx context.Context, arg string) (*types.ArgOptionsResponse, error) {
+	if err := c.start(ctx); err != nil {
+		return nil, err
+	}
+	var res types.ArgOptionsResponse
+	err := c.invoke(ctx, "Options", &OptionsRequest{Arg: arg}, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Instructions returns an empty string if the plugin doesn't provide instructions.
+func (c *Client) Instructions(ctx context.Context, subject string, args []byte, grantID string) (string, error) {
+	if err := c.start(ctx); err != nil {
+		return "", err
+	}
+	if !c.desc.Capabilities.Instructions {
+		return "", nil
+	}
+	var res InstructionsResponse
+	err := c.invoke(ctx, "Instructions", newAccessRequest(ctx, subject, args, grantID), &res)
+	if err != nil {
+		return "", err
+	}
+	return res.Instructions, nil
+}
+
+func (c *Client) ValidateGrant() providers.GrantValidationSteps {
+	steps := providers.GrantValidationSteps{}
+	if err := c.start(context.Background()); err != nil {
+		return steps
+	}
+	for _, v := range c.desc.GrantValidations {
+		id := v.ID
+		steps[id] = providers.GrantValidationStep{
+			UserErrorMessage: v.UserErrorMessage,
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				var res LogsResponse
+				err := c.invoke(ctx, "ValidateGrant", &ValidateGrantRequest{Step: id, Subject: subject, Args: args}, &res)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return res.Logs
+			},
+		}
+	}
+	return steps
+}
+
+// ValidateConfig returns the config validation steps of the plugin.
+// If the plugin can't be launched, a single step reporting the error is returned.
+func (c *Client) ValidateConfig() map[string]providers.ConfigValidationStep {
+	if err := c.start(context.Background()); err != nil {
+		return map[string]providers.ConfigValidationStep{
+			"launch-plugin": {
+				Name: "Launch the plugin",
+				Run: func(ctx context.Context) diagnostics.Logs {
+					return diagnostics.Error(err)
+				},
+			},
+		}
+	}
+	steps := make(map[string]providers.ConfigValidationStep)
+	for _, v := range c.desc.ConfigValidations {
+		id := v.ID
+		steps[id] = providers.ConfigValidationStep{
+			Name:            v.Name,
+			FieldsValidated: v.FieldsValidated,
+			Run: func(ctx context.Context) diagnostics.Logs {
+				var res LogsResponse
+				err := c.invoke(ctx, "ValidateConfig", &ValidateConfigRequest{Step: id}, &res)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return res.Logs
+			},
+		}
+	}
+	return steps
+}
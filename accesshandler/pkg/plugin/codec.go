@@ -0,0 +1,22 @@
+package plugin
+
+import (
+	"encoding/json"
+)
+
+// jsonCodec marshals plugin messages as JSON rather than protobuf.
+// The messages exchanged with plugins reuse our existing Go and OpenAPI types,
+// so encoding them as JSON avoids maintaining a separate set of .proto definitions.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
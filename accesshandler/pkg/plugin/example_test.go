@@ -0,0 +1,36 @@
+package plugin_test
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/plugin"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+)
+
+// Provider is an Access Provider implemented by a plugin author.
+type Provider struct {
+	apiURL gconfig.StringValue
+}
+
+func (p *Provider) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("apiUrl", &p.apiURL, "the URL of the internal API"),
+	}
+}
+
+func (p *Provider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	// call the internal API to provision access here.
+	return nil
+}
+
+func (p *Provider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	// call the internal API to remove access here.
+	return nil
+}
+
+// A plugin binary calls Serve from its main function.
+// Build the binary as 'granted-provider-<name>_<version>', place it in the plugin directory,
+// and reference it in the provider config with 'uses: plugin/<name>@<version>'.
+func ExampleServe() {
+	plugin.Serve(&Provider{})
+}
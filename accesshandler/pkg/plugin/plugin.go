@@ -0,0 +1,94 @@
+// Package plugin allows Access Providers to run out-of-process as plugins.
+//
+// Plugins are standalone binaries which serve the provider interfaces
+// (Accessor, ArgSchemarer, ArgOptioner, Instructioner, GrantValidator and ConfigValidator)
+// over gRPC on a local unix socket. The Access Handler launches the plugin binary,
+// reads a handshake line from its stdout, and then talks to it over gRPC.
+//
+// Plugin authors implement a provider in the same way as a built-in provider
+// and call Serve from their main function:
+//
+//	func main() {
+//		plugin.Serve(&myprovider.Provider{})
+//	}
+//
+// Plugins are referenced in the provider config with a 'uses' field like "plugin/<name>@<version>".
+// The binary is looked up in GRANTED_PLUGIN_DIR as "granted-provider-<name>_<version>".
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ProtocolVersion is the version of the plugin protocol.
+	// It is incremented when a breaking change is made to the service.
+	ProtocolVersion = 1
+
+	// MagicCookieKey and MagicCookieValue are set in the environment of a plugin
+	// when it is launched by the Access Handler. They aren't a security measure,
+	// they just let a plugin binary show a helpful message when it's run directly.
+	MagicCookieKey   = "GRANTED_PLUGIN_MAGIC_COOKIE"
+	MagicCookieValue = "d5d0b6bf8bf9a4b2c1bc6b3f1d0f5f7e"
+)
+
+// ErrUnimplemented is returned when a plugin doesn't implement an optional provider interface.
+var ErrUnimplemented = errors.New("plugin does not implement this method")
+
+// Serve the provider as a plugin. Serve should be called from the main function
+// of the plugin binary and blocks until the plugin is shut down by the host.
+func Serve(p providers.Accessor) {
+	err := serve(p)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func serve(p providers.Accessor) error {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return errors.New("this binary is a Granted Access Provider plugin and is not meant to be executed directly. It is launched by the Granted Access Handler")
+	}
+
+	dir, err := os.MkdirTemp("", "granted-plugin")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "plugin.sock")
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+
+	srv := newServer(p)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		srv.GracefulStop()
+	}()
+
+	// the handshake line tells the host how to connect to us.
+	fmt.Printf("%d|unix|%s|grpc\n", ProtocolVersion, socket)
+
+	return srv.Serve(lis)
+}
+
+// newServer returns a gRPC server with the provider service registered.
+func newServer(p providers.Accessor) *grpc.Server {
+	srv := grpc.NewServer(grpc.ForceServerCodec(jsonCodec{}))
+	srv.RegisterService(&serviceDesc, &server{p: p})
+	return srv
+}
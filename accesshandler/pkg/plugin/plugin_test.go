@@ -0,0 +1,358 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/diagnostics"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProvider is a provider used to test the plugin protocol.
+type fakeProvider struct {
+	greeting gconfig.StringValue
+	token    gconfig.SecretStringValue
+
+	mu      sync.Mutex
+	granted map[string]bool
+}
+
+func (p *fakeProvider) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("greeting", &p.greeting, "the greeting"),
+		gconfig.SecretStringField("token", &p.token, "the token", gconfig.WithArgs("/granted/providers/%s/token", 1)),
+	}
+}
+
+func (p *fakeProvider) Init(ctx context.Context) error {
+	if p.token.Get() == "" {
+		return errors.New("token is required")
+	}
+	p.granted = make(map[string]bool)
+	return nil
+}
+
+func (p *fakeProvider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	if string(args) != `{"vault":"test"}` {
+		return &providers.InvalidArgumentError{Arg: "vault"}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.granted[subject] = true
+	return nil
+}
+
+func (p *fakeProvider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.granted[subject] {
+		return fmt.Errorf("%s has no access", subject)
+	}
+	delete(p.granted, subject)
+	return nil
+}
+
+func (p *fakeProvider) ArgSchema() providers.ArgSchema {
+	return providers.ArgSchema{
+		"vault": {Id: "vault", Title: "Vault", FormElement: types.INPUT},
+	}
+}
+
+func (p *fakeProvider) Options(ctx context.Context, arg string) (*types.ArgOptionsResponse, error) {
+	if arg != "vault" {
+		return nil, &providers.InvalidArgumentError{Arg: arg}
+	}
+	return &types.ArgOptionsResponse{Options: []types.Option{{Label: "Test", Value: "test"}}}, nil
+}
+
+func (p *fakeProvider) Instructions(ctx context.Context, subject string, args []byte, grantID string) (string, error) {
+	return fmt.Sprintf("%s, %s", p.greeting.Get(), subject), nil
+}
+
+func (p *fakeProvider) ValidateGrant() providers.GrantValidationSteps {
+	return providers.GrantValidationSteps{
+		"user-exists": {
+			UserErrorMessage: "user does not exist",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				if subject == "nobody@example.com" {
+					return diagnostics.Error(errors.New("user not found"))
+				}
+				return diagnostics.Info("found user %s", subject)
+			},
+		},
+	}
+}
+
+func (p *fakeProvider) ValidateConfig() map[string]providers.ConfigValidationStep {
+	return map[string]providers.ConfigValidationStep{
+		"check-token": {
+			Name:            "Check the token",
+			FieldsValidated: []string{"token"},
+			Run: func(ctx context.Context) diagnostics.Logs {
+				return diagnostics.Info("token is %d characters long", len(p.token.Get()))
+			},
+		},
+	}
+}
+
+// minimalProvider only implements Accessor.
+type minimalProvider struct{}
+
+func (p *minimalProvider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	return nil
+}
+
+func (p *minimalProvider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	return nil
+}
+
+// newTestClient serves the provider in-process and returns a client connected to it.
+func newTestClient(t *testing.T, p providers.Accessor) *Client {
+	socket := filepath.Join(t.TempDir(), "plugin.sock")
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newServer(p)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	c := &Client{Name: "test", Version: "v1"}
+	err = c.connect(context.Background(), "unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, &fakeProvider{})
+
+	cfg := c.Config()
+	require.Len(t, cfg, 2)
+	assert.Equal(t, "greeting", cfg[0].Key())
+	assert.False(t, cfg[0].IsSecret())
+	assert.True(t, cfg[1].IsSecret())
+
+	err := cfg.Load(ctx, &gconfig.MapLoader{SkipLoadingSecrets: true, Values: map[string]string{"greeting": "hello", "token": "secret"}})
+	require.NoError(t, err)
+	err = c.Init(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, types.INPUT, c.ArgSchema()["vault"].FormElement)
+
+	err = c.Grant(ctx, "alice@example.com", []byte(`{"vault":"other"}`), "grant1")
+	assert.Equal(t, &providers.InvalidArgumentError{Arg: "vault"}, err)
+
+	err = c.Grant(ctx, "alice@example.com", []byte(`{"vault":"test"}`), "grant1")
+	require.NoError(t, err)
+
+	in, err := c.Instructions(ctx, "alice@example.com", []byte(`{"vault":"test"}`), "grant1")
+	require.NoError(t, err)
+	assert.Equal(t, "hello, alice@example.com", in)
+
+	err = c.Revoke(ctx, "alice@example.com", []byte(`{"vault":"test"}`), "grant1")
+	require.NoError(t, err)
+
+	err = c.Revoke(ctx, "alice@example.com", []byte(`{"vault":"test"}`), "grant1")
+	assert.EqualError(t, err, "alice@example.com has no access")
+
+	opts, err := c.Options(ctx, "vault")
+	require.NoError(t, err)
+	assert.Equal(t, []types.Option{{Label: "Test", Value: "test"}}, opts.Options)
+
+	_, err = c.Options(ctx, "other")
+	assert.Equal(t, &providers.InvalidArgumentError{Arg: "other"}, err)
+
+	results := c.ValidateGrant().Run(ctx, "nobody@example.com", nil)
+	require.Contains(t, results, "user-exists")
+	assert.Equal(t, "user does not exist", results["user-exists"].Name)
+	logs := results["user-exists"].Logs
+	assert.False(t, logs.HasSucceeded())
+
+	steps := c.ValidateConfig()
+	require.Contains(t, steps, "check-token")
+	assert.Equal(t, []string{"token"}, steps["check-token"].FieldsValidated)
+	assert.Equal(t, diagnostics.Info("token is 6 characters long"), steps["check-token"].Run(ctx))
+}
+
+func TestClientInitError(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, &fakeProvider{})
+
+	err := c.Config().Load(ctx, &gconfig.MapLoader{SkipLoadingSecrets: true, Values: map[string]string{"greeting": "hello", "token": ""}})
+	require.NoError(t, err)
+	err = c.Init(ctx)
+	assert.EqualError(t, err, "token is required")
+}
+
+func TestClientMinimalProvider(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, &minimalProvider{})
+
+	assert.Empty(t, c.Config())
+	require.NoError(t, c.Init(ctx))
+	require.NoError(t, c.Grant(ctx, "alice@example.com", nil, "grant1"))
+	assert.Empty(t, c.ArgSchema())
+	assert.Empty(t, c.ValidateGrant())
+	assert.Empty(t, c.ValidateConfig())
+
+	in, err := c.Instructions(ctx, "alice@example.com", nil, "grant1")
+	require.NoError(t, err)
+	assert.Equal(t, "", in)
+
+	_, err = c.Options(ctx, "vault")
+	assert.ErrorIs(t, err, ErrUnimplemented)
+}
+
+func TestParseHandshake(t *testing.T) {
+	type testcase struct {
+		name        string
+		give        string
+		wantNetwork string
+		wantAddr    string
+		wantErr     bool
+	}
+
+	testcases := []testcase{
+		{name: "ok", give: "1|unix|/tmp/plugin.sock|grpc\n", wantNetwork: "unix", wantAddr: "/tmp/plugin.sock"},
+		{name: "wrong protocol version", give: "2|unix|/tmp/plugin.sock|grpc", wantErr: true},
+		{name: "not grpc", give: "1|unix|/tmp/plugin.sock|netrpc", wantErr: true},
+		{name: "garbage", give: "hello world", wantErr: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			network, addr, err := parseHandshake(tc.give)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantNetwork, network)
+			assert.Equal(t, tc.wantAddr, addr)
+		})
+	}
+}
+
+// TestHelperProcess isn't a real test. It's used as the plugin binary in TestLaunch.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GRANTED_PLUGIN_TEST_HELPER") != "1" {
+		return
+	}
+	Serve(&fakeProvider{})
+	os.Exit(0)
+}
+
+// writeHelperPlugin writes a plugin binary to dir which runs this test binary as a helper process.
+func writeHelperPlugin(t *testing.T, dir string, name string) {
+	t.Setenv("GRANTED_PLUGIN_TEST_HELPER", "1")
+	script := fmt.Sprintf("#!/bin/sh\nexec %s -test.run=TestHelperProcess\n", os.Args[0])
+	err := os.WriteFile(filepath.Join(dir, name), []byte(script), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLaunch(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	t.Setenv("GRANTED_PLUGIN_DIR", dir)
+	writeHelperPlugin(t, dir, "granted-provider-test_v1")
+
+	c, err := NewClient("test", "v1")
+	require.NoError(t, err)
+	defer c.Close()
+
+	err = c.Config().Load(ctx, &gconfig.MapLoader{SkipLoadingSecrets: true, Values: map[string]string{"greeting": "hi", "token": "secret"}})
+	require.NoError(t, err)
+	require.NoError(t, c.Init(ctx))
+
+	in, err := c.Instructions(ctx, "alice@example.com", nil, "grant1")
+	require.NoError(t, err)
+	assert.Equal(t, "hi, alice@example.com", in)
+
+	// if the plugin crashes, it is relaunched with the same configuration.
+	c.mu.Lock()
+	_ = c.cmd.Process.Kill()
+	exited := c.exited
+	c.mu.Unlock()
+	<-exited
+
+	in, err = c.Instructions(ctx, "alice@example.com", nil, "grant1")
+	require.NoError(t, err)
+	assert.Equal(t, "hi, alice@example.com", in)
+}
+
+func TestLaunchMissingBinary(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("GRANTED_PLUGIN_DIR", dir)
+
+	c, err := NewClient("missing", "v1")
+	require.NoError(t, err)
+	defer c.Close()
+
+	assert.Empty(t, c.Config())
+	assert.Error(t, c.Init(context.Background()))
+
+	steps := c.ValidateConfig()
+	require.Contains(t, steps, "launch-plugin")
+	logs := steps["launch-plugin"].Run(context.Background())
+	assert.False(t, logs.HasSucceeded())
+
+	// launching the plugin is tried again once the retry interval has passed.
+	writeHelperPlugin(t, dir, "granted-provider-missing_v1")
+	assert.Error(t, c.start(context.Background()))
+	c.mu.Lock()
+	c.errAt = c.errAt.Add(-launchRetryInterval)
+	c.mu.Unlock()
+	assert.NoError(t, c.start(context.Background()))
+}
+
+func TestBinaryPath(t *testing.T) {
+	t.Setenv("GRANTED_PLUGIN_DIR", "/opt/plugins")
+
+	type testcase struct {
+		name    string
+		plugin  string
+		version string
+		want    string
+		wantErr bool
+	}
+
+	testcases := []testcase{
+		{name: "ok", plugin: "my-plugin", version: "v1", want: "/opt/plugins/granted-provider-my-plugin_v1"},
+		{name: "semver", plugin: "my-plugin", version: "1.2.3-beta.1", want: "/opt/plugins/granted-provider-my-plugin_1.2.3-beta.1"},
+		{name: "name with path", plugin: "../../bin/x", version: "v1", wantErr: true},
+		{name: "name with uppercase", plugin: "Plugin", version: "v1", wantErr: true},
+		{name: "empty name", plugin: "", version: "v1", wantErr: true},
+		{name: "version with path", plugin: "my-plugin", version: "1/../../x", wantErr: true},
+		{name: "version which isn't semver", plugin: "my-plugin", version: "latest", wantErr: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := BinaryPath(tc.plugin, tc.version)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
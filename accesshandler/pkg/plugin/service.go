@@ -0,0 +1,316 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/diagnostics"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// serviceName is the fully qualified gRPC service name that plugins serve.
+const serviceName = "granted.plugin.v1.Provider"
+
+// ConfigField describes a configuration variable of a plugin.
+type ConfigField struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+	Secret      bool   `json:"secret"`
+	Optional    bool   `json:"optional"`
+	Default     string `json:"default,omitempty"`
+}
+
+// GrantValidation describes a grant validation step of a plugin.
+type GrantValidation struct {
+	ID               string `json:"id"`
+	UserErrorMessage string `json:"userErrorMessage"`
+}
+
+// ConfigValidation describes a config validation step of a plugin.
+type ConfigValidation struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	FieldsValidated []string `json:"fieldsValidated"`
+}
+
+// Capabilities indicates which of the optional provider interfaces a plugin implements.
+type Capabilities struct {
+	ArgSchema        bool `json:"argSchema"`
+	Options          bool `json:"options"`
+	Instructions     bool `json:"instructions"`
+	GrantValidation  bool `json:"grantValidation"`
+	ConfigValidation bool `json:"configValidation"`
+}
+
+type DescribeRequest struct{}
+
+// DescribeResponse contains everything the host needs to know about a plugin
+// before it is configured.
+type DescribeResponse struct {
+	Config            []ConfigField       `json:"config"`
+	ArgSchema         providers.ArgSchema `json:"argSchema"`
+	GrantValidations  []GrantValidation   `json:"grantValidations"`
+	ConfigValidations []ConfigValidation  `json:"configValidations"`
+	Capabilities      Capabilities        `json:"capabilities"`
+}
+
+type ConfigureRequest struct {
+	Values map[string]string `json:"values"`
+}
+
+type AccessRequest struct {
+	Subject string `json:"subject"`
+	Args    []byte `json:"args"`
+	GrantID string `json:"grantId"`
+	// Grant is set if the host called the provider with a grant in the context.
+	Grant *types.Grant `json:"grant,omitempty"`
+}
+
+// context returns ctx with the grant from the request, if it was provided.
+func (r *AccessRequest) context(ctx context.Context) context.Context {
+	if r.Grant == nil {
+		return ctx
+	}
+	return providers.WithGrant(ctx, *r.Grant)
+}
+
+type OptionsRequest struct {
+	Arg string `json:"arg"`
+}
+
+type InstructionsResponse struct {
+	Instructions string `json:"instructions"`
+}
+
+type ValidateGrantRequest struct {
+	Step    string `json:"step"`
+	Subject string `json:"subject"`
+	Args    []byte `json:"args"`
+}
+
+type ValidateConfigRequest struct {
+	Step string `json:"step"`
+}
+
+type LogsResponse struct {
+	Logs diagnostics.Logs `json:"logs"`
+}
+
+type Empty struct{}
+
+// server exposes a provider over gRPC. It runs inside the plugin process.
+type server struct {
+	p providers.Accessor
+}
+
+func (s *server) Describe(ctx context.Context, req *DescribeRequest) (*DescribeResponse, error) {
+	res := DescribeResponse{}
+	if c, ok := s.p.(gconfig.Configer); ok {
+		for _, f := range c.Config() {
+			res.Config = append(res.Config, ConfigField{
+				Key:         f.Key(),
+				Description: f.Description(),
+				Secret:      f.IsSecret(),
+				Optional:    f.IsOptional(),
+				Default:     f.Default(),
+			})
+		}
+	}
+	if as, ok := s.p.(providers.ArgSchemarer); ok {
+		res.Capabilities.ArgSchema = true
+		res.ArgSchema = as.ArgSchema()
+	}
+	if _, ok := s.p.(providers.ArgOptioner); ok {
+		res.Capabilities.Options = true
+	}
+	if _, ok := s.p.(providers.Instructioner); ok {
+		res.Capabilities.Instructions = true
+	}
+	if gv, ok := s.p.(providers.GrantValidator); ok {
+		res.Capabilities.GrantValidation = true
+		for id, step := range gv.ValidateGrant() {
+			res.GrantValidations = append(res.GrantValidations, GrantValidation{ID: id, UserErrorMessage: step.UserErrorMessage})
+		}
+	}
+	if cv, ok := s.p.(providers.ConfigValidator); ok {
+		res.Capabilities.ConfigValidation = true
+		for id, step := range cv.ValidateConfig() {
+			res.ConfigValidations = append(res.ConfigValidations, ConfigValidation{ID: id, Name: step.Name, FieldsValidated: step.FieldsValidated})
+		}
+	}
+	return &res, nil
+}
+
+func (s *server) Configure(ctx context.Context, req *ConfigureRequest) (*Empty, error) {
+	if c, ok := s.p.(gconfig.Configer); ok {
+		// secrets have already been resolved by the host, so we don't try to load them again here.
+		err := c.Config().Load(ctx, &gconfig.MapLoader{SkipLoadingSecrets: true, Values: req.Values})
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	if i, ok := s.p.(gconfig.Initer); ok {
+		err := i.Init(ctx)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	return &Empty{}, nil
+}
+
+func (s *server) Grant(ctx context.Context, req *AccessRequest) (*Empty, error) {
+	err := s.p.Grant(req.context(ctx), req.Subject, req.Args, req.GrantID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &Empty{}, nil
+}
+
+func (s *server) Revoke(ctx context.Context, req *AccessRequest) (*Empty, error) {
+	err := s.p.Revoke(req.context(ctx), req.Subject, req.Args, req.GrantID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &Empty{}, nil
+}
+
+func (s *server) Options(ctx context.Context, req *OptionsRequest) (*types.ArgOptionsResponse, error) {
+	ao, ok := s.p.(providers.ArgOptioner)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "provider does not implement Options")
+	}
+	res, err := ao.Options(ctx, req.Arg)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (s *server) Instructions(ctx context.Context, req *AccessRequest) (*InstructionsResponse, error) {
+	in, ok := s.p.(providers.Instructioner)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "provider does not implement Instructions")
+	}
+	instructions, err := in.Instructions(req.context(ctx), req.Subject, req.Args, req.GrantID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &InstructionsResponse{Instructions: instructions}, nil
+}
+
+func (s *server) ValidateGrant(ctx context.Context, req *ValidateGrantRequest) (*LogsResponse, error) {
+	gv, ok := s.p.(providers.GrantValidator)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "provider does not implement ValidateGrant")
+	}
+	step, ok := gv.ValidateGrant()[req.Step]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "grant validation step %s not found", req.Step)
+	}
+	return &LogsResponse{Logs: step.Run(ctx, req.Subject, req.Args)}, nil
+}
+
+func (s *server) ValidateConfig(ctx context.Context, req *ValidateConfigRequest) (*LogsResponse, error) {
+	cv, ok := s.p.(providers.ConfigValidator)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "provider does not implement ValidateConfig")
+	}
+	step, ok := cv.ValidateConfig()[req.Step]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "config validation step %s not found", req.Step)
+	}
+	return &LogsResponse{Logs: step.Run(ctx)}, nil
+}
+
+// toStatus converts a provider error into a gRPC status error.
+// InvalidArgumentErrors and ErrGrantNotActive are preserved so that they can be reconstructed by the host.
+func toStatus(err error) error {
+	var iae *providers.InvalidArgumentError
+	if errors.As(err, &iae) {
+		return status.Error(codes.InvalidArgument, iae.Arg)
+	}
+	if errors.Is(err, providers.ErrGrantNotActive) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// fromStatus converts a gRPC status error returned by a plugin back into a provider error.
+func fromStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return &providers.InvalidArgumentError{Arg: st.Message()}
+	case codes.Unimplemented:
+		return fmt.Errorf("%w: %s", ErrUnimplemented, st.Message())
+	case codes.FailedPrecondition:
+		return providers.ErrGrantNotActive
+	}
+	return errors.New(st.Message())
+}
+
+// handler builds a gRPC method handler which decodes a request created by newReq
+// and passes it to call.
+func handler(method string, newReq func() interface{}, call func(s *server, ctx context.Context, req interface{}) (interface{}, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: method,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			req := newReq()
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+			s := srv.(*server)
+			if interceptor == nil {
+				return call(s, ctx, req)
+			}
+			info := &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: "/" + serviceName + "/" + method,
+			}
+			return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(s, ctx, req)
+			})
+		},
+	}
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		handler("Describe", func() interface{} { return &DescribeRequest{} }, func(s *server, ctx context.Context, req interface{}) (interface{}, error) {
+			return s.Describe(ctx, req.(*DescribeRequest))
+		}),
+		handler("Configure", func() interface{} { return &ConfigureRequest{} }, func(s *server, ctx context.Context, req interface{}) (interface{}, error) {
+			return s.Configure(ctx, req.(*ConfigureRequest))
+		}),
+		handler("Grant", func() interface{} { return &AccessRequest{} }, func(s *server, ctx context.Context, req interface{}) (interface{}, error) {
+			return s.Grant(ctx, req.(*AccessRequest))
+		}),
+		handler("Revoke", func() interface{} { return &AccessRequest{} }, func(s *server, ctx context.Context, req interface{}) (interface{}, error) {
+			return s.Revoke(ctx, req.(*AccessRequest))
+		}),
+		handler("Options", func() interface{} { return &OptionsRequest{} }, func(s *server, ctx context.Context, req interface{}) (interface{}, error) {
+			return s.Options(ctx, req.(*OptionsRequest))
+		}),
+		handler("Instructions", func() interface{} { return &AccessRequest{} }, func(s *server, ctx context.Context, req interface{}) (interface{}, error) {
+			return s.Instructions(ctx, req.(*AccessRequest))
+		}),
+		handler("ValidateGrant", func() interface{} { return &ValidateGrantRequest{} }, func(s *server, ctx context.Context, req interface{}) (interface{}, error) {
+			return s.ValidateGrant(ctx, req.(*ValidateGrantRequest))
+		}),
+		handler("ValidateConfig", func() interface{} { return &ValidateConfigRequest{} }, func(s *server, ctx context.Context, req interface{}) (interface{}, error) {
+			return s.ValidateConfig(ctx, req.(*ValidateConfigRequest))
+		}),
+	},
+	Streams: []grpc.StreamDesc{},
+}
@@ -7,13 +7,18 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/plugin"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
 	ecsshellsso "github.com/common-fate/granted-approvals/accesshandler/pkg/providers/aws/ecs-shell-sso"
 	eksrolessso "github.com/common-fate/granted-approvals/accesshandler/pkg/providers/aws/eks-roles-sso"
 	ssov2 "github.com/common-fate/granted-approvals/accesshandler/pkg/providers/aws/sso-v2"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers/azure/ad"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers/github/teams"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers/okta"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers/postgres"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers/ssh/certificate"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers/testvault"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers/webhook"
 	"github.com/fatih/color"
 	"github.com/hashicorp/go-version"
 )
@@ -22,6 +27,9 @@ var (
 	ErrProviderTypeNotFound = errors.New("provider type not found")
 )
 
+// PluginPrefix is the prefix of the 'uses' field for plugin providers.
+const PluginPrefix = "plugin/"
+
 type ProviderRegistry struct {
 	Providers map[string]map[string]RegisteredProvider
 }
@@ -65,6 +73,13 @@ func Registry() ProviderRegistry {
 					Description: "Azure AD groups",
 				},
 			},
+			"commonfate/github-teams": {
+				"v1": {
+					Provider:    &teams.Provider{},
+					DefaultID:   "github-teams",
+					Description: "GitHub teams",
+				},
+			},
 			"commonfate/aws-sso": {
 
 				"v2": {
@@ -80,6 +95,27 @@ func Registry() ProviderRegistry {
 					Description: "AWS EKS Roles SSO",
 				},
 			},
+			"commonfate/postgres": {
+				"v1": {
+					Provider:    &postgres.Provider{},
+					DefaultID:   "postgres",
+					Description: "PostgreSQL roles",
+				},
+			},
+			"commonfate/ssh-certificate": {
+				"v1": {
+					Provider:    &certificate.Provider{},
+					DefaultID:   "ssh-certificate",
+					Description: "SSH certificates",
+				},
+			},
+			"commonfate/webhook": {
+				"v1": {
+					Provider:    &webhook.Provider{},
+					DefaultID:   "webhook",
+					Description: "Webhook - forwards access to an in-house system",
+				},
+			},
 			"commonfate/testvault": {
 				"v1": {
 					Provider:    &testvault.Provider{},
@@ -92,11 +128,25 @@ func Registry() ProviderRegistry {
 }
 
 // Lookup a provider by the 'uses' string.
+//
+// Providers with a 'uses' field like "plugin/<name>@<version>" are
+// out-of-process plugins, and are returned as a plugin client.
 func (r ProviderRegistry) LookupByUses(uses string) (*RegisteredProvider, error) {
 	ptype, version, err := ParseUses(uses)
 	if err != nil {
 		return nil, err
 	}
+	if name := strings.TrimPrefix(ptype, PluginPrefix); name != ptype {
+		c, err := plugin.NewClient(name, version)
+		if err != nil {
+			return nil, err
+		}
+		return &RegisteredProvider{
+			Provider:    c,
+			DefaultID:   name,
+			Description: "Plugin " + name,
+		}, nil
+	}
 	return r.Lookup(ptype, version)
 }
 
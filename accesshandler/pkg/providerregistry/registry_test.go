@@ -210,3 +210,12 @@ func TestGetLatestByType(t *testing.T) {
 		})
 	}
 }
+
+func TestLookupByUsesPlugin(t *testing.T) {
+	p, err := testRegistry.LookupByUses("plugin/my-plugin@v1")
+	assert.NoError(t, err)
+	assert.Equal(t, "my-plugin", p.DefaultID)
+
+	_, err = testRegistry.LookupByUses("plugin/../../bin/x@1")
+	assert.Error(t, err)
+}
@@ -90,3 +90,5 @@ func (p *Provider) ArgSchema() providers.ArgSchema {
 
 	return arg
 }
+
+var _ providers.ActiveChecker = &Provider{}
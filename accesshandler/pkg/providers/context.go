@@ -0,0 +1,27 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+)
+
+type contextKey struct {
+	name string
+}
+
+var grantContext = contextKey{name: "grantContext"}
+
+// WithGrant returns a context containing the grant which a provider is being called for.
+// Providers which need to know the timing of a grant, such as to align the expiry of a
+// credential with the end of the grant, can read it with GrantFromContext.
+func WithGrant(ctx context.Context, grant types.Grant) context.Context {
+	return context.WithValue(ctx, grantContext, grant)
+}
+
+// GrantFromContext returns the grant set by WithGrant.
+// The boolean is false if the context doesn't contain a grant.
+func GrantFromContext(ctx context.Context) (types.Grant, bool) {
+	g, ok := ctx.Value(grantContext).(types.Grant)
+	return g, ok
+}
@@ -1,6 +1,13 @@
 package providers
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrGrantNotActive is returned by providers which only issue credentials while a grant is active,
+// such as when access instructions are requested before a grant starts or after it has ended.
+var ErrGrantNotActive = errors.New("the grant is not active")
 
 type InvalidArgumentError struct {
 	Arg string
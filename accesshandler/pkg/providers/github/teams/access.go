@@ -0,0 +1,104 @@
+package teams
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	RoleMember     = "member"
+	RoleMaintainer = "maintainer"
+)
+
+type Args struct {
+	Team string `json:"team"`
+	Role string `json:"role"`
+}
+
+// parseArgs unmarshals the arguments of a grant. The role defaults to member if it isn't set.
+func parseArgs(args []byte) (Args, error) {
+	var a Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return Args{}, err
+	}
+	if a.Role == "" {
+		a.Role = RoleMember
+	}
+	if a.Role != RoleMember && a.Role != RoleMaintainer {
+		return Args{}, &InvalidRoleError{Role: a.Role}
+	}
+	return a, nil
+}
+
+// Grant the access by adding the user to the team through GitHub's API.
+// Memberships which the user already has aren't changed, as revoking the access would remove them,
+// so an AlreadyMemberError is returned if the user is already a member of the team with a different role.
+// Requests can't be made for teams which the user is already a member of, so a membership with the
+// granted role was added by an earlier attempt at this grant which is being retried, and the grant succeeds.
+func (p *Provider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	a, err := parseArgs(args)
+	if err != nil {
+		return err
+	}
+	log := zap.S().With("args", a)
+	log.Info("getting github login for user")
+	login, err := p.loginForEmail(ctx, subject)
+	if err != nil {
+		return err
+	}
+	m, err := p.getMembership(ctx, a.Team, login)
+	if err != nil {
+		return err
+	}
+	if m != nil && m.Role == a.Role {
+		log.Infow("github user is already a member of the team with the granted role", "login", login)
+		return nil
+	}
+	if m != nil {
+		return &AlreadyMemberError{User: login, Team: a.Team, Role: m.Role}
+	}
+	log.Infow("adding github user to team", "login", login)
+	return p.call(ctx, http.MethodPut, p.membershipPath(a.Team, login), map[string]string{"role": a.Role}, nil)
+}
+
+// Revoke the access by removing the user from the team through GitHub's API.
+func (p *Provider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	a, err := parseArgs(args)
+	if err != nil {
+		return err
+	}
+	log := zap.S().With("args", a)
+	log.Info("getting github login for user")
+	login, err := p.loginForEmail(ctx, subject)
+	if err != nil {
+		return err
+	}
+	log.Infow("removing github user from team", "login", login)
+	err = p.call(ctx, http.MethodDelete, p.membershipPath(a.Team, login), nil, nil)
+	if _, ok := err.(errNotFound); ok {
+		// the user has already been removed from the team.
+		return nil
+	}
+	return err
+}
+
+// IsActive checks whether the user is a member of the team with the granted role.
+func (p *Provider) IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error) {
+	a, err := parseArgs(args)
+	if err != nil {
+		return false, err
+	}
+	login, err := p.loginForEmail(ctx, subject)
+	if err != nil {
+		return false, err
+	}
+	m, err := p.getMembership(ctx, a.Team, login)
+	if err != nil || m == nil {
+		return false, err
+	}
+	return m.Role == a.Role, nil
+}
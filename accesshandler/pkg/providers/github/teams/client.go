@@ -0,0 +1,306 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// errNotFound is returned when the GitHub API returns a 404 response.
+type errNotFound struct {
+	path string
+}
+
+func (e errNotFound) Error() string {
+	return fmt.Sprintf("github resource %s was not found", e.path)
+}
+
+type team struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Slug        string  `json:"slug"`
+	Description *string `json:"description"`
+}
+
+type membership struct {
+	Role  string `json:"role"`
+	State string `json:"state"`
+}
+
+// appJWT creates a JSON Web Token which authenticates as the GitHub App.
+// See: https://docs.github.com/en/developers/apps/building-github-apps/authenticating-with-github-apps#authenticating-as-a-github-app
+func (p *Provider) appJWT(now time.Time) (string, error) {
+	enc := base64.RawURLEncoding
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	// the issued at time is backdated to allow for clock drift.
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(time.Minute * 9).Unix(),
+		"iss": p.appID.Get(),
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + enc.EncodeToString(sig), nil
+}
+
+// installationToken returns an access token for the installation of the GitHub App,
+// creating a new token if the cached token expires in the next five minutes.
+func (p *Provider) installationToken(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	if p.token != "" && now.Add(time.Minute*5).Before(p.tokenExpiresAt) {
+		return p.token, nil
+	}
+
+	jwt, err := p.appJWT(now)
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	path := fmt.Sprintf("/app/installations/%s/access_tokens", url.PathEscape(p.installationID.Get()))
+	err = p.do(ctx, "Bearer "+jwt, http.MethodPost, p.restURL(path), nil, &res)
+	if err != nil {
+		return "", err
+	}
+	p.token = res.Token
+	p.tokenExpiresAt = res.ExpiresAt
+	return p.token, nil
+}
+
+// call makes a request to the GitHub REST API as the installation of the GitHub App.
+func (p *Provider) call(ctx context.Context, method, path string, body, out interface{}) error {
+	token, err := p.installationToken(ctx)
+	if err != nil {
+		return err
+	}
+	return p.do(ctx, "token "+token, method, p.restURL(path), body, out)
+}
+
+func (p *Provider) do(ctx context.Context, authorization, method, u string, body, out interface{}) error {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, r)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", authorization)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return errNotFound{path: req.URL.Path}
+	}
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("github API returned %d for %s %s: %s", res.StatusCode, method, req.URL.Path, string(b))
+	}
+	if out == nil || len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
+
+func (p *Provider) restURL(path string) string {
+	return strings.TrimSuffix(p.apiURL.Get(), "/") + path
+}
+
+// graphqlURL returns the GraphQL API URL. GitHub Enterprise Server serves it
+// at /api/graphql rather than alongside the REST API.
+func (p *Provider) graphqlURL() string {
+	base := strings.TrimSuffix(p.apiURL.Get(), "/")
+	if strings.HasSuffix(base, "/api/v3") {
+		return strings.TrimSuffix(base, "/v3") + "/graphql"
+	}
+	return base + "/graphql"
+}
+
+// listTeams lists every team in the organization.
+func (p *Provider) listTeams(ctx context.Context) ([]team, error) {
+	var teams []team
+	for page := 1; ; page++ {
+		var res []team
+		err := p.call(ctx, http.MethodGet, fmt.Sprintf("/orgs/%s/teams?per_page=100&page=%d", url.PathEscape(p.org.Get()), page), nil, &res)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, res...)
+		if len(res) < 100 {
+			return teams, nil
+		}
+	}
+}
+
+func (p *Provider) getTeam(ctx context.Context, slug string) (*team, error) {
+	var t team
+	err := p.call(ctx, http.MethodGet, fmt.Sprintf("/orgs/%s/teams/%s", url.PathEscape(p.org.Get()), url.PathEscape(slug)), nil, &t)
+	if _, ok := err.(errNotFound); ok {
+		return nil, &TeamNotFoundError{Team: slug}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// getMembership returns the membership of a user in a team, or nil if they aren't a member of the team.
+func (p *Provider) getMembership(ctx context.Context, slug, login string) (*membership, error) {
+	var m membership
+	err := p.call(ctx, http.MethodGet, p.membershipPath(slug, login), nil, &m)
+	if _, ok := err.(errNotFound); ok {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (p *Provider) membershipPath(slug, login string) string {
+	return fmt.Sprintf("/orgs/%s/teams/%s/memberships/%s", url.PathEscape(p.org.Get()), url.PathEscape(slug), url.PathEscape(login))
+}
+
+const samlIdentitiesQuery = `query($org: String!, $cursor: String) {
+  organization(login: $org) {
+    samlIdentityProvider {
+      externalIdentities(first: 100, after: $cursor) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          samlIdentity { nameId emails { value } }
+          user { login }
+        }
+      }
+    }
+  }
+}`
+
+type samlIdentitiesResponse struct {
+	Data struct {
+		Organization struct {
+			SAMLIdentityProvider *struct {
+				ExternalIdentities struct {
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+					Nodes []struct {
+						SAMLIdentity struct {
+							NameID string `json:"nameId"`
+							Emails []struct {
+								Value string `json:"value"`
+							} `json:"emails"`
+						} `json:"samlIdentity"`
+						User *struct {
+							Login string `json:"login"`
+						} `json:"user"`
+					} `json:"nodes"`
+				} `json:"externalIdentities"`
+			} `json:"samlIdentityProvider"`
+		} `json:"organization"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// samlIdentities returns the GitHub logins of the users in the organization, keyed by the lowercased
+// email addresses of their SAML identities. Users who haven't linked their GitHub account are not included.
+func (p *Provider) samlIdentities(ctx context.Context) (map[string]string, error) {
+	token, err := p.installationToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logins := map[string]string{}
+	var cursor *string
+	for {
+		body := map[string]interface{}{
+			"query":     samlIdentitiesQuery,
+			"variables": map[string]interface{}{"org": p.org.Get(), "cursor": cursor},
+		}
+		var res samlIdentitiesResponse
+		err = p.do(ctx, "bearer "+token, http.MethodPost, p.graphqlURL(), body, &res)
+		if err != nil {
+			return nil, err
+		}
+		if len(res.Errors) > 0 {
+			return nil, fmt.Errorf("github GraphQL API returned an error: %s", res.Errors[0].Message)
+		}
+		idp := res.Data.Organization.SAMLIdentityProvider
+		if idp == nil {
+			return nil, fmt.Errorf("SAML single sign-on is not enabled for the %s organization", p.org.Get())
+		}
+		for _, n := range idp.ExternalIdentities.Nodes {
+			if n.User == nil {
+				continue
+			}
+			logins[strings.ToLower(n.SAMLIdentity.NameID)] = n.User.Login
+			for _, e := range n.SAMLIdentity.Emails {
+				logins[strings.ToLower(e.Value)] = n.User.Login
+			}
+		}
+		if !idp.ExternalIdentities.PageInfo.HasNextPage {
+			return logins, nil
+		}
+		next := idp.ExternalIdentities.PageInfo.EndCursor
+		cursor = &next
+	}
+}
+
+// loginForEmail maps the email address of a subject to their GitHub login through the SAML identities of the organization.
+// Listing the SAML identities takes a request for every hundred users, so the identities are cached for identitiesCacheTTL.
+func (p *Provider) loginForEmail(ctx context.Context, email string) (string, error) {
+	p.identitiesMu.Lock()
+	defer p.identitiesMu.Unlock()
+	if p.identities == nil || time.Since(p.identitiesFetchedAt) > identitiesCacheTTL {
+		logins, err := p.samlIdentities(ctx)
+		if err != nil {
+			return "", err
+		}
+		p.identities = logins
+		p.identitiesFetchedAt = time.Now()
+	}
+	login, ok := p.identities[strings.ToLower(email)]
+	if !ok {
+		return "", &UserNotFoundError{User: email}
+	}
+	return login, nil
+}
@@ -0,0 +1,39 @@
+package teams
+
+import (
+	"fmt"
+)
+
+type UserNotFoundError struct {
+	User string
+}
+
+func (e *UserNotFoundError) Error() string {
+	return fmt.Sprintf("could not find a GitHub user with a SAML identity for %s", e.User)
+}
+
+type TeamNotFoundError struct {
+	Team string
+}
+
+func (e *TeamNotFoundError) Error() string {
+	return fmt.Sprintf("team %s was not found", e.Team)
+}
+
+type AlreadyMemberError struct {
+	User string
+	Team string
+	Role string
+}
+
+func (e *AlreadyMemberError) Error() string {
+	return fmt.Sprintf("%s is already a %s of team %s, access can't be granted without changing their existing membership", e.User, e.Role, e.Team)
+}
+
+type InvalidRoleError struct {
+	Role string
+}
+
+func (e *InvalidRoleError) Error() string {
+	return fmt.Sprintf("role %s is not valid, it must be member or maintainer", e.Role)
+}
@@ -0,0 +1,35 @@
+package teams
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"go.uber.org/zap"
+)
+
+// List options for arg
+func (p *Provider) Options(ctx context.Context, arg string) (*types.ArgOptionsResponse, error) {
+	switch arg {
+	case "team":
+		log := zap.S().With("arg", arg)
+		log.Info("getting github team options")
+		teams, err := p.listTeams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		opts := types.ArgOptionsResponse{Options: []types.Option{}}
+		for _, t := range teams {
+			opts.Options = append(opts.Options, types.Option{Label: t.Name, Value: t.Slug, Description: t.Description})
+		}
+		return &opts, nil
+	case "role":
+		return &types.ArgOptionsResponse{
+			Options: []types.Option{
+				{Label: "Member", Value: RoleMember},
+				{Label: "Maintainer", Value: RoleMaintainer},
+			},
+		}, nil
+	}
+	return nil, &providers.InvalidArgumentError{Arg: arg}
+}
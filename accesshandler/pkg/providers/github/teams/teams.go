@@ -0,0 +1,118 @@
+package teams
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"go.uber.org/zap"
+)
+
+// DefaultAPIURL is the GitHub REST API URL. GitHub Enterprise Server uses https://HOSTNAME/api/v3.
+const DefaultAPIURL = "https://api.github.com"
+
+const (
+	// requestTimeout is the timeout for requests to the GitHub API.
+	requestTimeout = 30 * time.Second
+	// identitiesCacheTTL is how long the SAML identities of the organization are cached for.
+	identitiesCacheTTL = 5 * time.Minute
+)
+
+// Provider grants membership of teams in a GitHub organization.
+// It authenticates as an installation of a GitHub App in the organization.
+type Provider struct {
+	org            gconfig.StringValue
+	appID          gconfig.StringValue
+	installationID gconfig.StringValue
+	privateKey     gconfig.SecretStringValue
+	apiURL         gconfig.StringValue
+
+	httpClient *http.Client
+	key        *rsa.PrivateKey
+
+	// the installation access token is cached until shortly before it expires.
+	mu             sync.Mutex
+	token          string
+	tokenExpiresAt time.Time
+
+	// the GitHub logins of the organization's users, keyed by email, are cached until identitiesCacheTTL has passed.
+	identitiesMu        sync.Mutex
+	identities          map[string]string
+	identitiesFetchedAt time.Time
+}
+
+func (p *Provider) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("org", &p.org, "the GitHub organization"),
+		gconfig.StringField("appId", &p.appID, "the ID of the GitHub App"),
+		gconfig.StringField("installationId", &p.installationID, "the ID of the installation of the GitHub App in the organization"),
+		gconfig.SecretStringField("privateKey", &p.privateKey, "the private key of the GitHub App", gconfig.WithArgs("/granted/providers/%s/privateKey", 1)),
+		gconfig.StringField("apiUrl", &p.apiURL, "the GitHub API URL", gconfig.WithDefaultFunc(func() string { return DefaultAPIURL })),
+	}
+}
+
+// Init the GitHub teams provider.
+func (p *Provider) Init(ctx context.Context) error {
+	zap.S().Infow("configuring github client", "org", p.org, "apiUrl", p.apiURL)
+
+	key, err := parsePrivateKey(p.privateKey.Get())
+	if err != nil {
+		return err
+	}
+	p.key = key
+	if p.httpClient == nil {
+		p.httpClient = &http.Client{Timeout: requestTimeout}
+	}
+	zap.S().Info("github client configured")
+	return nil
+}
+
+func (p *Provider) ArgSchema() providers.ArgSchema {
+	arg := providers.ArgSchema{
+		"team": {
+			Id:          "team",
+			Title:       "Team",
+			FormElement: types.MULTISELECT,
+		},
+		"role": {
+			Id:          "role",
+			Title:       "Role",
+			Description: aws.String("Maintainers can manage the members and settings of the team"),
+			FormElement: types.MULTISELECT,
+		},
+	}
+	return arg
+}
+
+var _ providers.ActiveChecker = &Provider{}
+
+// parsePrivateKey parses the PEM encoded private key which is downloaded when creating a GitHub App.
+func parsePrivateKey(s string) (*rsa.PrivateKey, error) {
+	// private keys stored in environment variables and SSM often have escaped newlines.
+	block, _ := pem.Decode([]byte(strings.ReplaceAll(s, `\n`, "\n")))
+	if block == nil {
+		return nil, errors.New("github app private key must be PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.New("github app private key must be an RSA private key")
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("github app private key must be an RSA private key")
+	}
+	return key, nil
+}
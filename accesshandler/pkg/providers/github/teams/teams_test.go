@@ -0,0 +1,321 @@
+package teams
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeGitHub is a fake of the parts of the GitHub API which the provider uses.
+type fakeGitHub struct {
+	t   *testing.T
+	key *rsa.PublicKey
+	mu  sync.Mutex
+	// memberships are the roles of users in teams, keyed by "team/login".
+	memberships map[string]string
+	// identities maps SAML identity emails to GitHub logins.
+	identities map[string]string
+	teams      []team
+}
+
+func (f *fakeGitHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/app/installations/123/access_tokens" {
+		f.verifyJWT(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"token": "ghs_test", "expires_at": time.Now().Add(time.Hour)})
+		return
+	}
+	if auth := r.Header.Get("Authorization"); auth != "token ghs_test" && auth != "bearer ghs_test" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/graphql":
+		var nodes []map[string]interface{}
+		for email, login := range f.identities {
+			nodes = append(nodes, map[string]interface{}{
+				"samlIdentity": map[string]interface{}{"nameId": email, "emails": []interface{}{}},
+				"user":         map[string]interface{}{"login": login},
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"samlIdentityProvider": map[string]interface{}{
+						"externalIdentities": map[string]interface{}{
+							"pageInfo": map[string]interface{}{"hasNextPage": false},
+							"nodes":    nodes,
+						},
+					},
+				},
+			},
+		})
+	case r.URL.Path == "/orgs/my-org/teams":
+		_ = json.NewEncoder(w).Encode(f.teams)
+	case strings.HasPrefix(r.URL.Path, "/orgs/my-org/teams/"):
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/orgs/my-org/teams/"), "/")
+		if !f.hasTeam(parts[0]) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if len(parts) == 1 {
+			_ = json.NewEncoder(w).Encode(team{Slug: parts[0], Name: parts[0]})
+			return
+		}
+		key := parts[0] + "/" + parts[2]
+		switch r.Method {
+		case http.MethodPut:
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			f.memberships[key] = body["role"]
+			_ = json.NewEncoder(w).Encode(membership{Role: body["role"], State: "active"})
+		case http.MethodDelete:
+			if _, ok := f.memberships[key]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(f.memberships, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			role, ok := f.memberships[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(membership{Role: role, State: "active"})
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeGitHub) hasTeam(slug string) bool {
+	for _, t := range f.teams {
+		if t.Slug == slug {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyJWT checks that the JWT is signed by the private key of the GitHub App.
+func (f *fakeGitHub) verifyJWT(jwt string) {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		f.t.Errorf("invalid JWT: %s", jwt)
+		return
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		f.t.Error(err)
+		return
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	err = rsa.VerifyPKCS1v15(f.key, crypto.SHA256, digest[:], sig)
+	if err != nil {
+		f.t.Errorf("invalid JWT signature: %s", err)
+	}
+	claims, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	assert.Contains(f.t, string(claims), `"iss":"42"`)
+}
+
+func newTestProvider(t *testing.T) (*Provider, *fakeGitHub) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	f := &fakeGitHub{
+		t:           t,
+		key:         &key.PublicKey,
+		memberships: map[string]string{},
+		identities:  map[string]string{"alice@example.com": "alice"},
+		teams:       []team{{ID: 1, Name: "Platform", Slug: "platform"}},
+	}
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+
+	p := &Provider{
+		org:            gconfig.StringValue{Value: "my-org"},
+		appID:          gconfig.StringValue{Value: "42"},
+		installationID: gconfig.StringValue{Value: "123"},
+		apiURL:         gconfig.StringValue{Value: server.URL},
+		httpClient:     server.Client(),
+	}
+	p.privateKey.Set(string(pemKey))
+	err = p.Init(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p, f
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+
+	// keys stored in SSM or environment variables can have escaped newlines.
+	for _, s := range []string{pemKey, strings.ReplaceAll(pemKey, "\n", `\n`)} {
+		got, err := parsePrivateKey(s)
+		assert.NoError(t, err)
+		assert.True(t, key.Equal(got))
+	}
+
+	_, err = parsePrivateKey("not a key")
+	assert.Error(t, err)
+}
+
+func TestGrantAndRevoke(t *testing.T) {
+	ctx := context.Background()
+	p, f := newTestProvider(t)
+	args := []byte(`{"team": "platform", "role": "maintainer"}`)
+
+	err := p.Grant(ctx, "Alice@example.com", args, "gra_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{"platform/alice": RoleMaintainer}, f.memberships)
+
+	active, err := p.IsActive(ctx, "alice@example.com", args, "gra_1")
+	assert.NoError(t, err)
+	assert.True(t, active)
+
+	// the user is only a member, not a maintainer.
+	f.memberships["platform/alice"] = RoleMember
+	active, err = p.IsActive(ctx, "alice@example.com", args, "gra_1")
+	assert.NoError(t, err)
+	assert.False(t, active)
+
+	err = p.Revoke(ctx, "alice@example.com", args, "gra_1")
+	assert.NoError(t, err)
+	assert.Empty(t, f.memberships)
+
+	// revoking again is a no-op.
+	err = p.Revoke(ctx, "alice@example.com", args, "gra_1")
+	assert.NoError(t, err)
+}
+
+func TestGrantErrors(t *testing.T) {
+	ctx := context.Background()
+	p, _ := newTestProvider(t)
+
+	err := p.Grant(ctx, "bob@example.com", []byte(`{"team": "platform"}`), "gra_1")
+	assert.Equal(t, &UserNotFoundError{User: "bob@example.com"}, err)
+
+	err = p.Grant(ctx, "alice@example.com", []byte(`{"team": "platform", "role": "owner"}`), "gra_1")
+	assert.Equal(t, &InvalidRoleError{Role: "owner"}, err)
+}
+
+func TestGrantDoesNotChangeExistingMemberships(t *testing.T) {
+	ctx := context.Background()
+	p, f := newTestProvider(t)
+	f.memberships["platform/alice"] = RoleMaintainer
+
+	err := p.Grant(ctx, "alice@example.com", []byte(`{"team": "platform", "role": "member"}`), "gra_1")
+	assert.Equal(t, &AlreadyMemberError{User: "alice", Team: "platform", Role: RoleMaintainer}, err)
+	assert.Equal(t, map[string]string{"platform/alice": RoleMaintainer}, f.memberships)
+}
+
+func TestGrantIsRetried(t *testing.T) {
+	ctx := context.Background()
+	p, f := newTestProvider(t)
+	args := []byte(`{"team": "platform", "role": "maintainer"}`)
+
+	err := p.Grant(ctx, "alice@example.com", args, "gra_1")
+	assert.NoError(t, err)
+
+	// retrying a grant which added the membership succeeds without changing it.
+	err = p.Grant(ctx, "alice@example.com", args, "gra_1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"platform/alice": RoleMaintainer}, f.memberships)
+
+	err = p.Revoke(ctx, "alice@example.com", args, "gra_1")
+	assert.NoError(t, err)
+	assert.Empty(t, f.memberships)
+}
+
+func TestLoginsAreCached(t *testing.T) {
+	ctx := context.Background()
+	p, f := newTestProvider(t)
+
+	login, err := p.loginForEmail(ctx, "alice@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", login)
+
+	// the cached identities are used until they expire.
+	f.mu.Lock()
+	f.identities = map[string]string{"alice@example.com": "alice-renamed"}
+	f.mu.Unlock()
+	login, err = p.loginForEmail(ctx, "alice@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", login)
+
+	p.identitiesFetchedAt = p.identitiesFetchedAt.Add(-identitiesCacheTTL)
+	login, err = p.loginForEmail(ctx, "alice@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice-renamed", login)
+}
+
+func TestOptions(t *testing.T) {
+	p, _ := newTestProvider(t)
+	got, err := p.Options(context.Background(), "team")
+	assert.NoError(t, err)
+	assert.Equal(t, &types.ArgOptionsResponse{Options: []types.Option{{Label: "Platform", Value: "platform"}}}, got)
+
+	_, err = p.Options(context.Background(), "other")
+	assert.Error(t, err)
+}
+
+func TestValidateGrant(t *testing.T) {
+	ctx := context.Background()
+	p, f := newTestProvider(t)
+	f.teams = append(f.teams, team{ID: 2, Name: "Security", Slug: "security"})
+	f.memberships["security/alice"] = RoleMember
+	steps := p.ValidateGrant()
+
+	type testcase struct {
+		step    string
+		subject string
+		args    string
+		wantErr bool
+	}
+	testcases := []testcase{
+		{step: "user-exists-in-github", subject: "alice@example.com"},
+		{step: "user-exists-in-github", subject: "bob@example.com", wantErr: true},
+		{step: "team-exists-in-github", args: `{"team": "platform"}`},
+		{step: "team-exists-in-github", args: `{"team": "missing"}`, wantErr: true},
+		{step: "user-not-already-member", subject: "alice@example.com", args: `{"team": "platform"}`},
+		{step: "user-not-already-member", subject: "alice@example.com", args: `{"team": "security"}`, wantErr: true},
+	}
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s %s%s", tc.step, tc.subject, tc.args), func(t *testing.T) {
+			logs := steps[tc.step].Run(ctx, tc.subject, []byte(tc.args))
+			assert.Equal(t, !tc.wantErr, logs.HasSucceeded())
+		})
+	}
+}
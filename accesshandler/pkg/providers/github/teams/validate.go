@@ -0,0 +1,111 @@
+package teams
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/diagnostics"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/pkg/errors"
+)
+
+func (p *Provider) ValidateGrant() providers.GrantValidationSteps {
+	return map[string]providers.GrantValidationStep{
+		"user-exists-in-github": {
+			UserErrorMessage: "We couldn't find a GitHub account linked to your SAML identity in the organization",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				login, err := p.loginForEmail(ctx, subject)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("User %s is linked to GitHub user %s", subject, login)
+			},
+		},
+		"team-exists-in-github": {
+			UserErrorMessage: "We couldn't find a matching team in GitHub",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				a, err := parseArgs(args)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				_, err = p.getTeam(ctx, a.Team)
+				if err != nil {
+					return diagnostics.Error(fmt.Errorf("could not find team %s in GitHub", a.Team))
+				}
+				return diagnostics.Info("Team exists in GitHub")
+			},
+		},
+		"user-not-already-member": {
+			UserErrorMessage: "You are already a member of this team in GitHub",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				a, err := parseArgs(args)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				login, err := p.loginForEmail(ctx, subject)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				m, err := p.getMembership(ctx, a.Team, login)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				if m != nil {
+					return diagnostics.Error(&AlreadyMemberError{User: login, Team: a.Team, Role: m.Role})
+				}
+				return diagnostics.Info("User is not a member of the team")
+			},
+		},
+	}
+}
+
+func validateAPIURL(apiURL string) error {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" {
+		return errors.New("GitHub API URL must use https scheme")
+	}
+	return nil
+}
+
+func (p *Provider) ValidateConfig() map[string]providers.ConfigValidationStep {
+	return map[string]providers.ConfigValidationStep{
+		"authenticate-installation": {
+			Name: "Authenticate as the GitHub App installation",
+			Run: func(ctx context.Context) diagnostics.Logs {
+				err := validateAPIURL(p.apiURL.Get())
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				_, err = p.installationToken(ctx)
+				if err != nil {
+					return diagnostics.Error(errors.Wrap(err, "failed to create an installation access token"))
+				}
+				return diagnostics.Info("Authenticated as installation %s of GitHub App %s", p.installationID.Get(), p.appID.Get())
+			},
+		},
+		"list-teams": {
+			Name: "List GitHub teams",
+			Run: func(ctx context.Context) diagnostics.Logs {
+				teams, err := p.listTeams(ctx)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("GitHub returned %d teams in the %s organization", len(teams), p.org.Get())
+			},
+		},
+		"list-saml-identities": {
+			Name: "List SAML identities of the organization",
+			Run: func(ctx context.Context) diagnostics.Logs {
+				logins, err := p.samlIdentities(ctx)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("GitHub returned %d SAML identity emails linked to GitHub users", len(logins))
+			},
+		},
+	}
+}
@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// passwordTTL is how long the password generated when viewing the access instructions is valid for.
+const passwordTTL = time.Hour
+
+type Args struct {
+	Role     string `json:"role"`
+	Database string `json:"database"`
+}
+
+// Grant the access by creating or unlocking the login role for the user
+// and adding it as a member of the group role.
+func (p *Provider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	var a Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return err
+	}
+	user := roleName(subject)
+	log := zap.S().With("args", a, "user", user)
+
+	_, err = p.getGroupRole(ctx, a.Role)
+	if err != nil {
+		return err
+	}
+	_, err = p.getDatabase(ctx, a.Database)
+	if err != nil {
+		return err
+	}
+
+	return p.withRoleLock(ctx, user, func(tx *sql.Tx) error {
+		state, err := getRoleState(ctx, tx, user, subject)
+		if err != nil {
+			return err
+		}
+		if state != nil {
+			log.Info("unlocking postgres login role")
+			_, err = tx.ExecContext(ctx, fmt.Sprintf("ALTER ROLE %s LOGIN", pq.QuoteIdentifier(user)))
+		} else {
+			log.Info("creating postgres login role")
+			state = &roleState{Subject: subject, Grants: make(map[string]Args)}
+			_, err = tx.ExecContext(ctx, fmt.Sprintf("CREATE ROLE %s LOGIN", pq.QuoteIdentifier(user)))
+		}
+		if err != nil {
+			return err
+		}
+
+		log.Info("granting postgres role to user")
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("GRANT CONNECT ON DATABASE %s TO %s", pq.QuoteIdentifier(a.Database), pq.QuoteIdentifier(user)))
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("GRANT %s TO %s", pq.QuoteIdentifier(a.Role), pq.QuoteIdentifier(user)))
+		if err != nil {
+			return err
+		}
+		state.Grants[grantID] = a
+		return setRoleState(ctx, tx, user, state)
+	})
+}
+
+// Revoke the access by removing the login role from the group role and terminating the user's sessions.
+// The group role and CONNECT privilege are kept while another grant for the user still uses them,
+// and the login role is locked once no grants remain.
+func (p *Provider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	var a Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return err
+	}
+	user := roleName(subject)
+	log := zap.S().With("args", a, "user", user)
+
+	var revokedRole, revokedConnect bool
+	err = p.withRoleLock(ctx, user, func(tx *sql.Tx) error {
+		state, err := getRoleState(ctx, tx, user, subject)
+		if err != nil {
+			return err
+		}
+		if state == nil {
+			// the login role has already been removed.
+			log.Info("postgres login role does not exist, skipping revoke")
+			return nil
+		}
+		delete(state.Grants, grantID)
+
+		if !state.usesRole(a.Role) {
+			log.Info("revoking postgres role from user")
+			_, err = tx.ExecContext(ctx, fmt.Sprintf("REVOKE %s FROM %s", pq.QuoteIdentifier(a.Role), pq.QuoteIdentifier(user)))
+			if err != nil {
+				return err
+			}
+			revokedRole = true
+		}
+		if !state.usesDatabase(a.Database) {
+			log.Info("revoking postgres database connect privilege from user")
+			_, err = tx.ExecContext(ctx, fmt.Sprintf("REVOKE CONNECT ON DATABASE %s FROM %s", pq.QuoteIdentifier(a.Database), pq.QuoteIdentifier(user)))
+			if err != nil {
+				return err
+			}
+			revokedConnect = true
+		}
+		if len(state.Grants) == 0 {
+			log.Info("locking postgres login role")
+			_, err = tx.ExecContext(ctx, fmt.Sprintf("ALTER ROLE %s NOLOGIN PASSWORD NULL", pq.QuoteIdentifier(user)))
+			if err != nil {
+				return err
+			}
+		}
+		return setRoleState(ctx, tx, user, state)
+	})
+	if err != nil {
+		return err
+	}
+
+	// sessions which are already open keep the privileges of the role they have SET ROLE to, so they are terminated.
+	// If the user only lost access to the database, just their sessions on that database are terminated.
+	var rows *sql.Rows
+	switch {
+	case revokedRole:
+		log.Info("terminating postgres sessions of user")
+		rows, err = p.db.QueryContext(ctx, `SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE usename = $1`, user)
+	case revokedConnect:
+		log.Info("terminating postgres sessions of user on database")
+		rows, err = p.db.QueryContext(ctx, `SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE usename = $1 AND datname = $2`, user, a.Database)
+	default:
+		log.Info("another grant for the user is still active, keeping postgres sessions")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
+// IsActive checks whether the grant is recorded on the user's login role,
+// and the login role can log in and is a member of the group role.
+func (p *Provider) IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error) {
+	var a Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return false, err
+	}
+	user := roleName(subject)
+	state, err := getRoleState(ctx, p.db, user, subject)
+	if err != nil {
+		return false, err
+	}
+	if state == nil {
+		return false, nil
+	}
+	if _, ok := state.Grants[grantID]; !ok {
+		return false, nil
+	}
+	var active bool
+	err = p.db.QueryRowContext(ctx, `SELECT EXISTS (
+		SELECT 1 FROM pg_auth_members m
+		JOIN pg_roles g ON m.roleid = g.oid
+		JOIN pg_roles u ON m.member = u.oid
+		WHERE g.rolname = $1 AND u.rolname = $2 AND u.rolcanlogin
+	)`, a.Role, user).Scan(&active)
+	if err != nil {
+		return false, err
+	}
+	return active, nil
+}
+
+// Instructions generates a short-lived password for the user's login role and returns a connection string.
+// Each time the instructions are viewed the password is regenerated, which doesn't affect sessions that are already open.
+func (p *Provider) Instructions(ctx context.Context, subject string, args []byte, grantId string) (string, error) {
+	var a Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return "", err
+	}
+	user := roleName(subject)
+
+	// never set a password on a role which Granted didn't create for the user.
+	state, err := getRoleState(ctx, p.db, user, subject)
+	if err != nil {
+		return "", err
+	}
+	if state == nil {
+		return "", &UserNotFoundError{User: user}
+	}
+
+	var canLogin sql.NullBool
+	err = p.db.QueryRowContext(ctx, `SELECT rolcanlogin FROM pg_roles WHERE rolname = $1`, user).Scan(&canLogin)
+	if err == sql.ErrNoRows {
+		return "", &UserNotFoundError{User: user}
+	}
+	if err != nil {
+		return "", err
+	}
+	if !canLogin.Bool {
+		return "", &UserNotFoundError{User: user}
+	}
+
+	password, err := generatePassword()
+	if err != nil {
+		return "", err
+	}
+	expiresAt := time.Now().Add(passwordTTL)
+	_, err = p.db.ExecContext(ctx, fmt.Sprintf("ALTER ROLE %s WITH PASSWORD %s VALID UNTIL %s",
+		pq.QuoteIdentifier(user), pq.QuoteLiteral(password), pq.QuoteLiteral(expiresAt.UTC().Format(time.RFC3339))))
+	if err != nil {
+		return "", err
+	}
+
+	i := "# CLI\n"
+	i += fmt.Sprintf("Connect to the **%s** database as **%s** with psql:\n\n", a.Database, user)
+	i += "```\n"
+	i += fmt.Sprintf("psql '%s'\n", p.connectionURL(user, password, a.Database))
+	i += "```\n"
+	i += fmt.Sprintf("The password expires at %s. Refresh this page to generate a new password.\n", expiresAt.UTC().Format(time.RFC1123))
+	return i, nil
+}
+
+// generatePassword returns a random password which is safe to use in a connection URL.
+func generatePassword() (string, error) {
+	b := make([]byte, 24)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import "fmt"
+
+type UserNotFoundError struct {
+	User string
+}
+
+func (e *UserNotFoundError) Error() string {
+	return fmt.Sprintf("login role %s was not found or is locked", e.User)
+}
+
+type RoleNotFoundError struct {
+	Role string
+}
+
+func (e *RoleNotFoundError) Error() string {
+	return fmt.Sprintf("group role %s was not found", e.Role)
+}
+
+type DatabaseNotFoundError struct {
+	Database string
+}
+
+func (e *DatabaseNotFoundError) Error() string {
+	return fmt.Sprintf("database %s was not found", e.Database)
+}
+
+type RoleNotManagedError struct {
+	Role string
+}
+
+func (e *RoleNotManagedError) Error() string {
+	return fmt.Sprintf("role %s already exists and was not created by Granted for this user, so it can't be used as their login role", e.Role)
+}
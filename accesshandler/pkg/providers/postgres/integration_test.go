@@ -0,0 +1,244 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getenv returns the value of an environment variable, or def if it isn't set.
+func getenv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// newIntegrationProvider returns a provider connected to the local PostgreSQL server.
+func newIntegrationProvider(t *testing.T) *Provider {
+	ctx := context.Background()
+	p := Provider{}
+	err := p.Config().Load(ctx, &gconfig.MapLoader{
+		SkipLoadingSecrets: true,
+		Values: map[string]string{
+			"host":     getenv("PGHOST", "localhost"),
+			"port":     getenv("PGPORT", DefaultPort),
+			"database": getenv("PGDATABASE", "postgres"),
+			"username": getenv("PGUSER", "postgres"),
+			"password": getenv("PGPASSWORD", "postgres"),
+			"sslMode":  "disable",
+		},
+	})
+	require.NoError(t, err)
+	err = p.Init(ctx)
+	require.NoError(t, err)
+	return &p
+}
+
+// TestIntegration runs against a local PostgreSQL server. Start one with:
+//
+//	docker run --rm -p 5432:5432 -e POSTGRES_PASSWORD=postgres postgres:14
+//
+// The connection can be configured with the PGHOST, PGPORT, PGUSER, PGPASSWORD and PGDATABASE environment variables.
+func TestIntegration(t *testing.T) {
+	if os.Getenv("GRANTED_INTEGRATION_TEST") == "" {
+		t.Skip("GRANTED_INTEGRATION_TEST is not set, skipping integration testing")
+	}
+	ctx := context.Background()
+	p := newIntegrationProvider(t)
+
+	const (
+		subject = "integration-test@example.com"
+		group   = "granted_integration_test_readers"
+	)
+	user := roleName(subject)
+	database := p.database.Get()
+
+	cleanup := func() {
+		var exists bool
+		err := p.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1)`, user).Scan(&exists)
+		require.NoError(t, err)
+		if exists {
+			_, err = p.db.ExecContext(ctx, fmt.Sprintf("DROP OWNED BY %s", pq.QuoteIdentifier(user)))
+			require.NoError(t, err)
+			_, err = p.db.ExecContext(ctx, fmt.Sprintf("DROP ROLE %s", pq.QuoteIdentifier(user)))
+			require.NoError(t, err)
+		}
+		_, err = p.db.ExecContext(ctx, fmt.Sprintf("DROP ROLE IF EXISTS %s", pq.QuoteIdentifier(group)))
+		require.NoError(t, err)
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	_, err := p.db.ExecContext(ctx, fmt.Sprintf("CREATE ROLE %s NOLOGIN", pq.QuoteIdentifier(group)))
+	require.NoError(t, err)
+
+	for _, step := range p.ValidateConfig() {
+		logs := step.Run(ctx)
+		assert.True(t, logs.HasSucceeded(), "config validation %s failed: %v", step.Name, logs)
+	}
+
+	opts, err := p.Options(ctx, "role")
+	require.NoError(t, err)
+	assert.Contains(t, opts.Options, types.Option{Label: group, Value: group})
+
+	opts, err = p.Options(ctx, "database")
+	require.NoError(t, err)
+	assert.Contains(t, opts.Options, types.Option{Label: database, Value: database})
+
+	args := []byte(fmt.Sprintf(`{"role":"%s","database":"%s"}`, group, database))
+
+	// hasConnect returns true if the login role has been granted CONNECT on the database.
+	// PUBLIC can connect to databases by default, so the ACL is checked rather than has_database_privilege.
+	hasConnect := func() bool {
+		var ok bool
+		err := p.db.QueryRowContext(ctx, `SELECT EXISTS (
+			SELECT 1 FROM pg_database d, aclexplode(d.datacl) a
+			JOIN pg_roles r ON a.grantee = r.oid
+			WHERE d.datname = $1 AND r.rolname = $2 AND a.privilege_type = 'CONNECT'
+		)`, database, user).Scan(&ok)
+		require.NoError(t, err)
+		return ok
+	}
+
+	results := p.ValidateGrant().Run(ctx, subject, args)
+	for id, r := range results {
+		assert.True(t, r.Logs.HasSucceeded(), "grant validation %s failed: %v", id, r.Logs)
+	}
+	results = p.ValidateGrant().Run(ctx, subject, []byte(fmt.Sprintf(`{"role":"non-existent","database":"%s"}`, database)))
+	logs := results["role-exists-in-postgres"].Logs
+	assert.False(t, logs.HasSucceeded())
+
+	// the user doesn't have a login role until access is granted.
+	_, err = p.Instructions(ctx, subject, args, "grant1")
+	assert.Equal(t, &UserNotFoundError{User: user}, err)
+
+	err = p.Grant(ctx, subject, args, "grant1")
+	require.NoError(t, err)
+
+	active, err := p.IsActive(ctx, subject, args, "grant1")
+	require.NoError(t, err)
+	assert.True(t, active)
+	assert.True(t, hasConnect())
+
+	instructions, err := p.Instructions(ctx, subject, args, "grant1")
+	require.NoError(t, err)
+	match := regexp.MustCompile(`psql '(.*)'`).FindStringSubmatch(instructions)
+	require.NotNil(t, match, "instructions did not contain a connection string: %s", instructions)
+
+	userDB, err := sql.Open("postgres", match[1])
+	require.NoError(t, err)
+	defer userDB.Close()
+	conn, err := userDB.Conn(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var currentUser string
+	err = conn.QueryRowContext(ctx, "SELECT current_user").Scan(&currentUser)
+	require.NoError(t, err)
+	assert.Equal(t, user, currentUser)
+
+	var isMember bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_has_role($1, 'MEMBER')", group).Scan(&isMember)
+	require.NoError(t, err)
+	assert.True(t, isMember)
+
+	// a second grant for the same role overlaps with the first.
+	err = p.Grant(ctx, subject, args, "grant2")
+	require.NoError(t, err)
+
+	// revoking the first grant must not remove access which the second grant still uses.
+	err = p.Revoke(ctx, subject, args, "grant1")
+	require.NoError(t, err)
+
+	active, err = p.IsActive(ctx, subject, args, "grant1")
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	active, err = p.IsActive(ctx, subject, args, "grant2")
+	require.NoError(t, err)
+	assert.True(t, active)
+
+	err = conn.QueryRowContext(ctx, "SELECT pg_has_role($1, 'MEMBER')", group).Scan(&isMember)
+	require.NoError(t, err)
+	assert.True(t, isMember)
+	assert.True(t, hasConnect())
+
+	err = p.Revoke(ctx, subject, args, "grant2")
+	require.NoError(t, err)
+
+	active, err = p.IsActive(ctx, subject, args, "grant2")
+	require.NoError(t, err)
+	assert.False(t, active)
+	assert.False(t, hasConnect())
+
+	// the open session should have been terminated.
+	err = conn.QueryRowContext(ctx, "SELECT current_user").Scan(&currentUser)
+	assert.Error(t, err)
+
+	// the login role is locked, so new sessions can't be opened.
+	err = userDB.PingContext(ctx)
+	assert.Error(t, err)
+
+	_, err = p.Instructions(ctx, subject, args, "grant2")
+	assert.Equal(t, &UserNotFoundError{User: user}, err)
+
+	// revoking access which has already been revoked should succeed.
+	err = p.Revoke(ctx, subject, args, "grant2")
+	require.NoError(t, err)
+
+	// the locked login role isn't offered as a group role.
+	opts, err = p.Options(ctx, "role")
+	require.NoError(t, err)
+	assert.NotContains(t, opts.Options, types.Option{Label: user, Value: user})
+}
+
+// TestIntegrationUnmanagedRole checks that a role which wasn't created by Granted isn't used as a login role.
+func TestIntegrationUnmanagedRole(t *testing.T) {
+	if os.Getenv("GRANTED_INTEGRATION_TEST") == "" {
+		t.Skip("GRANTED_INTEGRATION_TEST is not set, skipping integration testing")
+	}
+	ctx := context.Background()
+	p := newIntegrationProvider(t)
+
+	const (
+		subject = "integration-test-unmanaged@example.com"
+		group   = "granted_integration_test_unmanaged_readers"
+	)
+	user := roleName(subject)
+
+	cleanup := func() {
+		_, err := p.db.ExecContext(ctx, fmt.Sprintf("DROP ROLE IF EXISTS %s", pq.QuoteIdentifier(user)))
+		require.NoError(t, err)
+		_, err = p.db.ExecContext(ctx, fmt.Sprintf("DROP ROLE IF EXISTS %s", pq.QuoteIdentifier(group)))
+		require.NoError(t, err)
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	_, err := p.db.ExecContext(ctx, fmt.Sprintf("CREATE ROLE %s NOLOGIN", pq.QuoteIdentifier(group)))
+	require.NoError(t, err)
+	_, err = p.db.ExecContext(ctx, fmt.Sprintf("CREATE ROLE %s NOLOGIN", pq.QuoteIdentifier(user)))
+	require.NoError(t, err)
+
+	args := []byte(fmt.Sprintf(`{"role":"%s","database":"%s"}`, group, p.database.Get()))
+	err = p.Grant(ctx, subject, args, "grant1")
+	assert.Equal(t, &RoleNotManagedError{Role: user}, err)
+
+	_, err = p.Instructions(ctx, subject, args, "grant1")
+	assert.Equal(t, &RoleNotManagedError{Role: user}, err)
+
+	var canLogin bool
+	err = p.db.QueryRowContext(ctx, `SELECT rolcanlogin FROM pg_roles WHERE rolname = $1`, user).Scan(&canLogin)
+	require.NoError(t, err)
+	assert.False(t, canLogin)
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"go.uber.org/zap"
+)
+
+// groupRolesQuery lists roles which can't log in, excluding the predefined roles of the server
+// and login roles managed by Granted which are locked.
+const groupRolesQuery = `SELECT rolname FROM pg_roles WHERE NOT rolcanlogin AND rolname NOT LIKE 'pg\_%'
+	AND coalesce(shobj_description(oid, 'pg_authid'), '') NOT LIKE '` + managedRolePrefix + `%'`
+
+// databasesQuery lists databases which users can connect to.
+const databasesQuery = `SELECT datname FROM pg_database WHERE NOT datistemplate AND datallowconn`
+
+// List options for arg
+func (p *Provider) Options(ctx context.Context, arg string) (*types.ArgOptionsResponse, error) {
+	var query string
+	switch arg {
+	case "role":
+		query = groupRolesQuery + " ORDER BY rolname"
+	case "database":
+		query = databasesQuery + " ORDER BY datname"
+	default:
+		return nil, &providers.InvalidArgumentError{Arg: arg}
+	}
+
+	log := zap.S().With("arg", arg)
+	log.Info("getting postgres options")
+	names, err := p.queryNames(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	opts := types.ArgOptionsResponse{Options: []types.Option{}}
+	for _, n := range names {
+		opts.Options = append(opts.Options, types.Option{Label: n, Value: n})
+	}
+	return &opts, nil
+}
+
+// getGroupRole returns a RoleNotFoundError if the role doesn't exist or is a login role.
+func (p *Provider) getGroupRole(ctx context.Context, role string) (string, error) {
+	var name string
+	err := p.db.QueryRowContext(ctx, groupRolesQuery+" AND rolname = $1", role).Scan(&name)
+	if err == sql.ErrNoRows {
+		return "", &RoleNotFoundError{Role: role}
+	}
+	return name, err
+}
+
+// getDatabase returns a DatabaseNotFoundError if the database doesn't exist or doesn't allow connections.
+func (p *Provider) getDatabase(ctx context.Context, database string) (string, error) {
+	var name string
+	err := p.db.QueryRowContext(ctx, databasesQuery+" AND datname = $1", database).Scan(&name)
+	if err == sql.ErrNoRows {
+		return "", &DatabaseNotFoundError{Database: database}
+	}
+	return name, err
+}
+
+func (p *Provider) queryNames(ctx context.Context, query string) ([]string, error) {
+	rows, err := p.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var n string
+		err = rows.Scan(&n)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, n)
+	}
+	return names, rows.Err()
+}
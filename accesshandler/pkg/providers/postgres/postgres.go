@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultPort    = "5432"
+	DefaultSSLMode = "require"
+)
+
+// Provider grants membership of group roles in a PostgreSQL server.
+// Each user connects with their own login role, which is created on their first grant.
+type Provider struct {
+	host     gconfig.StringValue
+	port     gconfig.StringValue
+	database gconfig.StringValue
+	username gconfig.StringValue
+	password gconfig.SecretStringValue
+	sslMode  gconfig.StringValue
+
+	db *sql.DB
+}
+
+func (p *Provider) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("host", &p.host, "the hostname of the PostgreSQL server"),
+		gconfig.StringField("port", &p.port, "the port of the PostgreSQL server", gconfig.WithDefaultFunc(func() string { return DefaultPort })),
+		gconfig.StringField("database", &p.database, "the database the provider connects to when managing roles", gconfig.WithDefaultFunc(func() string { return "postgres" })),
+		gconfig.StringField("username", &p.username, "the user the provider connects as, which must have the CREATEROLE attribute"),
+		gconfig.SecretStringField("password", &p.password, "the password of the user", gconfig.WithArgs("/granted/providers/%s/password", 1)),
+		gconfig.StringField("sslMode", &p.sslMode, "the SSL mode used to connect to the server", gconfig.WithDefaultFunc(func() string { return DefaultSSLMode })),
+	}
+}
+
+// Init the PostgreSQL provider.
+func (p *Provider) Init(ctx context.Context) error {
+	zap.S().Infow("configuring postgres client", "host", p.host, "port", p.port, "database", p.database, "username", p.username)
+
+	db, err := sql.Open("postgres", p.connectionURL(p.username.Get(), p.password.Get(), p.database.Get()))
+	if err != nil {
+		return err
+	}
+	p.db = db
+	zap.S().Info("postgres client configured")
+	return nil
+}
+
+func (p *Provider) ArgSchema() providers.ArgSchema {
+	arg := providers.ArgSchema{
+		"role": {
+			Id:          "role",
+			Title:       "Role",
+			Description: aws.String("The group role which is granted to the user"),
+			FormElement: types.MULTISELECT,
+		},
+		"database": {
+			Id:          "database",
+			Title:       "Database",
+			FormElement: types.MULTISELECT,
+		},
+	}
+	return arg
+}
+
+var _ providers.ActiveChecker = &Provider{}
+
+// connectionURL returns a URL which can be used to connect to the server as the given user.
+func (p *Provider) connectionURL(user, password, database string) string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(user, password),
+		Host:     net.JoinHostPort(p.host.Get(), p.port.Get()),
+		Path:     "/" + database,
+		RawQuery: url.Values{"sslmode": {p.sslMode.Get()}}.Encode(),
+	}
+	return u.String()
+}
+
+// maxIdentifierLength is the maximum length of a PostgreSQL identifier. Longer identifiers are truncated by the server.
+const maxIdentifierLength = 63
+
+// subjectHashLength is the number of hex characters of the subject hash appended to login role names.
+const subjectHashLength = 12
+
+var invalidRoleChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// roleName returns the name of the login role for a user, for example "alice@example.com" becomes
+// "granted_alice_example_com_ff8d9819fc0e". The readable prefix is truncated to fit the identifier limit,
+// and the hash of the full subject keeps names of users whose emails sanitise to the same prefix distinct.
+func roleName(subject string) string {
+	subject = strings.ToLower(subject)
+	sum := sha256.Sum256([]byte(subject))
+	suffix := "_" + hex.EncodeToString(sum[:])[:subjectHashLength]
+
+	prefix := "granted_" + invalidRoleChars.ReplaceAllString(subject, "_")
+	if max := maxIdentifierLength - len(suffix); len(prefix) > max {
+		prefix = prefix[:max]
+	}
+	return prefix + suffix
+}
+
+// managedRolePrefix marks the comment of login roles which were created by Granted.
+// Roles without it are never unlocked or given a password by the provider.
+const managedRolePrefix = "granted-approvals:"
+
+// roleState is stored in the comment of a managed login role.
+// It records the grants which are using the role, so that access which is
+// shared by overlapping grants is only removed when the last of them is revoked.
+type roleState struct {
+	Subject string          `json:"subject"`
+	Grants  map[string]Args `json:"grants"`
+}
+
+// usesRole returns true if any grant in the state is for the group role.
+func (s *roleState) usesRole(role string) bool {
+	for _, a := range s.Grants {
+		if a.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// usesDatabase returns true if any grant in the state is for the database.
+func (s *roleState) usesDatabase(database string) bool {
+	for _, a := range s.Grants {
+		if a.Database == database {
+			return true
+		}
+	}
+	return false
+}
+
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// getRoleState returns the state of the login role for a subject, or nil if the role doesn't exist.
+// A RoleNotManagedError is returned if the role exists but wasn't created by Granted for the subject.
+func getRoleState(ctx context.Context, q queryer, user, subject string) (*roleState, error) {
+	var comment sql.NullString
+	err := q.QueryRowContext(ctx, `SELECT shobj_description(oid, 'pg_authid') FROM pg_roles WHERE rolname = $1`, user).Scan(&comment)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(comment.String, managedRolePrefix) {
+		return nil, &RoleNotManagedError{Role: user}
+	}
+	var state roleState
+	err = json.Unmarshal([]byte(strings.TrimPrefix(comment.String, managedRolePrefix)), &state)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(state.Subject, subject) {
+		return nil, &RoleNotManagedError{Role: user}
+	}
+	if state.Grants == nil {
+		state.Grants = make(map[string]Args)
+	}
+	return &state, nil
+}
+
+// setRoleState stores the state in the comment of the login role.
+func setRoleState(ctx context.Context, tx *sql.Tx, user string, state *roleState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("COMMENT ON ROLE %s IS %s", pq.QuoteIdentifier(user), pq.QuoteLiteral(managedRolePrefix+string(b))))
+	return err
+}
+
+// withRoleLock runs fn in a transaction which holds a lock on the login role,
+// so that concurrent grants and revokes for a user don't overwrite each other's state.
+func (p *Provider) withRoleLock(ctx context.Context, user string, fn func(tx *sql.Tx) error) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, user)
+	if err != nil {
+		return err
+	}
+	err = fn(tx)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleName(t *testing.T) {
+	type testcase struct {
+		name string
+		give string
+		want string
+	}
+
+	testcases := []testcase{
+		{name: "email", give: "alice@example.com", want: "granted_alice_example_com_ff8d9819fc0e"},
+		{name: "uppercase", give: "Alice@Example.com", want: "granted_alice_example_com_ff8d9819fc0e"},
+		{name: "repeated special characters", give: "alice.smith@x.com", want: "granted_alice_smith_x_com_9658d41f3aca"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := roleName(tc.give)
+			assert.Equal(t, tc.want, got)
+			assert.LessOrEqual(t, len(got), maxIdentifierLength)
+		})
+	}
+}
+
+func TestRoleNameIsDistinct(t *testing.T) {
+	subjects := []string{
+		"alice.smith@x.com",
+		"alice_smith@x.com",
+		"alice+smith@x.com",
+		"a-very-long-email-address-which-exceeds-the-limit@example.com",
+		"a-very-long-email-address-which-exceeds-the-limit@example.org",
+	}
+	seen := make(map[string]string)
+	for _, s := range subjects {
+		name := roleName(s)
+		assert.LessOrEqual(t, len(name), maxIdentifierLength)
+		assert.True(t, strings.HasPrefix(name, "granted_"))
+		if other, ok := seen[name]; ok {
+			t.Errorf("%s and %s have the same role name %s", s, other, name)
+		}
+		seen[name] = s
+	}
+}
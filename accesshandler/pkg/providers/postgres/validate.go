@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/diagnostics"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+)
+
+func (p *Provider) ValidateGrant() providers.GrantValidationSteps {
+	return map[string]providers.GrantValidationStep{
+		"role-exists-in-postgres": {
+			UserErrorMessage: "We couldn't find a matching group role in PostgreSQL",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				var a Args
+				err := json.Unmarshal(args, &a)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				_, err = p.getGroupRole(ctx, a.Role)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("Group role exists in PostgreSQL")
+			},
+		},
+		"database-exists-in-postgres": {
+			UserErrorMessage: "We couldn't find a matching database in PostgreSQL",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				var a Args
+				err := json.Unmarshal(args, &a)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				_, err = p.getDatabase(ctx, a.Database)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("Database exists in PostgreSQL")
+			},
+		},
+	}
+}
+
+func (p *Provider) ValidateConfig() map[string]providers.ConfigValidationStep {
+	return map[string]providers.ConfigValidationStep{
+		"connect": {
+			Name:            "Connect to the PostgreSQL server",
+			FieldsValidated: []string{"host", "port", "database", "username", "password", "sslMode"},
+			Run: func(ctx context.Context) diagnostics.Logs {
+				var version string
+				err := p.db.QueryRowContext(ctx, "SHOW server_version").Scan(&version)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("Connected to PostgreSQL %s", version)
+			},
+		},
+		"can-manage-roles": {
+			Name:            "Check that the user can manage roles",
+			FieldsValidated: []string{"username"},
+			Run: func(ctx context.Context) diagnostics.Logs {
+				var createRole bool
+				err := p.db.QueryRowContext(ctx, "SELECT rolcreaterole OR rolsuper FROM pg_roles WHERE rolname = current_user").Scan(&createRole)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				if !createRole {
+					return diagnostics.Error(errors.New("the user must have the CREATEROLE attribute to manage login roles"))
+				}
+				return diagnostics.Info("User %s has the CREATEROLE attribute", p.username.Get())
+			},
+		},
+		"list-roles": {
+			Name: "List PostgreSQL group roles",
+			Run: func(ctx context.Context) diagnostics.Logs {
+				roles, err := p.queryNames(ctx, groupRolesQuery)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("PostgreSQL returned %d group roles", len(roles))
+			},
+		},
+	}
+}
@@ -19,6 +19,15 @@ type Accessor interface {
 	Revoke(ctx context.Context, subject string, args []byte, grantID string) error
 }
 
+// ActiveCheckers can check whether access is currently provisioned in the
+// provider for a particular grant. This is used to detect drift between the
+// status of a grant recorded in Granted and the actual state of the provider,
+// such as a user being manually removed from a group while their grant is active.
+type ActiveChecker interface {
+	// IsActive returns true if the access for the grant is currently provisioned.
+	IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error)
+}
+
 // AccessTokeners can indicate whether they need an access token to be generated
 // as part of the access workflow.
 //
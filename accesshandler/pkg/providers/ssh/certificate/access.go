@@ -0,0 +1,126 @@
+package certificate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/ssh"
+)
+
+type Args struct {
+	// Principals is a comma separated list of the principals of the certificate.
+	Principals string `json:"principals"`
+}
+
+// parseArgs unmarshals the arguments of a grant and checks that each principal is allowed.
+func (p *Provider) parseArgs(args []byte) ([]string, error) {
+	var a Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return nil, err
+	}
+	principals := splitPrincipals(a.Principals)
+	if len(principals) == 0 {
+		return nil, &providers.InvalidArgumentError{Arg: "principals"}
+	}
+	for _, pr := range principals {
+		if !contains(p.allowed, pr) {
+			return nil, &PrincipalNotAllowedError{Principal: pr}
+		}
+	}
+	return principals, nil
+}
+
+// issueForGrant issues the certificate for the grant in the context.
+func (p *Provider) issueForGrant(ctx context.Context, subject string, args []byte, grantID string) (*issued, error) {
+	principals, err := p.parseArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	g, ok := providers.GrantFromContext(ctx)
+	if !ok || g.ID != grantID {
+		return nil, ErrNoGrant
+	}
+	return p.issue(grantID, subject, principals, g.Start.Time, g.End.Time)
+}
+
+// Grant the access by signing a certificate which is valid until the end of the grant.
+// The certificate is delivered to the user in the access instructions.
+func (p *Provider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	iss, err := p.issueForGrant(ctx, subject, args, grantID)
+	if err != nil {
+		return err
+	}
+	zap.S().Infow("signed ssh certificate",
+		"keyId", iss.Cert.KeyId,
+		"serial", iss.Cert.Serial,
+		"principals", iss.Cert.ValidPrincipals,
+		"validBefore", time.Unix(int64(iss.Cert.ValidBefore), 0),
+	)
+	return nil
+}
+
+// Revoke the access by publishing the key ID of the certificate to the KRL endpoint.
+// Certificates expire at the end of the grant, so this only has an effect when access is revoked early,
+// and only on hosts which enforce the KRL with the RevokedKeys option of sshd.
+func (p *Provider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	r := revocation{
+		KeyID:  keyID(grantID, subject),
+		Serial: p.serial(grantID),
+	}
+	if g, ok := providers.GrantFromContext(ctx); ok {
+		r.ValidBefore = &g.End.Time
+	}
+	zap.S().Infow("publishing ssh certificate revocation", "keyId", r.KeyID, "serial", r.Serial)
+	return p.publishRevocation(ctx, r)
+}
+
+// Instructions returns the private key and certificate for the grant.
+// The key is only handed out while the grant is active, so a revoked or expired grant
+// can't be used to obtain a certificate which would only be blocked by the KRL.
+func (p *Provider) Instructions(ctx context.Context, subject string, args []byte, grantId string) (string, error) {
+	g, ok := providers.GrantFromContext(ctx)
+	if !ok {
+		return "", ErrNoGrant
+	}
+	if g.Status != types.GrantStatusACTIVE || !time.Now().Before(g.End.Time) {
+		return "", providers.ErrGrantNotActive
+	}
+	iss, err := p.issueForGrant(ctx, subject, args, grantId)
+	if err != nil {
+		return "", err
+	}
+	keyFile := fmt.Sprintf("~/.ssh/granted_%s", grantId)
+
+	i := "# Certificate\n"
+	i += fmt.Sprintf("Save this private key to `%s`:\n\n", keyFile)
+	i += "```\n"
+	i += string(marshalPrivateKey(iss.Key, iss.Cert.KeyId))
+	i += "```\n"
+	i += fmt.Sprintf("Save this certificate to `%s-cert.pub`:\n\n", keyFile)
+	i += "```\n"
+	i += string(ssh.MarshalAuthorizedKey(iss.Cert))
+	i += "```\n"
+	i += fmt.Sprintf("The certificate expires at %s.\n\n", time.Unix(int64(iss.Cert.ValidBefore), 0).UTC().Format(time.RFC1123))
+	i += "# CLI\n"
+	i += "Connect with ssh, which loads the certificate from the file next to the private key:\n\n"
+	i += "```\n"
+	i += fmt.Sprintf("chmod 600 %s\n", keyFile)
+	i += fmt.Sprintf("ssh -i %s %s@<host>\n", keyFile, iss.Cert.ValidPrincipals[0])
+	i += "```\n"
+	return i, nil
+}
+
+func contains(set []string, str string) bool {
+	for _, s := range set {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package certificate
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// clockSkew is subtracted from the start of the grant when setting valid-after,
+// so that the certificate can be used on hosts with clocks running slightly behind.
+const clockSkew = time.Minute
+
+// issued is a certificate issued for a grant, along with the private key it was issued for.
+type issued struct {
+	Cert *ssh.Certificate
+	Key  ed25519.PrivateKey
+}
+
+// keyID returns the key ID of the certificate for a grant. The key ID is logged by sshd
+// when the certificate is used, and is published to the KRL endpoint when the grant is revoked.
+func keyID(grantID, subject string) string {
+	return fmt.Sprintf("granted:%s:%s", grantID, subject)
+}
+
+// issue signs a certificate for a grant, valid from the start until the end of the grant.
+// The key pair, serial and nonce are derived from the CA key and the grant ID, so issuing
+// a certificate for the same grant again returns an equivalent certificate for the same key.
+func (p *Provider) issue(grantID, subject string, principals []string, start, end time.Time) (*issued, error) {
+	key := ed25519.NewKeyFromSeed(p.derive("user-key", grantID))
+	pub, err := ssh.NewPublicKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	cert := &ssh.Certificate{
+		Key:             pub,
+		Serial:          p.serial(grantID),
+		CertType:        ssh.UserCert,
+		KeyId:           keyID(grantID, subject),
+		ValidPrincipals: principals,
+		ValidAfter:      uint64(start.Add(-clockSkew).Unix()),
+		ValidBefore:     uint64(end.Unix()),
+		Permissions: ssh.Permissions{
+			// these are the extensions which ssh-keygen enables by default.
+			Extensions: map[string]string{
+				"permit-X11-forwarding":   "",
+				"permit-agent-forwarding": "",
+				"permit-port-forwarding":  "",
+				"permit-pty":              "",
+				"permit-user-rc":          "",
+			},
+		},
+	}
+	err = cert.SignCert(&deriveReader{p: p, label: "sign", grantID: grantID}, p.ca)
+	if err != nil {
+		return nil, err
+	}
+	return &issued{Cert: cert, Key: key}, nil
+}
+
+// serial returns the serial number of the certificate for a grant.
+func (p *Provider) serial(grantID string) uint64 {
+	return binary.BigEndian.Uint64(p.derive("serial", grantID))
+}
+
+// derive returns 32 bytes derived from the CA key for the label and grant ID.
+func (p *Provider) derive(label string, grantID string) []byte {
+	mac := hmac.New(sha256.New, []byte(p.caPrivateKey.Get()))
+	mac.Write([]byte(label))
+	mac.Write([]byte{0})
+	mac.Write([]byte(grantID))
+	return mac.Sum(nil)
+}
+
+// deriveReader is a deterministic stream of bytes derived from the CA key, used in place of
+// a random source when signing so that the nonce of a certificate is the same each time it is issued.
+type deriveReader struct {
+	p       *Provider
+	label   string
+	grantID string
+	counter uint32
+	buf     []byte
+}
+
+func (r *deriveReader) Read(b []byte) (int, error) {
+	n := 0
+	for n < len(b) {
+		if len(r.buf) == 0 {
+			r.buf = r.p.derive(fmt.Sprintf("%s-%d", r.label, r.counter), r.grantID)
+			r.counter++
+		}
+		c := copy(b[n:], r.buf)
+		r.buf = r.buf[c:]
+		n += c
+	}
+	return n, nil
+}
+
+// marshalPrivateKey encodes an ed25519 private key in the OpenSSH private key format,
+// which is the format ssh-keygen writes keys in.
+func marshalPrivateKey(key ed25519.PrivateKey, comment string) []byte {
+	pub := key.Public().(ed25519.PublicKey)
+	pubKey := struct {
+		KeyType string
+		Pub     []byte
+	}{ssh.KeyAlgoED25519, pub}
+
+	// the check ints are used to verify decryption, which we don't use as the key isn't encrypted.
+	check := binary.BigEndian.Uint32(pub)
+	privKey := struct {
+		Check1  uint32
+		Check2  uint32
+		KeyType string
+		Pub     []byte
+		Priv    []byte
+		Comment string
+		Pad     []byte `ssh:"rest"`
+	}{
+		Check1:  check,
+		Check2:  check,
+		KeyType: ssh.KeyAlgoED25519,
+		Pub:     pub,
+		Priv:    key,
+		Comment: comment,
+	}
+	// the private section is padded to the cipher block size, which is 8 for unencrypted keys.
+	for i := 1; len(ssh.Marshal(privKey))%8 != 0; i++ {
+		privKey.Pad = append(privKey.Pad, byte(i))
+	}
+
+	w := struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{
+		CipherName:   "none",
+		KdfName:      "none",
+		NumKeys:      1,
+		PubKey:       ssh.Marshal(pubKey),
+		PrivKeyBlock: ssh.Marshal(privKey),
+	}
+	b := append([]byte("openssh-key-v1\x00"), ssh.Marshal(w)...)
+	return pem.EncodeToMemory(&pem.Block{Type: "OPENSSH PRIVATE KEY", Bytes: b})
+}
@@ -0,0 +1,127 @@
+package certificate
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/ssh"
+)
+
+// requestTimeout is the timeout for publishing revoked certificates to the KRL endpoint.
+const requestTimeout = 10 * time.Second
+
+// Provider issues short-lived SSH user certificates signed by a certificate authority.
+//
+// Certificates are derived deterministically from the CA key and the grant, so that the
+// certificate signed when access is granted can be delivered later in the access instructions
+// without the provider storing any state. Certificates are only delivered while the grant is active.
+//
+// Revoking a grant publishes the certificate to a key revocation list (KRL) endpoint. Hosts must
+// enforce the KRL with the RevokedKeys option of sshd, otherwise a certificate remains usable
+// until it expires at the end of the grant even if the grant is revoked early.
+type Provider struct {
+	caPrivateKey gconfig.SecretStringValue
+	principals   gconfig.StringValue
+	krlURL       gconfig.StringValue
+	krlToken     gconfig.SecretStringValue
+
+	httpClient *http.Client
+	ca         ssh.Signer
+	allowed    []string
+}
+
+func (p *Provider) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.SecretStringField("caPrivateKey", &p.caPrivateKey, "the private key of the SSH certificate authority", gconfig.WithArgs("/granted/providers/%s/caPrivateKey", 1)),
+		gconfig.StringField("principals", &p.principals, "a comma separated list of the principals which users can request"),
+		gconfig.StringField("krlUrl", &p.krlURL, "the HTTPS URL that revoked certificates are published to"),
+		gconfig.SecretStringField("krlToken", &p.krlToken, "the bearer token used to authenticate to the KRL endpoint", gconfig.WithArgs("/granted/providers/%s/krlToken", 1)),
+	}
+}
+
+// Init the SSH certificate provider.
+func (p *Provider) Init(ctx context.Context) error {
+	zap.S().Infow("configuring ssh certificate provider", "principals", p.principals, "krlUrl", p.krlURL)
+
+	ca, err := parseCAKey(p.caPrivateKey.Get())
+	if err != nil {
+		return err
+	}
+	p.ca = ca
+	p.allowed = splitPrincipals(p.principals.Get())
+	if len(p.allowed) == 0 {
+		return errNoPrincipals
+	}
+	err = validateKRLURL(p.krlURL.Get())
+	if err != nil {
+		return err
+	}
+	if p.httpClient == nil {
+		p.httpClient = &http.Client{Timeout: requestTimeout}
+	}
+	zap.S().Infow("ssh certificate provider configured", "ca", ssh.FingerprintSHA256(ca.PublicKey()))
+	return nil
+}
+
+func (p *Provider) ArgSchema() providers.ArgSchema {
+	arg := providers.ArgSchema{
+		"principals": {
+			Id:          "principals",
+			Title:       "Principals",
+			Description: aws.String("The usernames the certificate is valid for"),
+			FormElement: types.MULTISELECT,
+		},
+	}
+	return arg
+}
+
+var _ providers.AccessTokener = &Provider{}
+
+// RequiresAccessToken is true, so that Granted issues an access token alongside each certificate.
+// The access token expires when the grant ends, at the same time as the certificate, and can be
+// verified by bastion hosts against Granted's access token endpoint.
+func (p *Provider) RequiresAccessToken() bool {
+	return true
+}
+
+// parseCAKey parses the PEM encoded private key of the certificate authority.
+func parseCAKey(s string) (ssh.Signer, error) {
+	// private keys stored in environment variables and SSM often have escaped newlines.
+	signer, err := ssh.ParsePrivateKey([]byte(strings.ReplaceAll(s, `\n`, "\n")))
+	if err != nil {
+		return nil, &InvalidCAKeyError{Err: err}
+	}
+	return signer, nil
+}
+
+// splitPrincipals splits a comma separated list of principals, ignoring empty entries.
+func splitPrincipals(s string) []string {
+	var principals []string
+	for _, pr := range strings.Split(s, ",") {
+		pr = strings.TrimSpace(pr)
+		if pr != "" {
+			principals = append(principals, pr)
+		}
+	}
+	return principals
+}
+
+func validateKRLURL(krlURL string) error {
+	u, err := url.Parse(krlURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" {
+		return errors.New("KRL URL must use https scheme")
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package certificate
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+func newTestProvider(t *testing.T, handler http.HandlerFunc) *Provider {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+	p := &Provider{
+		principals: gconfig.StringValue{Value: "ubuntu, ec2-user"},
+		krlURL:     gconfig.StringValue{Value: server.URL + "/krl"},
+		httpClient: server.Client(),
+	}
+	p.caPrivateKey.Set(string(marshalPrivateKey(key, "ca")))
+	p.krlToken.Set("token")
+	err = p.Init(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func testGrant(start, end time.Time) types.Grant {
+	return types.Grant{
+		ID:       "gra_1",
+		Status:   types.GrantStatusACTIVE,
+		Subject:  "alice@example.com",
+		Provider: "ssh-certificate",
+		Start:    iso8601.New(start),
+		End:      iso8601.New(end),
+	}
+}
+
+// parseInstructions returns the private key and certificate from the access instructions.
+func parseInstructions(t *testing.T, instructions string) (ssh.Signer, *ssh.Certificate) {
+	blocks := strings.Split(instructions, "```\n")
+	if len(blocks) < 4 {
+		t.Fatalf("expected code blocks in instructions: %s", instructions)
+	}
+	signer, err := ssh.ParsePrivateKey([]byte(blocks[1]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(blocks[3]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, ok := pub.(*ssh.Certificate)
+	if !ok {
+		t.Fatalf("expected a certificate but got %s", pub.Type())
+	}
+	return signer, cert
+}
+
+func TestInstructions(t *testing.T) {
+	p := newTestProvider(t, nil)
+	start := time.Now().Truncate(time.Second)
+	end := start.Add(time.Hour)
+	ctx := providers.WithGrant(context.Background(), testGrant(start, end))
+	args := []byte(`{"principals":"ubuntu"}`)
+
+	err := p.Grant(ctx, "alice@example.com", args, "gra_1")
+	assert.NoError(t, err)
+
+	instructions, err := p.Instructions(ctx, "alice@example.com", args, "gra_1")
+	assert.NoError(t, err)
+	signer, cert := parseInstructions(t, instructions)
+
+	assert.Equal(t, signer.PublicKey().Marshal(), cert.Key.Marshal())
+	assert.Equal(t, []string{"ubuntu"}, cert.ValidPrincipals)
+	assert.Equal(t, "granted:gra_1:alice@example.com", cert.KeyId)
+	assert.Equal(t, uint64(end.Unix()), cert.ValidBefore)
+	assert.Equal(t, uint64(start.Add(-clockSkew).Unix()), cert.ValidAfter)
+
+	checker := ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return string(auth.Marshal()) == string(p.ca.PublicKey().Marshal())
+		},
+	}
+	_, err = checker.Authenticate(connMetadata{user: "ubuntu"}, cert)
+	assert.NoError(t, err)
+	_, err = checker.Authenticate(connMetadata{user: "root"}, cert)
+	assert.Error(t, err)
+
+	// the certificate is derived from the grant, so the instructions are the same each time they are loaded.
+	again, err := p.Instructions(ctx, "alice@example.com", args, "gra_1")
+	assert.NoError(t, err)
+	assert.Equal(t, instructions, again)
+}
+
+func TestInstructionsRequireActiveGrant(t *testing.T) {
+	p := newTestProvider(t, nil)
+	args := []byte(`{"principals":"ubuntu"}`)
+
+	for _, status := range []types.GrantStatus{types.GrantStatusPENDING, types.GrantStatusREVOKED, types.GrantStatusEXPIRED, types.GrantStatusERROR} {
+		t.Run(string(status), func(t *testing.T) {
+			g := testGrant(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+			g.Status = status
+			_, err := p.Instructions(providers.WithGrant(context.Background(), g), "alice@example.com", args, "gra_1")
+			assert.Equal(t, providers.ErrGrantNotActive, err)
+		})
+	}
+
+	t.Run("ended", func(t *testing.T) {
+		g := testGrant(time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+		_, err := p.Instructions(providers.WithGrant(context.Background(), g), "alice@example.com", args, "gra_1")
+		assert.Equal(t, providers.ErrGrantNotActive, err)
+	})
+
+	t.Run("different grant", func(t *testing.T) {
+		g := testGrant(time.Now(), time.Now().Add(time.Hour))
+		_, err := p.Instructions(providers.WithGrant(context.Background(), g), "alice@example.com", args, "gra_2")
+		assert.Equal(t, ErrNoGrant, err)
+	})
+}
+
+func TestGrantErrors(t *testing.T) {
+	type testcase struct {
+		name      string
+		withGrant bool
+		args      string
+		wantErr   error
+	}
+	testcases := []testcase{
+		{name: "no grant in context", args: `{"principals":"ubuntu"}`, wantErr: ErrNoGrant},
+		{name: "principal not allowed", withGrant: true, args: `{"principals":"ubuntu,root"}`, wantErr: &PrincipalNotAllowedError{Principal: "root"}},
+		{name: "no principals", withGrant: true, args: `{"principals":""}`, wantErr: &providers.InvalidArgumentError{Arg: "principals"}},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newTestProvider(t, nil)
+			ctx := context.Background()
+			if tc.withGrant {
+				ctx = providers.WithGrant(ctx, testGrant(time.Now(), time.Now().Add(time.Hour)))
+			}
+			err := p.Grant(ctx, "alice@example.com", []byte(tc.args), "gra_1")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	var got revocation
+	var auth string
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/krl", r.URL.Path)
+		auth = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&got)
+	})
+	end := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	ctx := providers.WithGrant(context.Background(), testGrant(time.Now(), end))
+
+	err := p.Revoke(ctx, "alice@example.com", []byte(`{"principals":"ubuntu"}`), "gra_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer token", auth)
+	assert.Equal(t, "granted:gra_1:alice@example.com", got.KeyID)
+	assert.Equal(t, p.serial("gra_1"), got.Serial)
+	if assert.NotNil(t, got.ValidBefore) {
+		assert.True(t, end.Equal(*got.ValidBefore))
+	}
+}
+
+func TestRevokeError(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	err := p.Revoke(context.Background(), "alice@example.com", []byte(`{"principals":"ubuntu"}`), "gra_1")
+	assert.Error(t, err)
+}
+
+func TestOptions(t *testing.T) {
+	p := newTestProvider(t, nil)
+	got, err := p.Options(context.Background(), "principals")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.Option{{Label: "ubuntu", Value: "ubuntu"}, {Label: "ec2-user", Value: "ec2-user"}}, got.Options)
+
+	_, err = p.Options(context.Background(), "other")
+	assert.Equal(t, &providers.InvalidArgumentError{Arg: "other"}, err)
+}
+
+// connMetadata is a minimal ssh.ConnMetadata for checking certificates.
+type connMetadata struct {
+	ssh.ConnMetadata
+	user string
+}
+
+func (c connMetadata) User() string { return c.user }
@@ -0,0 +1,28 @@
+package certificate
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoGrant is returned when the provider is called without the grant in the context,
+// or with a different grant, as the certificate can't be aligned to the end of the grant.
+var ErrNoGrant = errors.New("the grant start and end times are required to issue an SSH certificate")
+
+var errNoPrincipals = errors.New("at least one principal must be configured")
+
+type InvalidCAKeyError struct {
+	Err error
+}
+
+func (e *InvalidCAKeyError) Error() string {
+	return fmt.Sprintf("could not parse the SSH certificate authority private key: %s", e.Err)
+}
+
+type PrincipalNotAllowedError struct {
+	Principal string
+}
+
+func (e *PrincipalNotAllowedError) Error() string {
+	return fmt.Sprintf("principal %s is not in the list of principals configured for the provider", e.Principal)
+}
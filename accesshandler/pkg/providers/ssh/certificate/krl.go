@@ -0,0 +1,47 @@
+package certificate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// revocation is the payload published to the KRL endpoint when a certificate is revoked.
+//
+// The receiver should add the key ID to the key revocation list distributed to hosts,
+// which can be referenced in sshd_config with the RevokedKeys option.
+// Entries can be removed from the list once ValidBefore has passed, as the certificate will have expired.
+type revocation struct {
+	KeyID       string     `json:"keyId"`
+	Serial      uint64     `json:"serial"`
+	ValidBefore *time.Time `json:"validBefore,omitempty"`
+}
+
+// publishRevocation posts a revocation to the KRL endpoint, authenticated with the KRL token.
+func (p *Provider) publishRevocation(ctx context.Context, r revocation) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.krlURL.Get(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.krlToken.Get())
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("KRL endpoint returned status %d: %s", res.StatusCode, string(b))
+	}
+	return nil
+}
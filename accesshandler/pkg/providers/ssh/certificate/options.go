@@ -0,0 +1,21 @@
+package certificate
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+)
+
+// List options for arg
+func (p *Provider) Options(ctx context.Context, arg string) (*types.ArgOptionsResponse, error) {
+	switch arg {
+	case "principals":
+		opts := types.ArgOptionsResponse{Options: []types.Option{}}
+		for _, pr := range p.allowed {
+			opts.Options = append(opts.Options, types.Option{Label: pr, Value: pr})
+		}
+		return &opts, nil
+	}
+	return nil, &providers.InvalidArgumentError{Arg: arg}
+}
@@ -0,0 +1,53 @@
+package certificate
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/diagnostics"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"golang.org/x/crypto/ssh"
+)
+
+func (p *Provider) ValidateGrant() providers.GrantValidationSteps {
+	return map[string]providers.GrantValidationStep{
+		"principals-are-allowed": {
+			UserErrorMessage: "The requested principals aren't allowed by the SSH certificate provider",
+			Run: func(ctx context.Context, subject string, args []byte) diagnostics.Logs {
+				principals, err := p.parseArgs(args)
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("Principals %v are allowed", principals)
+			},
+		},
+	}
+}
+
+func (p *Provider) ValidateConfig() map[string]providers.ConfigValidationStep {
+	return map[string]providers.ConfigValidationStep{
+		"parse-ca-key": {
+			Name:            "Parse the SSH certificate authority private key",
+			FieldsValidated: []string{"caPrivateKey"},
+			Run: func(ctx context.Context) diagnostics.Logs {
+				ca, err := parseCAKey(p.caPrivateKey.Get())
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				logs := diagnostics.Info("Parsed %s certificate authority with fingerprint %s", ca.PublicKey().Type(), ssh.FingerprintSHA256(ca.PublicKey()))
+				logs.Info("Add this public key to the TrustedUserCAKeys file of your hosts: %s", string(ssh.MarshalAuthorizedKey(ca.PublicKey())))
+				return logs
+			},
+		},
+		"validate-principals": {
+			Name:            "Validate the principals",
+			FieldsValidated: []string{"principals"},
+			Run: func(ctx context.Context) diagnostics.Logs {
+				principals := splitPrincipals(p.principals.Get())
+				if len(principals) == 0 {
+					return diagnostics.Error(errNoPrincipals)
+				}
+				return diagnostics.Info("Users can request certificates for %d principals", len(principals))
+			},
+		},
+	}
+}
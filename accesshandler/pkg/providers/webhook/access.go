@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+// Grant the access by sending a grant payload to the webhook receiver.
+func (p *Provider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	zap.S().Infow("sending grant webhook", "subject", subject, "grantId", grantID)
+	return p.send(ctx, payload{Action: "grant", Subject: subject, Args: args, GrantID: grantID}, nil)
+}
+
+// Revoke the access by sending a revoke payload to the webhook receiver.
+func (p *Provider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	zap.S().Infow("sending revoke webhook", "subject", subject, "grantId", grantID)
+	return p.send(ctx, payload{Action: "revoke", Subject: subject, Args: args, GrantID: grantID}, nil)
+}
+
+// IsActive asks the webhook receiver whether the access is currently provisioned.
+func (p *Provider) IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error) {
+	var res struct {
+		Active *bool `json:"active"`
+	}
+	err := p.send(ctx, payload{Action: "is-active", Subject: subject, Args: args, GrantID: grantID}, &res)
+	if err != nil {
+		return false, err
+	}
+	if res.Active == nil {
+		return false, errors.New("webhook response to is-active must include an 'active' field")
+	}
+	return *res.Active, nil
+}
+
+// Instructions returns the instructions from the webhook receiver on how to use the access.
+func (p *Provider) Instructions(ctx context.Context, subject string, args []byte, grantID string) (string, error) {
+	var res struct {
+		Instructions string `json:"instructions"`
+	}
+	err := p.send(ctx, payload{Action: "instructions", Subject: subject, Args: args, GrantID: grantID}, &res)
+	if err != nil {
+		return "", err
+	}
+	return res.Instructions, nil
+}
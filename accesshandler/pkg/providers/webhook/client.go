@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// SignatureHeader contains the HMAC-SHA256 signature of the timestamp and body of the payload.
+	SignatureHeader = "X-Granted-Signature"
+	// TimestampHeader contains the Unix time that the payload was signed at.
+	TimestampHeader = "X-Granted-Timestamp"
+
+	requestTimeout = 10 * time.Second
+	maxAttempts    = 3
+)
+
+// payload is the body of a webhook request.
+type payload struct {
+	Action  string          `json:"action"`
+	Subject string          `json:"subject,omitempty"`
+	Args    json.RawMessage `json:"args,omitempty"`
+	GrantID string          `json:"grantId,omitempty"`
+	Arg     string          `json:"arg,omitempty"`
+}
+
+// Sign returns the signature of a webhook payload, which is the hex encoded HMAC-SHA256
+// of the timestamp and the body joined with a period, prefixed with "sha256=".
+// Receivers should compute the signature in the same way and compare it to the SignatureHeader.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryableError is returned for responses which may succeed if the request is retried.
+type retryableError struct {
+	err error
+}
+
+func (e retryableError) Error() string {
+	return e.err.Error()
+}
+
+// send posts a signed payload to the endpoint for the action, retrying on network errors,
+// 429 and 5xx responses. Receivers should handle duplicate grant and revoke payloads for the same grant ID.
+func (p *Provider) send(ctx context.Context, pl payload, out interface{}) error {
+	body, err := json.Marshal(pl)
+	if err != nil {
+		return err
+	}
+	endpoint := strings.TrimSuffix(p.url.Get(), "/") + "/" + pl.Action
+	log := zap.S().With("action", pl.Action, "grantId", pl.GrantID)
+
+	backoff := 500 * time.Millisecond
+	for attempt := 1; ; attempt++ {
+		err = p.post(ctx, endpoint, body, out)
+		if _, ok := err.(retryableError); !ok || attempt == maxAttempts {
+			return err
+		}
+		log.Warnw("webhook request failed, retrying", "attempt", attempt, "error", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (p *Provider) post(ctx context.Context, endpoint string, body []byte, out interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(p.signingSecret.Get(), timestamp, body))
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return retryableError{err: err}
+	}
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return retryableError{err: err}
+	}
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500 {
+		return retryableError{err: fmt.Errorf("webhook returned %d: %s", res.StatusCode, string(b))}
+	}
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %d: %s", res.StatusCode, string(b))
+	}
+	if out == nil || len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
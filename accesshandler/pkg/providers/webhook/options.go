@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"go.uber.org/zap"
+)
+
+// List options for arg. Options are loaded from the webhook receiver for arguments declared in the arg schema.
+func (p *Provider) Options(ctx context.Context, arg string) (*types.ArgOptionsResponse, error) {
+	if _, ok := p.ArgSchema()[arg]; !ok {
+		return nil, &providers.InvalidArgumentError{Arg: arg}
+	}
+	zap.S().Infow("getting webhook options", "arg", arg)
+	var res struct {
+		Options []types.Option `json:"options"`
+	}
+	err := p.send(ctx, payload{Action: "options", Arg: arg}, &res)
+	if err != nil {
+		return nil, err
+	}
+	opts := types.ArgOptionsResponse{Options: res.Options}
+	if opts.Options == nil {
+		opts.Options = []types.Option{}
+	}
+	return &opts, nil
+}
@@ -0,0 +1,35 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/diagnostics"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+)
+
+func (p *Provider) ValidateConfig() map[string]providers.ConfigValidationStep {
+	return map[string]providers.ConfigValidationStep{
+		"url-uses-https": {
+			Name:            "Check the webhook URL uses HTTPS",
+			FieldsValidated: []string{"url"},
+			Run: func(ctx context.Context) diagnostics.Logs {
+				err := validateURL(p.url.Get())
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("Webhook URL %s uses HTTPS", p.url.Get())
+			},
+		},
+		"parse-arg-schema": {
+			Name:            "Parse the argument schema",
+			FieldsValidated: []string{"argSchema"},
+			Run: func(ctx context.Context) diagnostics.Logs {
+				schema, err := parseArgSchema(p.argSchema.Get())
+				if err != nil {
+					return diagnostics.Error(err)
+				}
+				return diagnostics.Info("The argument schema declares %d arguments", len(schema))
+			},
+		},
+	}
+}
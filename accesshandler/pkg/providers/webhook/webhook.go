@@ -0,0 +1,124 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Provider forwards access to an in-house system through signed webhooks.
+// Each call to the provider is sent as a JSON payload to an endpoint under the configured URL,
+// signed with an HMAC of the signing secret so that the receiver can verify it came from Granted.
+type Provider struct {
+	url           gconfig.StringValue
+	signingSecret gconfig.SecretStringValue
+	argSchema     gconfig.StringValue
+
+	httpClient *http.Client
+	args       providers.ArgSchema
+}
+
+func (p *Provider) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("url", &p.url, "the HTTPS URL of the webhook receiver"),
+		gconfig.SecretStringField("signingSecret", &p.signingSecret, "the secret used to sign webhook payloads", gconfig.WithArgs("/granted/providers/%s/signingSecret", 1)),
+		gconfig.StringField("argSchema", &p.argSchema, "a JSON object declaring the arguments of the provider", gconfig.WithDefaultFunc(func() string { return "{}" })),
+	}
+}
+
+// Init the webhook provider.
+func (p *Provider) Init(ctx context.Context) error {
+	zap.S().Infow("configuring webhook provider", "url", p.url)
+
+	err := validateURL(p.url.Get())
+	if err != nil {
+		return err
+	}
+	args, err := parseArgSchema(p.argSchema.Get())
+	if err != nil {
+		return err
+	}
+	p.args = args
+	if p.httpClient == nil {
+		p.httpClient = &http.Client{Timeout: requestTimeout}
+	}
+	return nil
+}
+
+// ArgSchema returns the arguments declared in the argSchema config of the provider.
+// The schema is only available once the provider has been initialised.
+func (p *Provider) ArgSchema() providers.ArgSchema {
+	if p.args == nil {
+		return providers.ArgSchema{}
+	}
+	return p.args
+}
+
+var _ providers.ActiveChecker = &Provider{}
+
+// argument is the declaration of an argument in the argSchema config.
+type argument struct {
+	Title       string                    `json:"title"`
+	Description *string                   `json:"description,omitempty"`
+	FormElement types.ArgumentFormElement `json:"formElement"`
+}
+
+// parseArgSchema parses the argSchema config, which is a JSON object keyed by argument ID, such as:
+//
+//	{"role": {"title": "Role", "formElement": "MULTISELECT"}}
+//
+// The options for MULTISELECT arguments are loaded from the webhook receiver.
+func parseArgSchema(s string) (providers.ArgSchema, error) {
+	var declared map[string]argument
+	err := json.Unmarshal([]byte(s), &declared)
+	if err != nil {
+		return nil, errors.Wrap(err, "argSchema must be a JSON object")
+	}
+	// sort the IDs so that errors are reported consistently.
+	ids := make([]string, 0, len(declared))
+	for id := range declared {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	schema := providers.ArgSchema{}
+	for _, id := range ids {
+		a := declared[id]
+		if a.Title == "" {
+			return nil, fmt.Errorf("argument %s must have a title", id)
+		}
+		if a.FormElement == "" {
+			a.FormElement = types.INPUT
+		}
+		if a.FormElement != types.INPUT && a.FormElement != types.MULTISELECT {
+			return nil, fmt.Errorf("argument %s has an unsupported formElement %s, it must be INPUT or MULTISELECT", id, a.FormElement)
+		}
+		schema[id] = types.Argument{
+			Id:          id,
+			Title:       a.Title,
+			Description: a.Description,
+			FormElement: a.FormElement,
+		}
+	}
+	return schema, nil
+}
+
+func validateURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "https" {
+		return errors.New("webhook URL must use https scheme")
+	}
+	return nil
+}
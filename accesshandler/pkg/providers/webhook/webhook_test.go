@@ -0,0 +1,128 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestProvider(t *testing.T, handler http.HandlerFunc) *Provider {
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+	p := &Provider{
+		url:        gconfig.StringValue{Value: server.URL + "/granted"},
+		argSchema:  gconfig.StringValue{Value: `{"role": {"title": "Role", "formElement": "MULTISELECT"}}`},
+		httpClient: server.Client(),
+	}
+	p.signingSecret.Set("secret")
+	err := p.Init(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestGrant(t *testing.T) {
+	var got payload
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/granted/grant", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		// the receiver can verify the signature using the signing secret.
+		assert.Equal(t, Sign("secret", r.Header.Get(TimestampHeader), body), r.Header.Get(SignatureHeader))
+		_ = json.Unmarshal(body, &got)
+	})
+
+	err := p.Grant(context.Background(), "alice@example.com", []byte(`{"role":"admin"}`), "gra_1")
+	assert.NoError(t, err)
+	assert.Equal(t, payload{Action: "grant", Subject: "alice@example.com", Args: json.RawMessage(`{"role":"admin"}`), GrantID: "gra_1"}, got)
+}
+
+func TestRetries(t *testing.T) {
+	type testcase struct {
+		name         string
+		statuses     []int
+		wantAttempts int
+		wantErr      bool
+	}
+	testcases := []testcase{
+		{name: "retries server errors", statuses: []int{500, 200}, wantAttempts: 2},
+		{name: "retries rate limits", statuses: []int{429, 503, 200}, wantAttempts: 3},
+		{name: "gives up after max attempts", statuses: []int{500, 500, 500, 200}, wantAttempts: 3, wantErr: true},
+		{name: "doesn't retry client errors", statuses: []int{400, 200}, wantAttempts: 1, wantErr: true},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statuses[attempts])
+				attempts++
+			})
+			err := p.Revoke(context.Background(), "alice@example.com", []byte(`{}`), "gra_1")
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.wantAttempts, attempts)
+		})
+	}
+}
+
+func TestIsActiveAndInstructions(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/granted/is-active":
+			_, _ = w.Write([]byte(`{"active": true}`))
+		case "/granted/instructions":
+			_, _ = w.Write([]byte(`{"instructions": "sign in at https://tool.internal"}`))
+		}
+	})
+
+	active, err := p.IsActive(context.Background(), "alice@example.com", []byte(`{}`), "gra_1")
+	assert.NoError(t, err)
+	assert.True(t, active)
+
+	got, err := p.Instructions(context.Background(), "alice@example.com", []byte(`{}`), "gra_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "sign in at https://tool.internal", got)
+}
+
+func TestOptions(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		var pl payload
+		_ = json.NewDecoder(r.Body).Decode(&pl)
+		assert.Equal(t, payload{Action: "options", Arg: "role"}, pl)
+		_, _ = w.Write([]byte(`{"options": [{"label": "Admin", "value": "admin"}]}`))
+	})
+
+	got, err := p.Options(context.Background(), "role")
+	assert.NoError(t, err)
+	assert.Equal(t, &types.ArgOptionsResponse{Options: []types.Option{{Label: "Admin", Value: "admin"}}}, got)
+
+	_, err = p.Options(context.Background(), "other")
+	assert.Equal(t, &providers.InvalidArgumentError{Arg: "other"}, err)
+}
+
+func TestParseArgSchema(t *testing.T) {
+	desc := "The role to grant"
+	got, err := parseArgSchema(`{"role": {"title": "Role", "description": "The role to grant", "formElement": "MULTISELECT"}, "reason": {"title": "Reason"}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, providers.ArgSchema{
+		"role":   {Id: "role", Title: "Role", Description: &desc, FormElement: types.MULTISELECT},
+		"reason": {Id: "reason", Title: "Reason", FormElement: types.INPUT},
+	}, got)
+
+	_, err = parseArgSchema(`{"role": {"formElement": "INPUT"}}`)
+	assert.Equal(t, errors.New("argument role must have a title"), err)
+
+	_, err = parseArgSchema(`{"role": {"title": "Role", "formElement": "SELECT"}}`)
+	assert.EqualError(t, err, "argument role has an unsupported formElement SELECT, it must be INPUT or MULTISELECT")
+
+	_, err = parseArgSchema(`[]`)
+	assert.Error(t, err)
+}
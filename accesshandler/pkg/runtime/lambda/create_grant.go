@@ -16,6 +16,9 @@ import (
 // WorkflowInput is the input to the Step Functions workflow execution
 type WorkflowInput struct {
 	Grant types.Grant `json:"grant"`
+	// SkipActivation is set when a grant which is already active is extended,
+	// so that the workflow only waits for the new end time before deactivating it.
+	SkipActivation bool `json:"skipActivation"`
 }
 
 // CreateGrant creates a new grant.
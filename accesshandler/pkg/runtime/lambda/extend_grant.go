@@ -0,0 +1,142 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aws_config "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfntypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/iso8601"
+)
+
+// ExtendGrant reschedules the deactivation of a grant.
+//
+// Step Functions executions can't be modified once they have started, so a new execution
+// is started with the updated end time and the previous execution is stopped. If the grant
+// is already active, the new execution skips straight to waiting for the end of the window.
+func (r *Runtime) ExtendGrant(ctx context.Context, grantID string, end time.Time) (*types.Grant, error) {
+	logger.Get(ctx).Infow("extending grant", "grant", grantID, "end", end)
+
+	c, err := aws_config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sfnClient := sfn.NewFromConfig(c)
+
+	exe, err := findGrantExecution(ctx, sfnClient, r.GranterStateMachineARN, grantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var wi WorkflowInput
+	err = json.Unmarshal([]byte(aws.ToString(exe.Input)), &wi)
+	if err != nil {
+		return nil, err
+	}
+	grant := wi.Grant
+	grant.Status = grantStatusFromExecution(exe.Status, grant, time.Now())
+
+	err = grant.ValidateExtension(end)
+	if err != nil {
+		return nil, err
+	}
+	grant.End = iso8601.New(end)
+
+	in := WorkflowInput{Grant: grant, SkipActivation: grant.Status == types.GrantStatusACTIVE}
+	inJson, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// start the new execution before stopping the previous one, so that the
+	// grant always has a running execution which will deactivate it.
+	_, extension := parseExecutionName(aws.ToString(exe.Name))
+	_, err = sfnClient.StartExecution(ctx, &sfn.StartExecutionInput{
+		StateMachineArn: aws.String(r.StateMachineARN),
+		Input:           aws.String(string(inJson)),
+		Name:            aws.String(executionName(grantID, extension+1)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = sfnClient.StopExecution(ctx, &sfn.StopExecutionInput{ExecutionArn: exe.ExecutionArn})
+	if err != nil {
+		return nil, err
+	}
+
+	return &grant, nil
+}
+
+// executionName returns the name of the execution for a grant. The execution started when the grant
+// is created is named after the grant, and each extension is numbered after the execution it replaces,
+// so the execution which replaced an execution can be found from its name.
+func executionName(grantID string, extension int) string {
+	if extension == 0 {
+		return grantID
+	}
+	return fmt.Sprintf("%s-ext-%d", grantID, extension)
+}
+
+// parseExecutionName returns the grant ID and extension number from the name of an execution.
+func parseExecutionName(name string) (grantID string, extension int) {
+	i := strings.LastIndex(name, "-ext-")
+	if i == -1 {
+		return name, 0
+	}
+	n, err := strconv.Atoi(name[i+len("-ext-"):])
+	if err != nil {
+		return name, 0
+	}
+	return name[:i], n
+}
+
+// describeExtension returns the execution which replaced an execution when its grant was extended,
+// or nil if the grant hasn't been extended since the execution was started.
+func describeExtension(ctx context.Context, client *sfn.Client, stateMachineARN string, name string) (*sfn.DescribeExecutionOutput, error) {
+	grantID, extension := parseExecutionName(name)
+	exeARN := BuildExecutionARN(stateMachineARN, executionName(grantID, extension+1))
+	out, err := client.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: aws.String(exeARN)})
+	var dne *sfntypes.ExecutionDoesNotExist
+	if errors.As(err, &dne) {
+		return nil, nil
+	}
+	return out, err
+}
+
+// findGrantExecution returns the current execution for a grant. If the grant
+// has been extended, this is the latest extension execution, otherwise it is
+// the execution which was started when the grant was created.
+func findGrantExecution(ctx context.Context, client *sfn.Client, stateMachineARN string, grantID string) (*sfn.DescribeExecutionOutput, error) {
+	exeARN := BuildExecutionARN(stateMachineARN, grantID)
+	out, err := client.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: aws.String(exeARN)})
+	var dne *sfntypes.ExecutionDoesNotExist
+	if errors.As(err, &dne) {
+		return nil, types.ErrGrantNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the previous execution is stopped when a grant is extended, so follow the extensions of stopped executions.
+	for out.Status == sfntypes.ExecutionStatusAborted {
+		next, err := describeExtension(ctx, client, stateMachineARN, aws.ToString(out.Name))
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
+			break
+		}
+		out = next
+	}
+	return out, nil
+}
@@ -0,0 +1,30 @@
+package lambda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionName(t *testing.T) {
+	testcases := []struct {
+		name      string
+		grantID   string
+		extension int
+		want      string
+	}{
+		{name: "original execution", grantID: "gra_123", extension: 0, want: "gra_123"},
+		{name: "first extension", grantID: "gra_123", extension: 1, want: "gra_123-ext-1"},
+		{name: "later extension", grantID: "gra_123", extension: 12, want: "gra_123-ext-12"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := executionName(tc.grantID, tc.extension)
+			assert.Equal(t, tc.want, got)
+
+			grantID, extension := parseExecutionName(got)
+			assert.Equal(t, tc.grantID, grantID)
+			assert.Equal(t, tc.extension, extension)
+		})
+	}
+}
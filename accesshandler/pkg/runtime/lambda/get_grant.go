@@ -0,0 +1,34 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aws_config "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+)
+
+// GetGrant reads a grant from the input of its current execution, deriving the status from the execution status.
+func (r *Runtime) GetGrant(ctx context.Context, grantID string) (*types.Grant, error) {
+	c, err := aws_config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sfnClient := sfn.NewFromConfig(c)
+
+	exe, err := findGrantExecution(ctx, sfnClient, r.GranterStateMachineARN, grantID)
+	if err != nil {
+		return nil, err
+	}
+	var wi WorkflowInput
+	err = json.Unmarshal([]byte(aws.ToString(exe.Input)), &wi)
+	if err != nil {
+		return nil, err
+	}
+	g := wi.Grant
+	g.Status = grantStatusFromExecution(exe.Status, g, time.Now())
+	return &g, nil
+}
@@ -83,6 +83,9 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (Output, err
 		return Output{}, err
 	}
 
+	// providers can read the grant from the context, such as to align credential expiry with the end of the grant.
+	pctx := providers.WithGrant(ctx, grant)
+
 	switch in.Action {
 	case ACTIVATE:
 		log.Infow("activating grant")
@@ -93,7 +96,7 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (Output, err
 					err = fmt.Errorf("internal server error with provider: %s  version: %s", prov.Type, prov.Version)
 				}
 			}()
-			return prov.Provider.Grant(ctx, string(grant.Subject), args, grant.ID)
+			return prov.Provider.Grant(pctx, string(grant.Subject), args, grant.ID)
 		}()
 	case DEACTIVATE:
 		log.Infow("deactivating grant")
@@ -104,7 +107,7 @@ func (g *Granter) HandleRequest(ctx context.Context, in InputEvent) (Output, err
 					err = fmt.Errorf("internal server error with provider: %s  version: %s", prov.Type, prov.Version)
 				}
 			}()
-			return prov.Provider.Revoke(ctx, string(grant.Subject), args, grant.ID)
+			return prov.Provider.Revoke(pctx, string(grant.Subject), args, grant.ID)
 		}()
 	default:
 		err = fmt.Errorf("invocation type: %s not supported, type must be one of [ACTIVATE, DEACTIVATE]", in.Action)
@@ -3,9 +3,13 @@ package lambda
 import (
 	"context"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/sethvargo/go-envconfig"
 )
 
+// executionCacheSize is the number of executions cached when listing grants.
+const executionCacheSize = 5000
+
 // Runtime is a runtime which initiates a stepfunctions workflow
 type Runtime struct {
 	StateMachineARN        string `env:"STATE_MACHINE_ARN"`
@@ -14,9 +18,17 @@ type Runtime struct {
 	EventBusArn            string `env:"EVENT_BUS_ARN"`
 	EventBusSource         string `env:"EVENT_BUS_SOURCE"`
 	GranterStateMachineARN string `env:"STATE_MACHINE_ARN"`
+
+	// executions caches the details of executions read when listing grants.
+	executions *lru.Cache
 }
 
 // Init initialises the runtime
 func (r *Runtime) Init(ctx context.Context) error {
+	cache, err := lru.New(executionCacheSize)
+	if err != nil {
+		return err
+	}
+	r.executions = cache
 	return envconfig.Process(ctx, r)
 }
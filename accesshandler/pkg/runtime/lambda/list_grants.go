@@ -0,0 +1,150 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aws_config "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfntypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+)
+
+// ListGrants lists grants by reading the executions of the granter state machine.
+// The execution input is used as the source of truth for the grant details, and
+// the grant status is derived from the execution status.
+//
+// Filtering by status is done by Step Functions where a grant status matches a single
+// execution status. Other filters are applied after each page of executions is fetched,
+// so a page may contain fewer grants than the limit even if there are more results available.
+//
+// Extending a grant starts a new execution for it and stops the previous execution.
+// Stopped executions which were replaced by an extension are skipped, so each grant
+// is listed once across all pages, from its current execution.
+func (r *Runtime) ListGrants(ctx context.Context, opts types.ListGrantsOpts) ([]types.Grant, *string, error) {
+	c, err := aws_config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	sfnClient := sfn.NewFromConfig(c)
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = types.DefaultListGrantsLimit
+	}
+	// the limit is capped even if the runtime isn't called through the API, as Step Functions
+	// returns an error if more than 1000 executions are requested.
+	if limit > types.MaxListGrantsLimit {
+		limit = types.MaxListGrantsLimit
+	}
+
+	in := &sfn.ListExecutionsInput{
+		StateMachineArn: aws.String(r.StateMachineARN),
+		MaxResults:      int32(limit),
+	}
+	if opts.NextToken != "" {
+		in.NextToken = aws.String(opts.NextToken)
+	}
+	switch opts.Status {
+	case types.GrantStatusACTIVE, types.GrantStatusPENDING:
+		in.StatusFilter = sfntypes.ExecutionStatusRunning
+	case types.GrantStatusEXPIRED:
+		in.StatusFilter = sfntypes.ExecutionStatusSucceeded
+	case types.GrantStatusREVOKED:
+		in.StatusFilter = sfntypes.ExecutionStatusAborted
+	}
+
+	out, err := sfnClient.ListExecutions(ctx, in)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	grants := []types.Grant{}
+	for _, exe := range out.Executions {
+		le, err := r.describeListedExecution(ctx, sfnClient, exe)
+		if err != nil {
+			return nil, nil, err
+		}
+		if le.superseded {
+			// the grant was extended rather than revoked, so it is listed from the newer execution.
+			continue
+		}
+		var wi WorkflowInput
+		err = json.Unmarshal([]byte(le.input), &wi)
+		if err != nil {
+			logger.Get(ctx).Errorw("error parsing workflow input, skipping execution", "execution", aws.ToString(exe.ExecutionArn), "error", err)
+			continue
+		}
+		g := wi.Grant
+		g.Status = grantStatusFromExecution(exe.Status, g, now)
+		if opts.Matches(g) {
+			grants = append(grants, g)
+		}
+	}
+
+	return grants, out.NextToken, nil
+}
+
+// listedExecution contains the details of an execution which ListGrants needs.
+type listedExecution struct {
+	// superseded is true if the execution was stopped because its grant was extended.
+	superseded bool
+	// input is the workflow input of the execution, if it wasn't superseded.
+	input string
+}
+
+// describeListedExecution reads the details of an execution which aren't included when listing executions.
+// Execution inputs never change, and a stopped execution can't be extended, so the details are cached
+// by execution and status to avoid describing every execution each time grants are listed.
+func (r *Runtime) describeListedExecution(ctx context.Context, client *sfn.Client, exe sfntypes.ExecutionListItem) (listedExecution, error) {
+	key := aws.ToString(exe.ExecutionArn) + "|" + string(exe.Status)
+	if r.executions != nil {
+		if cached, ok := r.executions.Get(key); ok {
+			return cached.(listedExecution), nil
+		}
+	}
+
+	var le listedExecution
+	if exe.Status == sfntypes.ExecutionStatusAborted {
+		ext, err := describeExtension(ctx, client, r.StateMachineARN, aws.ToString(exe.Name))
+		if err != nil {
+			return listedExecution{}, err
+		}
+		le.superseded = ext != nil
+	}
+	if !le.superseded {
+		desc, err := client.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: exe.ExecutionArn})
+		if err != nil {
+			return listedExecution{}, err
+		}
+		le.input = aws.ToString(desc.Input)
+	}
+
+	if r.executions != nil {
+		r.executions.Add(key, le)
+	}
+	return le, nil
+}
+
+// grantStatusFromExecution derives the status of a grant from the status
+// of the Step Functions execution provisioning it.
+func grantStatusFromExecution(status sfntypes.ExecutionStatus, g types.Grant, now time.Time) types.GrantStatus {
+	switch status {
+	case sfntypes.ExecutionStatusRunning:
+		if now.Before(g.Start.Time) {
+			return types.GrantStatusPENDING
+		}
+		return types.GrantStatusACTIVE
+	case sfntypes.ExecutionStatusSucceeded:
+		return types.GrantStatusEXPIRED
+	case sfntypes.ExecutionStatusAborted:
+		// RevokeGrant stops the execution, so an aborted execution is a revoked grant.
+		return types.GrantStatusREVOKED
+	default:
+		return types.GrantStatusERROR
+	}
+}
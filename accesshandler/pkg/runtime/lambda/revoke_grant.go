@@ -29,13 +29,12 @@ func (r *Runtime) RevokeGrant(ctx context.Context, grantID string, revoker strin
 	}
 	sfnClient := sfn.NewFromConfig(c)
 
-	//build the execution ARN
-	exeARN := BuildExecutionARN(r.GranterStateMachineARN, grantID)
-
-	out, err := sfnClient.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: aws.String(exeARN)})
+	//find the execution for the grant, which may be an extension of the original execution
+	out, err := findGrantExecution(ctx, sfnClient, r.GranterStateMachineARN, grantID)
 	if err != nil {
 		return nil, err
 	}
+	exeARN := aws.ToString(out.ExecutionArn)
 
 	//build the previous grant from the execution input
 	var grantInput WorkflowInput
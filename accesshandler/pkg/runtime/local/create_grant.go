@@ -26,11 +26,23 @@ func (r *Runtime) CreateGrant(ctx context.Context, vcg types.ValidCreateGrant) (
 		time.Sleep(waitFor)
 
 		logger.Get(ctx).Infow("activating grant", "grant", grant)
-
-		dur := grant.End.Sub(grant.Start.Time)
-		time.Sleep(dur)
+		r.updateStatus(ctx, grant.ID, types.GrantStatusACTIVE)
+
+		// the grant may be extended while it is active, so check the end time again after waking up.
+		for {
+			current := r.getGrant(grant.ID)
+			if current == nil {
+				return
+			}
+			dur := time.Until(current.End.Time)
+			if dur <= 0 {
+				break
+			}
+			time.Sleep(dur)
+		}
 
 		logger.Get(ctx).Infow("deactivating grant", "grant", grant)
+		r.updateStatus(ctx, grant.ID, types.GrantStatusEXPIRED)
 	}()
 
 	return grant, nil
@@ -0,0 +1,54 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/iso8601"
+)
+
+// ExtendGrant updates the end time of a stored grant. The grant workflow
+// goroutine re-reads the end time before expiring the grant, so it picks
+// up the new end time without needing to be restarted.
+func (r *Runtime) ExtendGrant(ctx context.Context, grantID string, end time.Time) (*types.Grant, error) {
+	logger.Get(ctx).Infow("extending grant", "grant.id", grantID, "end", end)
+
+	tx := r.db.Txn(true)
+	defer tx.Abort()
+
+	obj, err := tx.First("grants", "id", grantID)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, types.ErrGrantNotFound
+	}
+	existing := obj.(*types.Grant)
+	err = existing.ValidateExtension(end)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *existing
+	updated.End = iso8601.New(end)
+	err = tx.Insert("grants", &updated)
+	if err != nil {
+		return nil, err
+	}
+	tx.Commit()
+	return &updated, nil
+}
+
+// getGrant returns a stored grant, or nil if it doesn't exist.
+func (r *Runtime) getGrant(grantID string) *types.Grant {
+	tx := r.db.Txn(false)
+	defer tx.Abort()
+
+	obj, err := tx.First("grants", "id", grantID)
+	if err != nil || obj == nil {
+		return nil
+	}
+	return obj.(*types.Grant)
+}
@@ -0,0 +1,63 @@
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendGrant(t *testing.T) {
+	ctx := context.Background()
+	r := Runtime{}
+
+	err := r.Init(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// grants are created in the future so that the local workflow goroutines don't change their status.
+	start := time.Now().Add(time.Hour).Truncate(time.Second)
+	grants := []types.CreateGrant{
+		{Id: "pending", Provider: "okta", Subject: "alice@acme.com", Start: iso8601.New(start), End: iso8601.New(start.Add(time.Hour))},
+		{Id: "revoked", Provider: "okta", Subject: "alice@acme.com", Start: iso8601.New(start), End: iso8601.New(start.Add(time.Hour))},
+	}
+	for _, g := range grants {
+		_, err = r.CreateGrant(ctx, types.ValidCreateGrant{CreateGrant: g})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = r.RevokeGrant(ctx, "revoked", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testcase struct {
+		name    string
+		grantID string
+		end     time.Time
+		wantErr error
+	}
+
+	testcases := []testcase{
+		{name: "ok", grantID: "pending", end: start.Add(2 * time.Hour)},
+		{name: "end before current end", grantID: "pending", end: start.Add(time.Minute), wantErr: types.ErrInvalidGrantTime{Msg: "grant end time can only be moved later"}},
+		{name: "revoked grant", grantID: "revoked", end: start.Add(2 * time.Hour), wantErr: types.ErrGrantNotExtendable},
+		{name: "grant not found", grantID: "other", end: start.Add(2 * time.Hour), wantErr: types.ErrGrantNotFound},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := r.ExtendGrant(ctx, tc.grantID, tc.end)
+			assert.Equal(t, tc.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tc.end, got.End.Time)
+				assert.Equal(t, tc.end, r.getGrant(tc.grantID).End.Time)
+			}
+		})
+	}
+}
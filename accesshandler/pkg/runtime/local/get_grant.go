@@ -0,0 +1,23 @@
+package local
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+)
+
+// GetGrant returns a grant stored in memory.
+func (r *Runtime) GetGrant(ctx context.Context, grantID string) (*types.Grant, error) {
+	tx := r.db.Txn(false)
+	defer tx.Abort()
+
+	obj, err := tx.First("grants", "id", grantID)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, types.ErrGrantNotFound
+	}
+	g := *obj.(*types.Grant)
+	return &g, nil
+}
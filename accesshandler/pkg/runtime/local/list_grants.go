@@ -0,0 +1,43 @@
+package local
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+)
+
+// ListGrants lists grants stored in memory, ordered by grant ID.
+// The pagination token is the ID of the last grant in the previous page.
+func (r *Runtime) ListGrants(ctx context.Context, opts types.ListGrantsOpts) ([]types.Grant, *string, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = types.DefaultListGrantsLimit
+	}
+
+	tx := r.db.Txn(false)
+	defer tx.Abort()
+
+	it, err := tx.LowerBound("grants", "id", opts.NextToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	grants := []types.Grant{}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		g := obj.(*types.Grant)
+		// the lower bound is inclusive, so skip the last grant from the previous page.
+		if opts.NextToken != "" && g.ID == opts.NextToken {
+			continue
+		}
+		if !opts.Matches(*g) {
+			continue
+		}
+		if len(grants) == limit {
+			next := grants[len(grants)-1].ID
+			return grants, &next, nil
+		}
+		grants = append(grants, *g)
+	}
+
+	return grants, nil, nil
+}
@@ -0,0 +1,78 @@
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGrants(t *testing.T) {
+	ctx := context.Background()
+	r := Runtime{}
+
+	err := r.Init(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// grants are created in the future so that the local workflow goroutines don't change their status.
+	start := time.Now().Add(time.Hour)
+	grants := []types.CreateGrant{
+		{Id: "a", Provider: "okta", Subject: "alice@acme.com", Start: iso8601.New(start), End: iso8601.New(start.Add(time.Hour))},
+		{Id: "b", Provider: "okta", Subject: "bob@acme.com", Start: iso8601.New(start), End: iso8601.New(start.Add(time.Hour))},
+		{Id: "c", Provider: "aws", Subject: "alice@acme.com", Start: iso8601.New(start.Add(2 * time.Hour)), End: iso8601.New(start.Add(3 * time.Hour))},
+	}
+	for _, g := range grants {
+		_, err = r.CreateGrant(ctx, types.ValidCreateGrant{CreateGrant: g})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = r.RevokeGrant(ctx, "b", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	windowStart := start.Add(90 * time.Minute)
+
+	type testcase struct {
+		name     string
+		opts     types.ListGrantsOpts
+		wantIDs  []string
+		wantNext *string
+	}
+
+	testcases := []testcase{
+		{name: "no filters", opts: types.ListGrantsOpts{}, wantIDs: []string{"a", "b", "c"}},
+		{name: "provider", opts: types.ListGrantsOpts{Provider: "okta"}, wantIDs: []string{"a", "b"}},
+		{name: "subject", opts: types.ListGrantsOpts{Subject: "alice@acme.com"}, wantIDs: []string{"a", "c"}},
+		{name: "status", opts: types.ListGrantsOpts{Status: types.GrantStatusREVOKED}, wantIDs: []string{"b"}},
+		{name: "time window", opts: types.ListGrantsOpts{Start: &windowStart}, wantIDs: []string{"c"}},
+		{name: "first page", opts: types.ListGrantsOpts{Limit: 2}, wantIDs: []string{"a", "b"}, wantNext: strPtr("b")},
+		{name: "second page", opts: types.ListGrantsOpts{Limit: 2, NextToken: "b"}, wantIDs: []string{"c"}},
+		{name: "exact page size", opts: types.ListGrantsOpts{Limit: 3}, wantIDs: []string{"a", "b", "c"}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, next, err := r.ListGrants(ctx, tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotIDs []string
+			for _, g := range got {
+				gotIDs = append(gotIDs, g.ID)
+			}
+			assert.Equal(t, tc.wantIDs, gotIDs)
+			assert.Equal(t, tc.wantNext, next)
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
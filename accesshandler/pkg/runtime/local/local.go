@@ -3,6 +3,8 @@ package local
 import (
 	"context"
 
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/hashicorp/go-memdb"
 )
 
@@ -36,3 +38,30 @@ func (r *Runtime) Init(ctx context.Context) error {
 	r.db = db
 	return nil
 }
+
+// updateStatus sets the status of a stored grant. Grants which have been
+// revoked are left untouched, so that a running grant workflow can't
+// overwrite the revocation.
+func (r *Runtime) updateStatus(ctx context.Context, grantID string, status types.GrantStatus) *types.Grant {
+	tx := r.db.Txn(true)
+	defer tx.Commit()
+
+	obj, err := tx.First("grants", "id", grantID)
+	if err != nil || obj == nil {
+		return nil
+	}
+	existing := obj.(*types.Grant)
+	if existing.Status == types.GrantStatusREVOKED {
+		return existing
+	}
+
+	// objects stored in memdb must not be modified in place, so insert a copy.
+	updated := *existing
+	updated.Status = status
+	err = tx.Insert("grants", &updated)
+	if err != nil {
+		logger.Get(ctx).Errorw("error updating grant status", "grant.id", grantID, "error", err)
+		return nil
+	}
+	return &updated
+}
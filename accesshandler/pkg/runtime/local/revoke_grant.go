@@ -11,5 +11,10 @@ func (r *Runtime) RevokeGrant(ctx context.Context, grant string, revoker string)
 
 	logger.Get(ctx).Infow("revoking grant", "grant", grant, "revoker", revoker)
 
-	return &types.Grant{}, nil
+	g := r.updateStatus(ctx, grant, types.GrantStatusREVOKED)
+	if g == nil {
+		return &types.Grant{}, nil
+	}
+
+	return g, nil
 }
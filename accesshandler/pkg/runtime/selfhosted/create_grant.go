@@ -0,0 +1,37 @@
+package selfhosted
+
+import (
+	"context"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+// CreateGrant stores a new grant and schedules it to be activated at its start time.
+func (r *Runtime) CreateGrant(ctx context.Context, vcg types.ValidCreateGrant) (types.Grant, error) {
+	grant := types.NewGrant(vcg)
+	logger.Get(ctx).Infow("creating grant", "grant", grant)
+
+	j := job{GrantID: grant.ID, Action: activate, RunAt: grant.Start.Time}
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		existing, err := getGrant(tx, grant.ID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return types.ErrGrantAlreadyExists
+		}
+		err = putGrant(tx, grant)
+		if err != nil {
+			return err
+		}
+		return putJob(tx, j)
+	})
+	if err != nil {
+		return types.Grant{}, err
+	}
+
+	r.schedule(j)
+	return grant, nil
+}
@@ -0,0 +1,61 @@
+package selfhosted
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/iso8601"
+	bolt "go.etcd.io/bbolt"
+)
+
+// ExtendGrant updates the end time of a grant. If the grant is active, its
+// deactivation is rescheduled. Pending grants are scheduled to be deactivated
+// at the new end time when they are activated.
+func (r *Runtime) ExtendGrant(ctx context.Context, grantID string, end time.Time) (*types.Grant, error) {
+	logger.Get(ctx).Infow("extending grant", "grant.id", grantID, "end", end)
+	unlock := r.lockGrant(grantID)
+	defer unlock()
+
+	var grant *types.Grant
+	var rescheduled *job
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		var err error
+		grant, err = getGrant(tx, grantID)
+		if err != nil {
+			return err
+		}
+		if grant == nil {
+			return types.ErrGrantNotFound
+		}
+		err = grant.ValidateExtension(end)
+		if err != nil {
+			return err
+		}
+		grant.End = iso8601.New(end)
+		err = putGrant(tx, *grant)
+		if err != nil {
+			return err
+		}
+
+		j, err := getJob(tx, grantID)
+		if err != nil {
+			return err
+		}
+		if j != nil && j.Action == deactivate {
+			j.RunAt = end
+			rescheduled = j
+			return putJob(tx, *j)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rescheduled != nil {
+		r.schedule(*rescheduled)
+	}
+	return grant, nil
+}
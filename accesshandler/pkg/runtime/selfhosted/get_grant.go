@@ -0,0 +1,25 @@
+package selfhosted
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+// GetGrant returns a stored grant.
+func (r *Runtime) GetGrant(ctx context.Context, grantID string) (*types.Grant, error) {
+	var g *types.Grant
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		g, err = getGrant(tx, grantID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, types.ErrGrantNotFound
+	}
+	return g, nil
+}
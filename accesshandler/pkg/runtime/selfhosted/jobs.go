@@ -0,0 +1,278 @@
+package selfhosted
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	bolt "go.etcd.io/bbolt"
+)
+
+type action string
+
+const (
+	activate   action = "ACTIVATE"
+	deactivate action = "DEACTIVATE"
+)
+
+// job is a scheduled activation or deactivation of a grant.
+// A grant has at most one job scheduled at a time.
+type job struct {
+	GrantID string    `json:"grantId"`
+	Action  action    `json:"action"`
+	RunAt   time.Time `json:"runAt"`
+}
+
+// grantLock is the lock for a single grant. refs counts the callers holding
+// or waiting for the lock, so that the lock can be removed once it is unused.
+type grantLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lockGrant serialises changes to a grant, so that a job can't overwrite a grant
+// which is revoked or extended while the job is calling the provider.
+// It returns a function which releases the lock.
+func (r *Runtime) lockGrant(grantID string) func() {
+	r.locksMu.Lock()
+	l, ok := r.locks[grantID]
+	if !ok {
+		l = &grantLock{}
+		r.locks[grantID] = l
+	}
+	l.refs++
+	r.locksMu.Unlock()
+
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		r.locksMu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(r.locks, grantID)
+		}
+		r.locksMu.Unlock()
+	}
+}
+
+// resumeJobs schedules the jobs stored in the database.
+// Jobs which were due while the runtime was stopped are run immediately.
+func (r *Runtime) resumeJobs(ctx context.Context) error {
+	var jobs []job
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		jobs, err = listJobs(tx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for _, j := range jobs {
+		logger.Get(ctx).Infow("resuming job", "grant.id", j.GrantID, "action", j.Action, "runAt", j.RunAt)
+		r.schedule(j)
+	}
+	return nil
+}
+
+// schedule runs the job at its scheduled time, replacing any job already scheduled for the grant.
+func (r *Runtime) schedule(j job) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if t, ok := r.timers[j.GrantID]; ok {
+		t.Stop()
+	}
+	var t *clock.Timer
+	t = r.clock.AfterFunc(r.clock.Until(j.RunAt), func() {
+		r.mu.Lock()
+		current := r.timers[j.GrantID] == t
+		if current {
+			delete(r.timers, j.GrantID)
+		}
+		r.mu.Unlock()
+		// the job has been replaced or cancelled since the timer was started.
+		if !current {
+			return
+		}
+		r.runJob(context.Background(), j)
+	})
+	r.timers[j.GrantID] = t
+}
+
+// cancel stops the job scheduled for a grant, if there is one.
+func (r *Runtime) cancel(grantID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if t, ok := r.timers[grantID]; ok {
+		t.Stop()
+		delete(r.timers, grantID)
+	}
+}
+
+// runJob activates or deactivates a grant by calling its provider, and schedules the
+// deactivation of the grant once it has been activated.
+// If the provider returns an error after retrying, the grant status is set to ERROR.
+//
+// The grant is locked for each attempt rather than across the retries, so that
+// the grant can be revoked or extended while the job is waiting to retry.
+func (r *Runtime) runJob(ctx context.Context, j job) {
+	log := logger.Get(ctx).With("grant.id", j.GrantID, "action", j.Action)
+
+	err := r.retry(ctx, func() error {
+		unlock := r.lockGrant(j.GrantID)
+		defer unlock()
+
+		grant, err := r.loadJobGrant(j)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if grant == nil {
+			return nil
+		}
+		log.Infow("running job", "grant", grant)
+		err = r.execute(ctx, *grant, j.Action)
+		if err != nil {
+			return err
+		}
+		// the new status is saved before the lock is released, so that a revocation
+		// can't miss access which was provisioned by this attempt.
+		r.completeJob(ctx, *grant, j)
+		return nil
+	})
+	if err == nil {
+		return
+	}
+
+	log.Errorw("error calling provider", "error", err)
+	unlock := r.lockGrant(j.GrantID)
+	defer unlock()
+	grant, dberr := r.loadJobGrant(j)
+	if dberr != nil {
+		log.Errorw("error loading grant", "error", dberr)
+		return
+	}
+	if grant == nil {
+		return
+	}
+	grant.Status = types.GrantStatusERROR
+	dberr = r.db.Update(func(tx *bolt.Tx) error {
+		err := putGrant(tx, *grant)
+		if err != nil {
+			return err
+		}
+		return deleteJob(tx, grant.ID)
+	})
+	if dberr != nil {
+		log.Errorw("error saving grant", "error", dberr)
+	}
+	r.putEvent(ctx, &gevent.GrantFailed{Grant: *grant, Reason: err.Error()})
+}
+
+// loadJobGrant returns the grant which a job is for, or nil if the job no longer needs to run.
+// The grant must be locked when this is called.
+func (r *Runtime) loadJobGrant(j job) (*types.Grant, error) {
+	var grant *types.Grant
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		grant, err = getGrant(tx, j.GrantID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the grant may have been revoked after the job was started.
+	if grant == nil || grant.Status == types.GrantStatusREVOKED {
+		err = r.db.Update(func(tx *bolt.Tx) error { return deleteJob(tx, j.GrantID) })
+		return nil, err
+	}
+	// the grant may have been extended after the deactivation job was started,
+	// in which case a new deactivation job has already been scheduled.
+	if j.Action == deactivate && grant.End.Time.After(r.clock.Now()) {
+		return nil, nil
+	}
+	return grant, nil
+}
+
+// completeJob saves the status of a grant after its provider has been called successfully.
+// The grant must be locked when this is called.
+func (r *Runtime) completeJob(ctx context.Context, grant types.Grant, j job) {
+	log := logger.Get(ctx).With("grant.id", j.GrantID, "action", j.Action)
+	var evt gevent.EventTyper
+	switch j.Action {
+	case activate:
+		grant.Status = types.GrantStatusACTIVE
+		next := job{GrantID: grant.ID, Action: deactivate, RunAt: grant.End.Time}
+		err := r.db.Update(func(tx *bolt.Tx) error {
+			err := putGrant(tx, grant)
+			if err != nil {
+				return err
+			}
+			return putJob(tx, next)
+		})
+		if err != nil {
+			log.Errorw("error saving grant", "error", err)
+			return
+		}
+		r.schedule(next)
+		evt = &gevent.GrantActivated{Grant: grant}
+	case deactivate:
+		grant.Status = types.GrantStatusEXPIRED
+		err := r.db.Update(func(tx *bolt.Tx) error {
+			err := putGrant(tx, grant)
+			if err != nil {
+				return err
+			}
+			return deleteJob(tx, grant.ID)
+		})
+		if err != nil {
+			log.Errorw("error saving grant", "error", err)
+			return
+		}
+		evt = &gevent.GrantExpired{Grant: grant}
+	}
+	r.putEvent(ctx, evt)
+}
+
+// execute calls the provider to provision or remove access for the grant.
+// Errors which can't be fixed by retrying are returned as permanent errors.
+func (r *Runtime) execute(ctx context.Context, grant types.Grant, a action) (err error) {
+	prov, err := r.lookupProvider(grant.Provider)
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+	args, err := json.Marshal(grant.With)
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+	defer func() {
+		if rec := recover(); rec != nil {
+			logger.Get(ctx).Errorw("recovered panic while calling provider", "error", rec, "provider", grant.Provider)
+			err = backoff.Permanent(fmt.Errorf("internal server error with provider: %s", grant.Provider))
+		}
+	}()
+	ctx = providers.WithGrant(ctx, grant)
+	if a == activate {
+		return prov.Grant(ctx, string(grant.Subject), args, grant.ID)
+	}
+	return prov.Revoke(ctx, string(grant.Subject), args, grant.ID)
+}
+
+// retry runs op until it succeeds, returns a permanent error, or the maximum number of retries is reached.
+func (r *Runtime) retry(ctx context.Context, op func() error) error {
+	b := backoff.WithContext(backoff.WithMaxRetries(r.newBackOff(), r.MaxRetries), ctx)
+	return backoff.Retry(op, b)
+}
+
+func (r *Runtime) putEvent(ctx context.Context, e gevent.EventTyper) {
+	err := r.events.Put(ctx, e)
+	if err != nil {
+		logger.Get(ctx).Errorw("error emitting event", "event", e.EventType(), "error", err)
+	}
+}
@@ -0,0 +1,45 @@
+package selfhosted
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+// ListGrants lists stored grants, ordered by grant ID.
+// The pagination token is the ID of the last grant in the previous page.
+func (r *Runtime) ListGrants(ctx context.Context, opts types.ListGrantsOpts) ([]types.Grant, *string, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = types.DefaultListGrantsLimit
+	}
+
+	grants := []types.Grant{}
+	var next *string
+	err := r.db.View(func(tx *bolt.Tx) error {
+		c, k, v := seekGrants(tx, opts.NextToken)
+		for ; k != nil; k, v = c.Next() {
+			var g types.Grant
+			err := json.Unmarshal(v, &g)
+			if err != nil {
+				return err
+			}
+			if !opts.Matches(g) {
+				continue
+			}
+			if len(grants) == limit {
+				id := grants[len(grants)-1].ID
+				next = &id
+				return nil
+			}
+			grants = append(grants, g)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return grants, next, nil
+}
@@ -0,0 +1,51 @@
+package selfhosted
+
+import (
+	"context"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+// RevokeGrant cancels the scheduled jobs for a grant. If the grant is active,
+// the provider is called to remove access before the grant is marked as revoked.
+func (r *Runtime) RevokeGrant(ctx context.Context, grantID string, revoker string) (*types.Grant, error) {
+	logger.Get(ctx).Infow("revoking grant", "grant.id", grantID, "revoker", revoker)
+	unlock := r.lockGrant(grantID)
+	defer unlock()
+
+	var grant *types.Grant
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		grant, err = getGrant(tx, grantID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if grant == nil {
+		return nil, types.ErrGrantNotFound
+	}
+
+	if grant.Status == types.GrantStatusACTIVE {
+		err = r.retry(ctx, func() error { return r.execute(ctx, *grant, deactivate) })
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r.cancel(grantID)
+	grant.Status = types.GrantStatusREVOKED
+	err = r.db.Update(func(tx *bolt.Tx) error {
+		err := putGrant(tx, *grant)
+		if err != nil {
+			return err
+		}
+		return deleteJob(tx, grantID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
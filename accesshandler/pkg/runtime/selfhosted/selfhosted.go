@@ -0,0 +1,142 @@
+package selfhosted
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/config"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/sethvargo/go-envconfig"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Runtime is a runtime for self-hosted deployments of the Access Handler, which
+// don't have access to AWS Step Functions. Grants are executed by an in-process scheduler.
+//
+// Grants and their scheduled activation and deactivation jobs are stored in an embedded
+// bbolt database, so that outstanding jobs are resumed when the Access Handler restarts.
+// Failed provider calls are retried with an exponential backoff.
+type Runtime struct {
+	DBPath      string `env:"SELFHOSTED_DB_PATH,default=access-handler.db"`
+	MaxRetries  uint64 `env:"SELFHOSTED_MAX_RETRIES,default=5"`
+	EventBusArn string `env:"EVENT_BUS_ARN"`
+	// EventBusType is the transport used to emit grant events: eventbridge or webhook.
+	EventBusType          string `env:"EVENT_BUS_TYPE"`
+	EventBusWebhookURL    string `env:"EVENT_BUS_WEBHOOK_URL"`
+	EventBusWebhookSecret string `env:"EVENT_BUS_WEBHOOK_SECRET"`
+
+	db     *bolt.DB
+	clock  clock.Clock
+	events EventPutter
+	// lookupProvider returns the provider to execute a grant with.
+	// It can be overridden for testing purposes.
+	lookupProvider func(id string) (providers.Accessor, error)
+	// newBackOff returns the backoff policy used when retrying provider calls.
+	// It can be overridden for testing purposes.
+	newBackOff func() backoff.BackOff
+
+	mu sync.Mutex
+	// timers holds the scheduled job for each grant, keyed by grant ID.
+	timers  map[string]*clock.Timer
+	locksMu sync.Mutex
+	// locks holds the lock for each grant which is being changed, keyed by grant ID.
+	locks map[string]*grantLock
+}
+
+// EventPutter emits events when grants are activated, expire, or fail.
+type EventPutter interface {
+	Put(ctx context.Context, e gevent.EventTyper) error
+}
+
+// Init loads the runtime configuration, opens the database,
+// and resumes any jobs which were outstanding when the runtime last stopped.
+func (r *Runtime) Init(ctx context.Context) error {
+	err := envconfig.Process(ctx, r)
+	if err != nil {
+		return err
+	}
+	if r.clock == nil {
+		r.clock = clock.New()
+	}
+	if r.lookupProvider == nil {
+		r.lookupProvider = lookupConfiguredProvider
+	}
+	if r.newBackOff == nil {
+		r.newBackOff = func() backoff.BackOff { return backoff.NewExponentialBackOff() }
+	}
+	if r.EventBusType == gevent.BusTypeInProcess {
+		// in-process events are only dispatched to handlers in the same process, and the
+		// handlers for grant events run in the approvals server, so the events would be lost.
+		return fmt.Errorf("the %s event bus can't be used by the access handler, use the %s or %s event bus instead", gevent.BusTypeInProcess, gevent.BusTypeEventBridge, gevent.BusTypeWebhook)
+	}
+	if r.events == nil {
+		r.events = logEventPutter{}
+		if r.EventBusType != "" || r.EventBusArn != "" {
+			r.events, err = gevent.NewEventBus(ctx, gevent.BusOpts{
+				Type:          r.EventBusType,
+				EventBusARN:   r.EventBusArn,
+				WebhookURL:    r.EventBusWebhookURL,
+				WebhookSecret: r.EventBusWebhookSecret,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	r.timers = make(map[string]*clock.Timer)
+	r.locks = make(map[string]*grantLock)
+
+	db, err := bolt.Open(r.DBPath, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{grantsBucket, jobsBucket} {
+			_, err := tx.CreateBucketIfNotExists(b)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.db = db
+
+	return r.resumeJobs(ctx)
+}
+
+// Close stops any scheduled jobs and closes the database.
+// Jobs remain stored in the database and are resumed by the next call to Init.
+func (r *Runtime) Close() error {
+	r.mu.Lock()
+	for id, t := range r.timers {
+		t.Stop()
+		delete(r.timers, id)
+	}
+	r.mu.Unlock()
+	return r.db.Close()
+}
+
+func lookupConfiguredProvider(id string) (providers.Accessor, error) {
+	prov, ok := config.Providers[id]
+	if !ok {
+		return nil, &providers.ProviderNotFoundError{Provider: id}
+	}
+	return prov.Provider, nil
+}
+
+// logEventPutter logs events rather than emitting them, and is used if no event bus is configured.
+type logEventPutter struct{}
+
+func (logEventPutter) Put(ctx context.Context, e gevent.EventTyper) error {
+	logger.Get(ctx).Infow("no event bus configured, skipping event", "event", e.EventType())
+	return nil
+}
@@ -0,0 +1,296 @@
+package selfhosted
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/iso8601"
+	"github.com/stretchr/testify/assert"
+)
+
+// testProvider records calls and fails the first failures calls.
+type testProvider struct {
+	mu       sync.Mutex
+	failures int
+	calls    []string
+}
+
+func (p *testProvider) call(action string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls = append(p.calls, action)
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("provider unavailable")
+	}
+	return nil
+}
+
+func (p *testProvider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	return p.call("grant")
+}
+
+func (p *testProvider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	return p.call("revoke")
+}
+
+type testEvents struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (e *testEvents) Put(ctx context.Context, evt gevent.EventTyper) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, evt.EventType())
+	return nil
+}
+
+// newTestRuntime returns a runtime which stores grants in the provided database path.
+func newTestRuntime(t *testing.T, path string, clk clock.Clock, p *testProvider, events *testEvents) *Runtime {
+	t.Setenv("SELFHOSTED_DB_PATH", path)
+	r := &Runtime{
+		clock:          clk,
+		events:         events,
+		lookupProvider: func(id string) (providers.Accessor, error) { return p, nil },
+		newBackOff:     func() backoff.BackOff { return &backoff.ZeroBackOff{} },
+	}
+	err := r.Init(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func testGrant(id string, start time.Time) types.ValidCreateGrant {
+	return types.ValidCreateGrant{CreateGrant: types.CreateGrant{
+		Id:       id,
+		Provider: "test",
+		Subject:  "alice@acme.com",
+		Start:    iso8601.New(start),
+		End:      iso8601.New(start.Add(time.Hour)),
+	}}
+}
+
+func getStatus(t *testing.T, r *Runtime, id string) types.GrantStatus {
+	grants, _, err := r.ListGrants(context.Background(), types.ListGrantsOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, g := range grants {
+		if g.ID == id {
+			return g.Status
+		}
+	}
+	t.Fatalf("grant %s not found", id)
+	return ""
+}
+
+func TestGrantWorkflow(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewMock()
+	p := &testProvider{}
+	events := &testEvents{}
+	r := newTestRuntime(t, filepath.Join(t.TempDir(), "ah.db"), clk, p, events)
+	defer r.Close()
+
+	_, err := r.CreateGrant(ctx, testGrant("abcd", clk.Now().Add(time.Minute)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, types.GrantStatusPENDING, getStatus(t, r, "abcd"))
+
+	clk.Add(time.Minute)
+	assert.Equal(t, types.GrantStatusACTIVE, getStatus(t, r, "abcd"))
+
+	clk.Add(time.Hour)
+	assert.Equal(t, types.GrantStatusEXPIRED, getStatus(t, r, "abcd"))
+	assert.Equal(t, []string{"grant", "revoke"}, p.calls)
+	assert.Equal(t, []string{gevent.GrantActivatedType, gevent.GrantExpiredType}, events.events)
+}
+
+func TestRetryProviderErrors(t *testing.T) {
+	ctx := context.Background()
+	type testcase struct {
+		name       string
+		failures   int
+		wantStatus types.GrantStatus
+		wantEvents []string
+	}
+	testcases := []testcase{
+		{name: "succeeds after retrying", failures: 2, wantStatus: types.GrantStatusACTIVE, wantEvents: []string{gevent.GrantActivatedType}},
+		{name: "fails after max retries", failures: 10, wantStatus: types.GrantStatusERROR, wantEvents: []string{gevent.GrantFailedType}},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("SELFHOSTED_MAX_RETRIES", "3")
+			clk := clock.NewMock()
+			p := &testProvider{failures: tc.failures}
+			events := &testEvents{}
+			r := newTestRuntime(t, filepath.Join(t.TempDir(), "ah.db"), clk, p, events)
+			defer r.Close()
+
+			_, err := r.CreateGrant(ctx, testGrant("abcd", clk.Now()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			clk.Add(time.Second)
+			assert.Equal(t, tc.wantStatus, getStatus(t, r, "abcd"))
+			assert.Equal(t, tc.wantEvents, events.events)
+		})
+	}
+}
+
+func TestResumeJobsAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "ah.db")
+	clk := clock.NewMock()
+	p := &testProvider{}
+	r := newTestRuntime(t, path, clk, p, &testEvents{})
+
+	_, err := r.CreateGrant(ctx, testGrant("abcd", clk.Now().Add(time.Minute)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the activation is due while the runtime is stopped, so it runs as soon as the runtime restarts.
+	clk.Add(2 * time.Minute)
+	r = newTestRuntime(t, path, clk, p, &testEvents{})
+	defer r.Close()
+	clk.Add(time.Second)
+	assert.Equal(t, types.GrantStatusACTIVE, getStatus(t, r, "abcd"))
+
+	clk.Add(time.Hour)
+	assert.Equal(t, types.GrantStatusEXPIRED, getStatus(t, r, "abcd"))
+	assert.Equal(t, []string{"grant", "revoke"}, p.calls)
+}
+
+func TestRevokeGrant(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewMock()
+	p := &testProvider{}
+	r := newTestRuntime(t, filepath.Join(t.TempDir(), "ah.db"), clk, p, &testEvents{})
+	defer r.Close()
+
+	_, err := r.CreateGrant(ctx, testGrant("active", clk.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.CreateGrant(ctx, testGrant("pending", clk.Now().Add(time.Hour)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	clk.Add(time.Second)
+
+	g, err := r.RevokeGrant(ctx, "active", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, types.GrantStatusREVOKED, g.Status)
+	_, err = r.RevokeGrant(ctx, "pending", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.RevokeGrant(ctx, "other", "admin")
+	assert.Equal(t, types.ErrGrantNotFound, err)
+
+	// the revoked grants' jobs are cancelled, so the provider is only called to revoke the active grant.
+	clk.Add(3 * time.Hour)
+	assert.Equal(t, []string{"grant", "revoke"}, p.calls)
+	assert.Equal(t, types.GrantStatusREVOKED, getStatus(t, r, "active"))
+	assert.Equal(t, types.GrantStatusREVOKED, getStatus(t, r, "pending"))
+}
+
+func TestExtendGrant(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewMock()
+	p := &testProvider{}
+	r := newTestRuntime(t, filepath.Join(t.TempDir(), "ah.db"), clk, p, &testEvents{})
+	defer r.Close()
+
+	start := clk.Now()
+	_, err := r.CreateGrant(ctx, testGrant("abcd", start))
+	if err != nil {
+		t.Fatal(err)
+	}
+	clk.Add(time.Second)
+
+	_, err = r.ExtendGrant(ctx, "abcd", start.Add(2*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.ExtendGrant(ctx, "abcd", start.Add(time.Minute))
+	assert.Equal(t, types.ErrInvalidGrantTime{Msg: "grant end time can only be moved later"}, err)
+
+	// the grant would have expired after an hour if it wasn't extended.
+	clk.Add(time.Hour)
+	assert.Equal(t, types.GrantStatusACTIVE, getStatus(t, r, "abcd"))
+	clk.Add(time.Hour)
+	assert.Equal(t, types.GrantStatusEXPIRED, getStatus(t, r, "abcd"))
+}
+
+func TestInitRejectsInProcessEventBus(t *testing.T) {
+	t.Setenv("SELFHOSTED_DB_PATH", filepath.Join(t.TempDir(), "grants.db"))
+	t.Setenv("EVENT_BUS_TYPE", gevent.BusTypeInProcess)
+	r := &Runtime{}
+	err := r.Init(context.Background())
+	assert.EqualError(t, err, "the inprocess event bus can't be used by the access handler, use the eventbridge or webhook event bus instead")
+}
+
+// funcBackOff calls f before each retry.
+type funcBackOff struct {
+	f func()
+}
+
+func (b *funcBackOff) NextBackOff() time.Duration {
+	b.f()
+	return 0
+}
+
+func (b *funcBackOff) Reset() {}
+
+func TestRevokeGrantWhileRetrying(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewMock()
+	p := &testProvider{failures: 1}
+	events := &testEvents{}
+	r := newTestRuntime(t, filepath.Join(t.TempDir(), "ah.db"), clk, p, events)
+	defer r.Close()
+
+	// the grant is revoked while the activation is waiting to be retried.
+	var revokeErr error
+	r.newBackOff = func() backoff.BackOff {
+		return &funcBackOff{f: func() { _, revokeErr = r.RevokeGrant(ctx, "abcd", "admin") }}
+	}
+
+	_, err := r.CreateGrant(ctx, testGrant("abcd", clk.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.CreateGrant(ctx, testGrant("abcd", clk.Now()))
+	assert.Equal(t, types.ErrGrantAlreadyExists, err)
+
+	clk.Add(time.Second)
+	assert.NoError(t, revokeErr)
+	assert.Equal(t, types.GrantStatusREVOKED, getStatus(t, r, "abcd"))
+	// the activation isn't retried once the grant has been revoked.
+	assert.Equal(t, []string{"grant"}, p.calls)
+	assert.Empty(t, events.events)
+
+	r.locksMu.Lock()
+	defer r.locksMu.Unlock()
+	assert.Empty(t, r.locks)
+}
@@ -0,0 +1,91 @@
+package selfhosted
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	grantsBucket = []byte("grants")
+	jobsBucket   = []byte("jobs")
+)
+
+// getGrant returns a stored grant, or nil if it doesn't exist.
+func getGrant(tx *bolt.Tx, grantID string) (*types.Grant, error) {
+	v := tx.Bucket(grantsBucket).Get([]byte(grantID))
+	if v == nil {
+		return nil, nil
+	}
+	var g types.Grant
+	err := json.Unmarshal(v, &g)
+	if err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+func putGrant(tx *bolt.Tx, g types.Grant) error {
+	v, err := json.Marshal(g)
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(grantsBucket).Put([]byte(g.ID), v)
+}
+
+// getJob returns the job scheduled for a grant, or nil if there isn't one.
+func getJob(tx *bolt.Tx, grantID string) (*job, error) {
+	v := tx.Bucket(jobsBucket).Get([]byte(grantID))
+	if v == nil {
+		return nil, nil
+	}
+	var j job
+	err := json.Unmarshal(v, &j)
+	if err != nil {
+		return nil, err
+	}
+	return &j, nil
+}
+
+func putJob(tx *bolt.Tx, j job) error {
+	v, err := json.Marshal(j)
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(jobsBucket).Put([]byte(j.GrantID), v)
+}
+
+func deleteJob(tx *bolt.Tx, grantID string) error {
+	return tx.Bucket(jobsBucket).Delete([]byte(grantID))
+}
+
+func listJobs(tx *bolt.Tx) ([]job, error) {
+	var jobs []job
+	err := tx.Bucket(jobsBucket).ForEach(func(k, v []byte) error {
+		var j job
+		err := json.Unmarshal(v, &j)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, j)
+		return nil
+	})
+	return jobs, err
+}
+
+// seekGrants returns a cursor positioned at the first grant with an ID after the provided ID.
+// If the ID is empty, the cursor is positioned at the first grant.
+func seekGrants(tx *bolt.Tx, after string) (*bolt.Cursor, []byte, []byte) {
+	c := tx.Bucket(grantsBucket).Cursor()
+	if after == "" {
+		k, v := c.First()
+		return c, k, v
+	}
+	k, v := c.Seek([]byte(after))
+	if k != nil && bytes.Equal(k, []byte(after)) {
+		k, v = c.Next()
+	}
+	return c, k, v
+}
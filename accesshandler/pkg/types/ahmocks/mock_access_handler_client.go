@@ -57,10 +57,10 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) GetAccessInstructionsWit
 }
 
 // GetGrantsWithResponse mocks base method.
-func (m *MockClientWithResponsesInterface) GetGrantsWithResponse(arg0 context.Context, arg1 ...types.RequestEditorFn) (*types.GetGrantsResponse, error) {
+func (m *MockClientWithResponsesInterface) GetGrantsWithResponse(arg0 context.Context, arg1 *types.GetGrantsParams, arg2 ...types.RequestEditorFn) (*types.GetGrantsResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0}
-	for _, a := range arg1 {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "GetGrantsWithResponse", varargs...)
@@ -70,9 +70,9 @@ func (m *MockClientWithResponsesInterface) GetGrantsWithResponse(arg0 context.Co
 }
 
 // GetGrantsWithResponse indicates an expected call of GetGrantsWithResponse.
-func (mr *MockClientWithResponsesInterfaceMockRecorder) GetGrantsWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+func (mr *MockClientWithResponsesInterfaceMockRecorder) GetGrantsWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0}, arg1...)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrantsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).GetGrantsWithResponse), varargs...)
 }
 
@@ -176,6 +176,46 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProvidersWithRespons
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProvidersWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProvidersWithResponse), varargs...)
 }
 
+// PostGrantsExtendWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) PostGrantsExtendWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...types.RequestEditorFn) (*types.PostGrantsExtendResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostGrantsExtendWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.PostGrantsExtendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostGrantsExtendWithBodyWithResponse indicates an expected call of PostGrantsExtendWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) PostGrantsExtendWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGrantsExtendWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).PostGrantsExtendWithBodyWithResponse), varargs...)
+}
+
+// PostGrantsExtendWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) PostGrantsExtendWithResponse(arg0 context.Context, arg1 string, arg2 types.PostGrantsExtendJSONRequestBody, arg3 ...types.RequestEditorFn) (*types.PostGrantsExtendResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostGrantsExtendWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.PostGrantsExtendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostGrantsExtendWithResponse indicates an expected call of PostGrantsExtendWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) PostGrantsExtendWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGrantsExtendWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).PostGrantsExtendWithResponse), varargs...)
+}
+
 // PostGrantsRevokeWithBodyWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) PostGrantsRevokeWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...types.RequestEditorFn) (*types.PostGrantsRevokeResponse, error) {
 	m.ctrl.T.Helper()
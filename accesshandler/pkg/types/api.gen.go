@@ -16,6 +16,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/common-fate/iso8601"
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
@@ -238,6 +239,14 @@ type HealthResponse struct {
 	Health *ProviderHealth `json:"health,omitempty"`
 }
 
+// ListGrantsResponse defines model for ListGrantsResponse.
+type ListGrantsResponse struct {
+	Grants []Grant `json:"grants"`
+
+	// A token to pass as nextToken to fetch the next page of results.
+	Next *string `json:"next"`
+}
+
 // ValidateResponse defines model for ValidateResponse.
 type ValidateResponse struct {
 	Validations []ProviderConfigValidation `json:"validations"`
@@ -252,12 +261,45 @@ type ValidateRequest struct {
 	With map[string]string `json:"with"`
 }
 
+// GetGrantsParams defines parameters for GetGrants.
+type GetGrantsParams struct {
+	// Only return grants for this provider ID.
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty"`
+
+	// Only return grants for this subject.
+	Subject *openapi_types.Email `form:"subject,omitempty" json:"subject,omitempty"`
+
+	// Only return grants with this status.
+	Status *GetGrantsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Only return grants which end after this time.
+	Start *time.Time `form:"start,omitempty" json:"start,omitempty"`
+
+	// Only return grants which start before this time.
+	End *time.Time `form:"end,omitempty" json:"end,omitempty"`
+
+	// The maximum number of grants to return. Defaults to 50.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// token containing pagination info
+	NextToken *string `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+}
+
+// GetGrantsParamsStatus defines parameters for GetGrants.
+type GetGrantsParamsStatus string
+
 // PostGrantsJSONBody defines parameters for PostGrants.
 type PostGrantsJSONBody = CreateGrant
 
 // ValidateGrantJSONBody defines parameters for ValidateGrant.
 type ValidateGrantJSONBody = CreateGrant
 
+// PostGrantsExtendJSONBody defines parameters for PostGrantsExtend.
+type PostGrantsExtendJSONBody struct {
+	// The new end time of the grant in ISO8601 format. Must be later than the current end time.
+	End iso8601.Time `json:"end"`
+}
+
 // PostGrantsRevokeJSONBody defines parameters for PostGrantsRevoke.
 type PostGrantsRevokeJSONBody struct {
 	// An id representiing the user calling this API will be included in the GrantRevoked event
@@ -282,6 +324,9 @@ type PostGrantsJSONRequestBody = PostGrantsJSONBody
 // ValidateGrantJSONRequestBody defines body for ValidateGrant for application/json ContentType.
 type ValidateGrantJSONRequestBody = ValidateGrantJSONBody
 
+// PostGrantsExtendJSONRequestBody defines body for PostGrantsExtend for application/json ContentType.
+type PostGrantsExtendJSONRequestBody PostGrantsExtendJSONBody
+
 // PostGrantsRevokeJSONRequestBody defines body for PostGrantsRevoke for application/json ContentType.
 type PostGrantsRevokeJSONRequestBody PostGrantsRevokeJSONBody
 
@@ -627,7 +672,7 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 // The interface specification for the client above.
 type ClientInterface interface {
 	// GetGrants request
-	GetGrants(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetGrants(ctx context.Context, params *GetGrantsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostGrants request with any body
 	PostGrantsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -639,6 +684,11 @@ type ClientInterface interface {
 
 	ValidateGrant(ctx context.Context, body ValidateGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostGrantsExtend request with any body
+	PostGrantsExtendWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostGrantsExtend(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostGrantsRevoke request with any body
 	PostGrantsRevokeWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -671,8 +721,8 @@ type ClientInterface interface {
 	ValidateSetup(ctx context.Context, body ValidateSetupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetGrants(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetGrantsRequest(c.Server)
+func (c *Client) GetGrants(ctx context.Context, params *GetGrantsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGrantsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -731,6 +781,30 @@ func (c *Client) ValidateGrant(ctx context.Context, body ValidateGrantJSONReques
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostGrantsExtendWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGrantsExtendRequestWithBody(c.Server, grantId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostGrantsExtend(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGrantsExtendRequest(c.Server, grantId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostGrantsRevokeWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostGrantsRevokeRequestWithBody(c.Server, grantId, contentType, body)
 	if err != nil {
@@ -864,7 +938,7 @@ func (c *Client) ValidateSetup(ctx context.Context, body ValidateSetupJSONReques
 }
 
 // NewGetGrantsRequest generates requests for GetGrants
-func NewGetGrantsRequest(server string) (*http.Request, error) {
+func NewGetGrantsRequest(server string, params *GetGrantsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -882,6 +956,122 @@ func NewGetGrantsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.Provider != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "provider", runtime.ParamLocationQuery, *params.Provider); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Subject != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Start != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.End != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.NextToken != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextToken", runtime.ParamLocationQuery, *params.NextToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -970,6 +1160,53 @@ func NewValidateGrantRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
+// NewPostGrantsExtendRequest calls the generic PostGrantsExtend builder with application/json body
+func NewPostGrantsExtendRequest(server string, grantId string, body PostGrantsExtendJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostGrantsExtendRequestWithBody(server, grantId, "application/json", bodyReader)
+}
+
+// NewPostGrantsExtendRequestWithBody generates requests for PostGrantsExtend with any type of body
+func NewPostGrantsExtendRequestWithBody(server string, grantId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "grantId", runtime.ParamLocationPath, grantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/grants/%s/extend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewPostGrantsRevokeRequest calls the generic PostGrantsRevoke builder with application/json body
 func NewPostGrantsRevokeRequest(server string, grantId string, body PostGrantsRevokeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -1365,7 +1602,7 @@ func WithBaseURL(baseURL string) ClientOption {
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// GetGrants request
-	GetGrantsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGrantsResponse, error)
+	GetGrantsWithResponse(ctx context.Context, params *GetGrantsParams, reqEditors ...RequestEditorFn) (*GetGrantsResponse, error)
 
 	// PostGrants request with any body
 	PostGrantsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsResponse, error)
@@ -1377,6 +1614,11 @@ type ClientWithResponsesInterface interface {
 
 	ValidateGrantWithResponse(ctx context.Context, body ValidateGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateGrantResponse, error)
 
+	// PostGrantsExtend request with any body
+	PostGrantsExtendWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error)
+
+	PostGrantsExtendWithResponse(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error)
+
 	// PostGrantsRevoke request with any body
 	PostGrantsRevokeWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsRevokeResponse, error)
 
@@ -1413,7 +1655,13 @@ type GetGrantsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		Grants *[]Grant `json:"grants,omitempty"`
+		Grants []Grant `json:"grants"`
+
+		// A token to pass as nextToken to fetch the next page of results.
+		Next *string `json:"next"`
+	}
+	JSON400 *struct {
+		Error *string `json:"error,omitempty"`
 	}
 	JSON500 *struct {
 		Error *string `json:"error,omitempty"`
@@ -1494,6 +1742,40 @@ func (r ValidateGrantResponse) StatusCode() int {
 	return 0
 }
 
+type PostGrantsExtendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// A temporary assignment of a user to a principal.
+		Grant Grant `json:"grant"`
+	}
+	JSON400 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON404 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON500 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGrantsExtendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGrantsExtendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PostGrantsRevokeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1504,6 +1786,9 @@ type PostGrantsRevokeResponse struct {
 	JSON400 *struct {
 		Error *string `json:"error,omitempty"`
 	}
+	JSON404 *struct {
+		Error *string `json:"error,omitempty"`
+	}
 	JSON500 *struct {
 		Error *string `json:"error,omitempty"`
 	}
@@ -1744,8 +2029,8 @@ func (r ValidateSetupResponse) StatusCode() int {
 }
 
 // GetGrantsWithResponse request returning *GetGrantsResponse
-func (c *ClientWithResponses) GetGrantsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGrantsResponse, error) {
-	rsp, err := c.GetGrants(ctx, reqEditors...)
+func (c *ClientWithResponses) GetGrantsWithResponse(ctx context.Context, params *GetGrantsParams, reqEditors ...RequestEditorFn) (*GetGrantsResponse, error) {
+	rsp, err := c.GetGrants(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -1786,6 +2071,23 @@ func (c *ClientWithResponses) ValidateGrantWithResponse(ctx context.Context, bod
 	return ParseValidateGrantResponse(rsp)
 }
 
+// PostGrantsExtendWithBodyWithResponse request with arbitrary body returning *PostGrantsExtendResponse
+func (c *ClientWithResponses) PostGrantsExtendWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error) {
+	rsp, err := c.PostGrantsExtendWithBody(ctx, grantId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGrantsExtendResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostGrantsExtendWithResponse(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error) {
+	rsp, err := c.PostGrantsExtend(ctx, grantId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGrantsExtendResponse(rsp)
+}
+
 // PostGrantsRevokeWithBodyWithResponse request with arbitrary body returning *PostGrantsRevokeResponse
 func (c *ClientWithResponses) PostGrantsRevokeWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsRevokeResponse, error) {
 	rsp, err := c.PostGrantsRevokeWithBody(ctx, grantId, contentType, body, reqEditors...)
@@ -1899,13 +2201,25 @@ func ParseGetGrantsResponse(rsp *http.Response) (*GetGrantsResponse, error) {
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Grants *[]Grant `json:"grants,omitempty"`
+			Grants []Grant `json:"grants"`
+
+			// A token to pass as nextToken to fetch the next page of results.
+			Next *string `json:"next"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error *string `json:"error,omitempty"`
@@ -2004,6 +2318,62 @@ func ParseValidateGrantResponse(rsp *http.Response) (*ValidateGrantResponse, err
 	return response, nil
 }
 
+// ParsePostGrantsExtendResponse parses an HTTP response from a PostGrantsExtendWithResponse call
+func ParsePostGrantsExtendResponse(rsp *http.Response) (*PostGrantsExtendResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostGrantsExtendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// A temporary assignment of a user to a principal.
+			Grant Grant `json:"grant"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostGrantsRevokeResponse parses an HTTP response from a PostGrantsRevokeWithResponse call
 func ParsePostGrantsRevokeResponse(rsp *http.Response) (*PostGrantsRevokeResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -2037,6 +2407,15 @@ func ParsePostGrantsRevokeResponse(rsp *http.Response) (*PostGrantsRevokeRespons
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error *string `json:"error,omitempty"`
@@ -2371,13 +2750,16 @@ func ParseValidateSetupResponse(rsp *http.Response) (*ValidateSetupResponse, err
 type ServerInterface interface {
 	// List Grants
 	// (GET /api/v1/grants)
-	GetGrants(w http.ResponseWriter, r *http.Request)
+	GetGrants(w http.ResponseWriter, r *http.Request, params GetGrantsParams)
 	// Create Grant
 	// (POST /api/v1/grants)
 	PostGrants(w http.ResponseWriter, r *http.Request)
 	// ValidateGrant
 	// (POST /api/v1/grants/validate)
 	ValidateGrant(w http.ResponseWriter, r *http.Request)
+	// Extend grant
+	// (POST /api/v1/grants/{grantId}/extend)
+	PostGrantsExtend(w http.ResponseWriter, r *http.Request, grantId string)
 	// Revoke grant
 	// (POST /api/v1/grants/{grantId}/revoke)
 	PostGrantsRevoke(w http.ResponseWriter, r *http.Request, grantId string)
@@ -2420,8 +2802,90 @@ type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
 func (siw *ServerInterfaceWrapper) GetGrants(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGrantsParams
+
+	// ------------- Optional query parameter "provider" -------------
+	if paramValue := r.URL.Query().Get("provider"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "provider", r.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "subject" -------------
+	if paramValue := r.URL.Query().Get("subject"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "subject", r.URL.Query(), &params.Subject)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subject", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start" -------------
+	if paramValue := r.URL.Query().Get("start"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+	if paramValue := r.URL.Query().Get("end"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "nextToken" -------------
+	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGrants(w, r)
+		siw.Handler.GetGrants(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2461,6 +2925,32 @@ func (siw *ServerInterfaceWrapper) ValidateGrant(w http.ResponseWriter, r *http.
 	handler(w, r.WithContext(ctx))
 }
 
+// PostGrantsExtend operation middleware
+func (siw *ServerInterfaceWrapper) PostGrantsExtend(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "grantId" -------------
+	var grantId string
+
+	err = runtime.BindStyledParameter("simple", false, "grantId", chi.URLParam(r, "grantId"), &grantId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "grantId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostGrantsExtend(w, r, grantId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // PostGrantsRevoke operation middleware
 func (siw *ServerInterfaceWrapper) PostGrantsRevoke(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2827,6 +3317,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/grants/validate", wrapper.ValidateGrant)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/grants/{grantId}/extend", wrapper.PostGrantsExtend)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/grants/{grantId}/revoke", wrapper.PostGrantsRevoke)
 	})
@@ -2861,56 +3354,64 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbaW8bOdL+KwTfF8guoMtHvLE+jdf2eLTJ2IbsSRY7E0yo7lKLSTfZJtlyFEP/fcGj",
-	"b7ak2M5mBph8idxqksWqp6qeKlIPOOBJyhkwJfH4AQu4y0Cqf/KQgnnwlsQ0JAqm9gv9KOBMATMfSZrG",
-	"NCCKcjb8KDnTz2SwgIToT6ngKQjlZsqk/T8EGQia6jF4jG8XgOZZHCO1SgGFMKeM6q8QnyO1AJQKvqQh",
-	"CNzD8JkkaQx4rGVOOJsTBUNyL/tSctzDegI8xlIJyiK87uF7qhZGyDA0U5L4uiZQa0Bbsnz1FxIFnM1p",
-	"lAmz2UG5Hp99hEDhHv7cj3jfPUxI+qud930+/bpnlEsFhHj8q9WGk/F9c7K1/qfflylnTm0nIroyosmp",
-	"e/wEW0SCZ6n59P8C5niM/29Y4mBoR8nhhX1r3cPcruw3n8yiCKSCELnXtHaogmTrAnZDegG3fyIEWbV0",
-	"la/u01PTak5HaM4FIgxdCMIUIiLKEmBqoJc6F4KLZ9Ah6Hk8OFrvIOUJQ2Y4EqAywSBEc8ETA/iTIAAp",
-	"0U+EhTEII7HZxLNYndhBm42uX2qawA7dxQAnSFIWxWBVb+T/CUisFs+wgYWZaNsOrp3T2mV3M4h9N1hA",
-	"8AnlbodmPFyZDZRB8MlbWNqpcm/ayU3yDZ2aEPS2mGGr41QX28V25dQ6/JIN4U+PdeKZ4GRAO2FSiSzo",
-	"CBXVbxFnaMHvkeKIWLxr6LvkA6E2Ac9EAIPf2G9Mx5gPtDL6A5pTiEN0T+MYzQAxnT/oHDGOqq8hIgCR",
-	"JaExmcWgg1LdFPSp4vIYkPFhJ2w7CWkDUWVylkdFvhwiFU9jGi0MsGiIx/jlUXR0d38/CtPZ8rOZ8kRE",
-	"NwW8urLbJkCduHiIq/IVk+4m1sGn+2yfHh2LkUijXCw7awv0Nc16Eu+ci+Q8hnwwsCzR8J1cXv9yi3v4",
-	"51/e3E5uzt+cn95WYFwOL7PZY5RhshxuB4me2adHWqcxX+CvOh8Ncf5ufYfva0q3KttN56++HNEv85eH",
-	"/1hlgQ1spwKIgos8sDdDsQnbGrYzQIF5NWz7AbDQn9iBhUjRBHIuZmejDE1url4djfZ0kk2IGtS42f5o",
-	"f78/OurvHdzu7Y0PjscHo8Hx/t5/nBKIwmOsY2lfz9zymDqNopLrdQa3+tXCIK1USkPjmVLSiOlPakEl",
-	"YnBvBfZRw4JVevc9OWuyTz2r3X3u/7y+a/5JacdJKHsDLNI5as+zrFREqA4Spb96krZHB8+sbZlZLPqx",
-	"kRAaIxKGQqvDiZzJTlUV0piB21X1RPKep8y+TCGgcxo4mUKiyAD9nEmFEqKCRc3KLySyQaFN75vOneum",
-	"AiUnc27lnvErg9mKw1f91efzzrJmi1caU7l/bvKrEs05Dh3QNsGjsK4uRcQPbuFBwBNcat9EVu1kYUKZ",
-	"xLYs6Yw2CpKUCyJWzhd1ZLNEIgeGphSUBTQl8Z8/DpVrkRkZhcGM9EfkVdA/PDg+6JPweL9/dPxyb3Sw",
-	"fzTbPyZdSzCS6IeTs7/i0q5xSRGVddSiQSaERp1+py6xkc8Ri+vzy7PJ5QXu4ZPT28nbc9zD0/O3V6/P",
-	"z3APn//7ejK1n6bTq6mXcfwVGrtDo+E9zka9nQNlJUY+c3Q0zGlHD31cJHV4rMDqKcHVPPxaBv1sVLVm",
-	"By3Kbsz07tP87uNx+urL/eooxsVGrgqJ69sJFjQOBbBaCdyWvlbb9rYqISYziL3fLEmc7aAHO0H+eq8U",
-	"s6kVt6/ddBMefr7P0oOD0UcRHZa62Viw7NQXqIriUVdN4l2rTRoFKxjF6Ty9C22J8YZHvmQf8wgBU2LV",
-	"TuQxLK0d2rFRjzJfV8Px5PLHK9zD706ml9Z9ugNvIqPuiROQkkQddXjNzkZAO1vFtHqnu2lpjyUHApbH",
-	"d/DleGam70L6/xizld10YHTdKzJAW5PXZYxu9ErCDR66W3jRr1TEqyzVKWCr4eXBYdnjQiQilEllElet",
-	"X4WMcopc7Fqs+SptAJv2ksy7fh2sNCVC0SCLiXCL2a6UzCWCsIfoHBG2qvXDt0Y5Gm5jf+WWe0hmwQIR",
-	"iT7EVKq+lLyvM478MPCVvTGPdo8u2hs84lm++uAlkZ20zH7Xlr8eBX6/nl5dTM9vbnAP3/xyemo/lVm1",
-	"Kyz44GbErPCQpkmdMjyAbIHusSGh0YfuPkBoQrr4ewnumMBFNgOoGv+nkr1QyPbFV3UKfnI9+f326vX5",
-	"JZIQCFBoQSRiXKEZAMtnMKrKYtMhxWMlMvDAxk3fFvXdAtRC89q6SFV53GQzzmMgbBO+iwkmZ94aZlv1",
-	"5ENBLrnHzM4qW2imJWWUzXne8yeW9ruFT81JJPqRKI22TMR4jBdKpXI8HJanlAPK2zzc0FwIGwc+6OR6",
-	"gptd4/xLHeZBSDt+bzCyR3PASErxGB8MRoORjmVELQzAhiSlw+Xe0PB7ewIEnprlDZXK1gDm5E5D1AB/",
-	"omF9AerCDm+cR+6PRk89ivoapkNct7p53LH9QPC1HvfSSutbo9jVsH4yaI44siQhYpUrqdCEIjqQ/ppv",
-	"470u3bn06Nb2exBxNWh+nFEc+9nSa3JmzzJCSIGFiDPPSeALiUTGdLk8QO8WwPRfjLJIv33y7ga9Icks",
-	"JEgXF+hGQYp+zJg9Z+jZhujkTLumnpiyJbd2qiSV+hh0z8Wneczv9TJtVFxzWYVFfmdgtQMimjUcqrKR",
-	"gvPsVtUJuPt9b//g8OXR07tgwUJQ+UPdZ7fUaiW4N2G32vLz4PO2aKk02vTrlsPtbYdw/ah43cOHjwD+",
-	"M7iLw31Ryjf9Zd1rRKdhzppMrPD60lsS6xc0honKXco6jsyCACA06OeZi2baOTSybbOlheGcC+Qyfh2M",
-	"H2X7tT+G+gLWd7JbXSkeMz2Y/yfheihgyT9ZcxFBElAgtI0fOvE9OdN+q5/pDJVztDF2M+Jq/rZMpFRy",
-	"k/G97wq4UyMVIgyRwBCoovnXFcHsiCcAoJ7ZrFrEpPOoSkAqQAJTNAeo6Q0GJI7tAyo1CShOtykL4iyE",
-	"EFGbFpyL61VCBEvwnXA1uFApk/eqkR+Vf45A4+wd7RBoytsjXho0NTlZIk32ROJuQViV25F5pnRpGBEW",
-	"FqRVDtDE1HruXXOTRKI5obG72uDulAQ8hMK0L0cj9LcJUyAYidENiCUIZHb7dy8TKzjr19urcQdnV9U3",
-	"h9V0X7k0U1G9U09d94WeNrPQ8rXm7vXX15Vvn8RFv+rWjYd2flOSWerAq8ChgLkAuejOk1OIOQkNGAMS",
-	"LMoSI99R+y5jXddTu4Id9cdWeiMWGLmb2+1S5EP+cRKuO2F5AapyEQrNVoiGXt+s9LOepKbdtNMFwcPR",
-	"4feIwlpLacV0DUbgSfql7r8u72+15NDSvX7zdle3dS1aajfHzO3RYkembMvTw0+3t9dofzRCV69tsUXQ",
-	"B5bFcX4pTQ9t3FZrNm1CDqZt4x74JPBCzHuFbCP5ytnFC1k/lcyJ2F0GYlUapTys290iPd+a+YVblJKV",
-	"CUaUoX/dXF26s+KO5YmI5NPWLsvZ/JS6pivfoo9kn9/Mxz1G3uDtj+Fcj4kRLW93MbYu53d2fBHJrexO",
-	"Y8Mg0V52RJYGu5brrCgZHX5tJDCUPK2cFnQG/5Mcwd8KHMUlzT9yBtDqc9cF/giYGD4QEek/Kr9i6Kag",
-	"2vw89aWBys8INrHT8ocajyLqnt95fD+r1gipMWuuw29p1553MmPER+NDgsrS3ZpM1HaZJChFWdQiA+iM",
-	"gz1IcZK0bmTNdL0XUalAQIj6iMRx4zxSxxMiJYRoSUn17rv9zUGVbxDDOA5Ho7KGbPKJgDB7rlM9etRS",
-	"u15rPsAdU1JNNRBhiDLzfuUCl79DdqN1126Q+GFV+f3YsPnjsUd1Glo/vnhk4vP2ubQaGuXCi9L0NspK",
-	"U5dbkJcnPOPhMOYBiRdcqvHx6Hgfa1rgiuCHGrvQ3lI8ycvj9fv1fwMAAP//3IXrpH03AAA=",
+	"H4sIAAAAAAAC/+xcfW/bOJP/KoTugN4Biu28NLfxX0+uyXZ97dMESbY93G6xpaWxzFYiFZJy4gb+7ge+",
+	"6ZWy1SS77QPsX3UkkjOc+c1wXqg+BBHLckaBShFMHwIOtwUI+d8sJqAfvMcpibGEK/NCPYoYlUD1T5zn",
+	"KYmwJIyOPwtG1TMRLSHD6lfOWQ5c2pUKYf6NQUSc5GpOMA1uloAWRZoiuc4BxbAglKhXiC2QXALKOVuR",
+	"GHgQBnCPszyFYKp4zhhdYAljfCf2hGBBGKgFgmkgJCc0CTZhcEfkUjMZx3pJnF42GOpM6HLmqL8QKGJ0",
+	"QZKC682OKnps/hkiGYTB/V7C9uzDDOe/mXU/uuU3oRYu4RAH09+MNCyPH9uLbTYbM17kjFqxnfLkQrMm",
+	"ruzjJ+gi4azI9a9/57AIpsG/jSscjM0sMX5tRm3CgBnKfvWJIklASIiRHaakQyRkOwmYDQWbcv+Yc7zu",
+	"yMpR98mprTUrI7RgHGGKXnNMJcI8KTKgcqRInXPO+DPIENQ6HhxtBnB5SpGejjjIglOI0YKzTAP+NIpA",
+	"CPQLpnEKXHOsN/EsWsdU7tKJJtZRgZk6RAGnSBCapGBEr/n/BXAql8+wgaVeaNcOLq3RGrLDFGLGRkuI",
+	"viBndmjO4rXewFsipN6OeC4t6F+DbMTqo20iYUDhXnYN8hRJ9gUokgzlWAiEBVIDb9zDBchoqYGmHqMc",
+	"J6BcLQdRpFJbLi3SFM+Vn5W8gNAD7w4wRGDZGQaQHCeEYuUwUiKkIm+Eq2VdHThPlvTKLOU81yBxO/C8",
+	"0u7+fbnCTidVJzZEDNXSSgB4y1Gj5lr29EGgHcSMCsmLqMct198iRtGS3SnlYz1Va98e9BAr1bOCRzD6",
+	"nf5OlT//RGqzP6EFgTRGdyRN0RyQQgciC0QZqg9DmAPCK0w0dBSMmqogT2WXpYAYr5gNfMCUROr4wCMi",
+	"33ktJMtTkiw1sEgcTIOXx8nx7d3dJM7nq3u95ClPrkt49UUS2wB1as+eoM5fuegwtg6/3BUH5PiET3ie",
+	"OLbMqh3QNyTrCXIWjGfnKbjJQItMwXf27vLXmyAM/vnr25vZ9fnb81c3NRhX06vI4THC0BFF0HXIod6n",
+	"h1srsYcdXojEgRvb3OHHhtCNyIbJ/Kevx+Tr4uXRf62LyBwirzhgCa/dIdr2atoTKtjOAUV6aNy1A6Cx",
+	"P4gCGiNJMnBxr1mNUDS7vvjpeLKvApoMy1EjDj6YHBzsTY739g9v9venhyfTw8no5GD//6wQsAymgfKl",
+	"e2rljsU0Q1YimKIzulFDS4V0whYSa8sUgiT6PJFLok6YO8OwLwwvI3jvvmdn7UhfrWp27+yfNXfNvkhl",
+	"OBmhb4EmKh7Y95AVEnPpp6lfPUnak8NnlrYoDBb92MgwSRGOY67EYVkuRK+oSm70xN2iemKi5I7MPZFD",
+	"RBYksjzFWOIR+mchJMqwizpqx5xxCt1Uqm3cTjY1KFmenZZDbVcaszWDr9urz+atZvUWLxSmnH1us6sK",
+	"zQ6HFmjb4FFqN1Ba+4clPIpYFlTS155VGVmcESoCkwL2ehsJWc445mtri8qzmUDCAUOFFIRGJMfpv74f",
+	"qmjhOZ7E0RzvTfBP0d7R4cnhHo5PDvaOT17uTw4PjucHJ7iPBMWZejg7+9svDfVLEsuiJ++PCs4V6tSY",
+	"JseaPxtYXJ6/O5u9ex2Ewemrm9n78yAMrs7fX7w5PwvC4Px/L2dX5tfV1cWVN+L42zX2u0Yd91gdhYMd",
+	"Zc1HPrN3JPFwC32cJ7V4rMHqKc5VP/zWCPrZQtWGHhQrwyLT2y+L288n+U9f79bHaVBu5KLkuLmdaEnS",
+	"mANtpMBd7lvVhV1CSPEcUu+bFU6LAXIwC7jhYcVmWyp2X8NkEx/d3xX54eHkM0+OKtlsTVgGlmEqVjzi",
+	"anA8NNskSbSGSZov8tvYpBhvWeI77FOWIKCSr7sHeQorSLtzlG9Us/Trujuevfv5IgiDD6dX74z59Dve",
+	"TCT9C2cgBE568vCGnjWDZrWaatVOh0lpn2aHHFYnt/D1ZK6X70P6X4zZ2m56MLoJyxOgK8nLykc3t9Hn",
+	"X/SDYe5lnTfYq5HqZbBT8PLgsKpxIZxgQoXUB1ejXoW0cMqz2JazHZUugHV5SVi60BOV5phLEhUp5paY",
+	"qUoJxxHEoSpKYbpu9B52ejkS74r+qi2HSBTRUhVUP6mypeo67akTR3wa+dLelCXDvctb5mXPxKsP3iCy",
+	"Nywz77r8N73AH5dXF6+vzq+vgzC4/vXVK/OrOlX73IIPbprNWhzSVqkVhgeQHdA91iW0av79zZo2pMu/",
+	"V2BbMtazaUA14n8i6AuJTA9i3QzBTy9nf9xcvDl/hwREHCRaYoEok2gOQN0K8e7iemhbHOsuqx+WIJfA",
+	"2yzV+bGLzRlLAdNt+C4XmJ15c5hd2ZMPBY5zj5qtVnaEmSYoI3TBXM0fm7DfEn6lu77oZywhCIOCp8E0",
+	"WEqZi+l4XHWER4R143Ad5kLcaq6h08tZ0K4au5fKzQMXZv7+aGLaoEBxTlRFdjQZTYIwyLFcaoCNcU7G",
+	"q/1x1d1JwJOzqE6SyQGErrdrxgSKMFVlwwVJJXCI0Xxdqki5HS0uhGlsjXuEZirT15niHaExu1NIcDBD",
+	"herAaaCYjFJNVHl9jjnOQAIXIWI0XVtG0N2SREvEVsBTnCMVMuvJdmXMoWxTKrAos9LGOlOm+BpsZ0wL",
+	"w60fTH9r7/xCETTrOLqqRyuXFecOjkSNvy2Ar51zmdYzmqrd0/FN30LUirWPYJVMVfQ62eNjGLDyJcIp",
+	"s4+BMq0r6T9TTj2MTQ0KBRu8kGBlphC3hV/eI67+6sO38GLAPIcF47CbHVMRfCozyllm+J5kRYZokc2B",
+	"q4PVsiWZ5XOEzmCBi9Q8eznp4yklGWmKyK4dTPcnE12MsH+VrBEqIQHu4800erWfJFRZvO2rqiBMe1E/",
+	"E2U7eKspfWxdPzmYTPqCmHLc2NMn34TB0ZCpzVsZmzB4+YhZulqUZZivnastfZPEiaj1qz+qAiATHg9t",
+	"qsYI20qWa4qWFzX0YxUg6o5oDLkyEUY9dzdeCMQLqjGKPiyBqr+0nhhFpx+u0VuczWNs/MG1hBz9XFDT",
+	"rQwNvGdnyq2rhQldMdP0roWmzTnojvEvi5TdKTJdP33JROWoq1te6wHt9XYlCNVzmjJzGlYb4nD7x/7B",
+	"4dHL46fX0qMlJ+IfzZN/R8Wnwvu2aLzeOPB07m+W4G/2bTo2s78bws3LPd/PXCzuy4Jg2142YSvGGbvc",
+	"S9H129J7nKoBCsNYOpMyhiOKKAKINfpZYWMiF7aYkm0Hwy6jcDx+G4wfpfuN3w22Tqw331FvTaF41PSg",
+	"/53FmzHcS9v32RqpVfienbkzRMW51RFiVwzqWYDJZ7YeKH6QnGuuVLsKaKzdI0c40gmZ4WK+RhlbqTdE",
+	"iqpBlWIJfIRuSrwgYnIuDtqxCMK8EWvlCQ3lJwBpYE9NtceH9tVMU2But6cMxxwtrtni1vmrGnCtTM+1",
+	"DzrXVR8TLjyT6zuaHH0Pw7O4TYY7zMoSOazYF/jRLPFKc4UwbdjfNgsyM57NgoxY+Kz36okybRBAJXFH",
+	"he71RThNzQMiVFJf3lYjNEoLlRITY0UWcYpKjGAFvhsrLcRXPP2Ne417i5IhuK/u63qLIVc6phY6WeGZ",
+	"CW9tJG1mOmdpw2hdyHBBoy2C0DVaVnd3BVqofrCeY7lHEYuhBMTLyQT9x4xK4BSn6Br4CjjSu/1Pb22j",
+	"rFx9u5Zbt56Hir49rSH72jXlmuiteJqyL+W0vRZVDWvvXr2+rL31SWCwnX/T3VvPXdtN6I25nidJrGTg",
+	"FeCYw4KDWPbHuVeQMhxrMEY4WlaFRrej7tcjTVlfGQpm1o8t9JYv0Hy3t9snyAf3cxZvemH5GmTtOrSK",
+	"/oi/7ljraj1JTMOk0wfB7+SFlZTymupacYQnVKhk/23Rwk5Njk34vde+492vXRuu18ab73XKHemyizse",
+	"frm5uUQHkwm6eGOKJRh9Un0UdzVdTW3dWW+3bmIGunljH/g4KCs9tsAQll8NCX3uSJKZGpsQEJubrzUC",
+	"pkiOa9/xlAF+7QAbtTZ1NDlyrJrhjk+41x9GVIx3bwlppmo17I55eC/Bbw03XTz1QjTvVe2skA9HU+ij",
+	"6QSNcrzWjpRQ9D/XF+9sTtRDHvNEPI12VUpzymrIykf0kfH2n+afPEre4qn+qiiz46ns+dDk8zs7LZ6I",
+	"nZGpwoZGovlcA5nA3zaN52W5qnQUnY7W1oPr1CH4zwJH+ZnJj3x6KfHZC48/AibGD5gn6o/aN6/94bNS",
+	"P8t9R1jto9NtkXX1We+jkgzPV8HfT6uNYFqr1cnwz9Rr6F1MK/HR+BAgi3xYgZuYCrcAqcrXnUAGnTFw",
+	"ZUnNSedO+RwQh4QI0/nfQzhNWzeqiHBhx4rg+td75gvVeqyEdWBxNJlU+W87FoowNTdT6penFNe2nOgm",
+	"GB4UcUJVTYhQPb52Bd1fnb9WsuuWhPywqv1vA+P2fzXwqNpK5/PRRx583hq7EkMr1XlRqd54WaFrCgbk",
+	"1R2V6XicsginSybk9GRychBsPpYJ/EMjulDWUj5xqf3m4+b/BwAlF9cUq0EAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrGrantNotFound = errors.New("grant not found")
+
+var ErrGrantNotExtendable = errors.New("only pending or active grants can be extended")
+
+// ValidateExtension checks that the grant can be extended to end at the provided time.
+// The grant status must be up to date when this is called.
+func (g Grant) ValidateExtension(end time.Time) error {
+	if g.Status != GrantStatusPENDING && g.Status != GrantStatusACTIVE {
+		return ErrGrantNotExtendable
+	}
+	if !end.After(g.End.Time) {
+		return ErrInvalidGrantTime{"grant end time can only be moved later"}
+	}
+	return nil
+}
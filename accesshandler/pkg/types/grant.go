@@ -1,5 +1,10 @@
 package types
 
+import "errors"
+
+// ErrGrantAlreadyExists is returned when creating a grant with the ID of an existing grant.
+var ErrGrantAlreadyExists = errors.New("grant already exists")
+
 // NewGrant creates a pending Grant from a validated
 // CreateGrant payload.
 func NewGrant(vcg ValidCreateGrant) Grant {
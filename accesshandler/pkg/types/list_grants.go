@@ -0,0 +1,74 @@
+package types
+
+import "time"
+
+// DefaultListGrantsLimit is the page size used when listing grants
+// if a limit isn't specified.
+const DefaultListGrantsLimit = 50
+
+// MaxListGrantsLimit is the largest page size which can be used when listing grants.
+const MaxListGrantsLimit = 100
+
+// ListGrantsOpts contains the filters and pagination options
+// used when listing grants from a runtime.
+//
+// Empty fields are ignored.
+type ListGrantsOpts struct {
+	Provider string
+	Subject  string
+	Status   GrantStatus
+	// Start, if set, only matches grants which end after this time.
+	Start *time.Time
+	// End, if set, only matches grants which start before this time.
+	End *time.Time
+	// Limit is the maximum number of grants to return in a page.
+	Limit int
+	// NextToken is an opaque runtime-specific pagination token.
+	NextToken string
+}
+
+// ListGrantsOptsFromParams builds list options from the query parameters
+// of a GET /api/v1/grants request.
+func ListGrantsOptsFromParams(params GetGrantsParams) ListGrantsOpts {
+	opts := ListGrantsOpts{
+		Start: params.Start,
+		End:   params.End,
+		Limit: DefaultListGrantsLimit,
+	}
+	if params.Provider != nil {
+		opts.Provider = *params.Provider
+	}
+	if params.Subject != nil {
+		opts.Subject = string(*params.Subject)
+	}
+	if params.Status != nil {
+		opts.Status = GrantStatus(*params.Status)
+	}
+	if params.Limit != nil {
+		opts.Limit = *params.Limit
+	}
+	if params.NextToken != nil {
+		opts.NextToken = *params.NextToken
+	}
+	return opts
+}
+
+// Matches returns true if the grant matches all of the filters.
+func (o ListGrantsOpts) Matches(g Grant) bool {
+	if o.Provider != "" && g.Provider != o.Provider {
+		return false
+	}
+	if o.Subject != "" && string(g.Subject) != o.Subject {
+		return false
+	}
+	if o.Status != "" && g.Status != o.Status {
+		return false
+	}
+	if o.Start != nil && !g.End.After(*o.Start) {
+		return false
+	}
+	if o.End != nil && !g.Start.Before(*o.End) {
+		return false
+	}
+	return true
+}
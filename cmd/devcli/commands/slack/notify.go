@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/aws/aws-lambda-go/events"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/deploy"
 	"github.com/common-fate/granted-approvals/pkg/gconfig"
@@ -82,9 +81,9 @@ var requestMessageCommand = cli.Command{
 		if err != nil {
 			return err
 		}
-		return slack.HandleRequestEvent(ctx, zap.S(), events.CloudWatchEvent{
-			Detail:     json.RawMessage(m),
-			DetailType: gevent.RequestCreatedType,
+		return slack.HandleRequestEvent(ctx, zap.S(), gevent.Event{
+			Detail: json.RawMessage(m),
+			Type:   gevent.RequestCreatedType,
 		})
 
 	},
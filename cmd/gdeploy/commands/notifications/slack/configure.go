@@ -45,16 +45,21 @@ var configureSlackCommand = cli.Command{
 		clio.Info("Copy & paste the following link into your web browser to create a new Slack app for Granted Approvals:")
 		fmt.Printf("\n\n%s\n\n", appInstallURL)
 		clio.Info("After creating the app, install it to your workspace and find your Bot User OAuth Token in the OAuth & Permissions tab.")
+		clio.Info("The Signing Secret is in the Basic Information tab, and is used to verify requests when reviewers approve or decline requests in Slack.")
 
 		var slack slacknotifier.SlackNotifier
 		cfg := slack.Config()
+		interactivityCfg := slack.InteractivityConfig()
 		currentConfig := dc.Deployment.Parameters.NotificationsConfiguration[slacknotifier.NotificationsTypeSlack]
 		if currentConfig != nil {
 			err = cfg.Load(ctx, &gconfig.MapLoader{Values: currentConfig})
 			if err != nil {
 				return err
 			}
+			// the signing secret won't be set if Slack was configured before interactivity was supported.
+			_ = interactivityCfg.Load(ctx, &gconfig.MapLoader{Values: currentConfig})
 		}
+		cfg = append(cfg, interactivityCfg...)
 
 		for _, v := range cfg {
 			err := deploy.CLIPrompt(v)
@@ -0,0 +1,74 @@
+package oncall
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/common-fate/granted-approvals/pkg/oncall"
+	"github.com/urfave/cli/v2"
+)
+
+var configureCommand = cli.Command{
+	Name:        "configure",
+	Description: "Configure an on-call schedule source",
+	Usage:       "Configure an on-call schedule source",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		registry := oncall.Registry()
+		var selected string
+		p := &survey.Select{Message: "The on-call source to configure", Options: registry.CLIOptions()}
+		err = survey.AskOne(p, &selected)
+		if err != nil {
+			return err
+		}
+		sourceType, s, err := registry.FromCLIOption(selected)
+		if err != nil {
+			return err
+		}
+
+		cfg := s.Source.Config()
+		// if there is existing config, the CLI prompts will have defaults loaded.
+		currentConfig := dc.Deployment.Parameters.OnCallConfiguration[sourceType]
+		if currentConfig != nil {
+			err = cfg.Load(ctx, &gconfig.MapLoader{Values: currentConfig})
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, v := range cfg {
+			err := deploy.CLIPrompt(v)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = deploy.RunConfigTest(ctx, s.Source)
+		if err != nil {
+			return err
+		}
+
+		// if tests pass, dump the config and update in the deployment config
+		newConfig, err := cfg.Dump(ctx, gconfig.SSMDumper{Suffix: dc.Deployment.Parameters.DeploymentSuffix})
+		if err != nil {
+			return err
+		}
+		dc.Deployment.Parameters.OnCallConfiguration.Upsert(sourceType, newConfig)
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+
+		clio.Successf("Successfully configured %s", s.Description)
+		clio.Warn("Your changes won't be applied until you redeploy. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
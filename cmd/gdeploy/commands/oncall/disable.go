@@ -0,0 +1,49 @@
+package oncall
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/common-fate/granted-approvals/pkg/oncall"
+	"github.com/urfave/cli/v2"
+)
+
+var disableCommand = cli.Command{
+	Name:        "disable",
+	Description: "Disable an on-call schedule source",
+	Usage:       "Disable an on-call schedule source",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		registry := oncall.Registry()
+		var selected string
+		p := &survey.Select{Message: "The on-call source to disable", Options: registry.CLIOptions()}
+		err = survey.AskOne(p, &selected)
+		if err != nil {
+			return err
+		}
+		sourceType, s, err := registry.FromCLIOption(selected)
+		if err != nil {
+			return err
+		}
+		if _, ok := dc.Deployment.Parameters.OnCallConfiguration[sourceType]; !ok {
+			clio.Infof("%s isn't configured so this command will not make any changes.", s.Description)
+			return nil
+		}
+
+		dc.Deployment.Parameters.OnCallConfiguration.Remove(sourceType)
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+		clio.Successf("Successfully disabled %s", s.Description)
+		clio.Warn("Access rules which use a schedule from this source will require review. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
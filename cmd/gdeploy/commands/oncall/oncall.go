@@ -0,0 +1,14 @@
+package oncall
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "oncall",
+	Aliases:     []string{"on-call"},
+	Description: "Manage on-call schedule sources like PagerDuty and Opsgenie",
+	Usage:       "Manage on-call schedule sources like PagerDuty and Opsgenie",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{&configureCommand, &disableCommand},
+}
@@ -0,0 +1,74 @@
+package tickets
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/common-fate/granted-approvals/pkg/ticket"
+	"github.com/urfave/cli/v2"
+)
+
+var configureCommand = cli.Command{
+	Name:        "configure",
+	Description: "Configure a ticketing system",
+	Usage:       "Configure a ticketing system",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		registry := ticket.Registry()
+		var selected string
+		p := &survey.Select{Message: "The ticketing system to configure", Options: registry.CLIOptions()}
+		err = survey.AskOne(p, &selected)
+		if err != nil {
+			return err
+		}
+		systemType, s, err := registry.FromCLIOption(selected)
+		if err != nil {
+			return err
+		}
+
+		cfg := s.System.Config()
+		// if there is existing config, the CLI prompts will have defaults loaded.
+		currentConfig := dc.Deployment.Parameters.TicketConfiguration[systemType]
+		if currentConfig != nil {
+			err = cfg.Load(ctx, &gconfig.MapLoader{Values: currentConfig})
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, v := range cfg {
+			err := deploy.CLIPrompt(v)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = deploy.RunConfigTest(ctx, s.System)
+		if err != nil {
+			return err
+		}
+
+		// if tests pass, dump the config and update in the deployment config
+		newConfig, err := cfg.Dump(ctx, gconfig.SSMDumper{Suffix: dc.Deployment.Parameters.DeploymentSuffix})
+		if err != nil {
+			return err
+		}
+		dc.Deployment.Parameters.TicketConfiguration.Upsert(systemType, newConfig)
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+
+		clio.Successf("Successfully configured %s", s.Description)
+		clio.Warn("Your changes won't be applied until you redeploy. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
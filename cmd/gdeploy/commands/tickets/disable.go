@@ -0,0 +1,49 @@
+package tickets
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/common-fate/clio"
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/common-fate/granted-approvals/pkg/ticket"
+	"github.com/urfave/cli/v2"
+)
+
+var disableCommand = cli.Command{
+	Name:        "disable",
+	Description: "Disable a ticketing system",
+	Usage:       "Disable a ticketing system",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		f := c.Path("file")
+
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		registry := ticket.Registry()
+		var selected string
+		p := &survey.Select{Message: "The ticketing system to disable", Options: registry.CLIOptions()}
+		err = survey.AskOne(p, &selected)
+		if err != nil {
+			return err
+		}
+		systemType, s, err := registry.FromCLIOption(selected)
+		if err != nil {
+			return err
+		}
+		if _, ok := dc.Deployment.Parameters.TicketConfiguration[systemType]; !ok {
+			clio.Infof("%s isn't configured so this command will not make any changes.", s.Description)
+			return nil
+		}
+
+		dc.Deployment.Parameters.TicketConfiguration.Remove(systemType)
+		err = dc.Save(f)
+		if err != nil {
+			return err
+		}
+		clio.Successf("Successfully disabled %s", s.Description)
+		clio.Warn("Requests for access rules which require a ticket from this system will fail. Run 'gdeploy update' to apply the changes to your CloudFormation deployment.")
+		return nil
+	},
+}
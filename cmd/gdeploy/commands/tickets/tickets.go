@@ -0,0 +1,14 @@
+package tickets
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var Command = cli.Command{
+	Name:        "tickets",
+	Aliases:     []string{"ticket"},
+	Description: "Manage ticketing systems like Jira and Linear which verify the tickets linked to requests",
+	Usage:       "Manage ticketing systems like Jira and Linear which verify the tickets linked to requests",
+	Action:      cli.ShowSubcommandHelp,
+	Subcommands: []*cli.Command{&configureCommand, &disableCommand},
+}
@@ -17,7 +17,9 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/deploy"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/identity/identitysync"
+	"github.com/common-fate/granted-approvals/pkg/oncall"
 	"github.com/common-fate/granted-approvals/pkg/server"
+	"github.com/common-fate/granted-approvals/pkg/ticket"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/sethvargo/go-envconfig"
 	"go.uber.org/zap"
@@ -86,6 +88,23 @@ func buildHandler() (*Lambda, error) {
 	if err != nil {
 		return nil, err
 	}
+	occ, err := deploy.UnmarshalFeatureMap(cfg.OnCallSettings)
+	if err != nil {
+		panic(err)
+	}
+	onCall, err := oncall.NewResolver(ctx, occ)
+	if err != nil {
+		return nil, err
+	}
+	tc, err := deploy.UnmarshalFeatureMap(cfg.TicketSettings)
+	if err != nil {
+		panic(err)
+	}
+	tickets, err := ticket.NewVerifier(ctx, tc)
+	if err != nil {
+		return nil, err
+	}
+
 	api, err := api.New(ctx, api.Opts{
 		Log:                 log,
 		DynamoTable:         cfg.DynamoTable,
@@ -100,6 +119,8 @@ func buildHandler() (*Lambda, error) {
 		IDPType:             cfg.IdpProvider,
 		AdminGroupID:        cfg.AdminGroup,
 		DeploymentConfig:    dc,
+		OnCall:              onCall,
+		Tickets:             tickets,
 	})
 	if err != nil {
 		return nil, err
@@ -3,11 +3,14 @@ package main
 import (
 	"context"
 
+	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
 
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/granted-approvals/pkg/config"
 	"github.com/common-fate/granted-approvals/pkg/eventhandler"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/storage"
 
 	"github.com/common-fate/ddb"
 	"github.com/joho/godotenv"
@@ -27,7 +30,11 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	eventHandler, err := eventhandler.New(ctx, db)
+	requests, err := storage.NewRequestWriter(ctx, cfg.DynamoTable)
+	if err != nil {
+		panic(err)
+	}
+	eventHandler, err := eventhandler.New(ctx, db, requests)
 	if err != nil {
 		panic(err)
 	}
@@ -37,5 +44,7 @@ func main() {
 	}
 	zap.ReplaceGlobals(log.Desugar())
 	zap.S().Infow("starting event handler with configuration", "config", cfg)
-	lambda.Start(eventHandler.HandleEvent)
+	lambda.Start(func(ctx context.Context, event events.CloudWatchEvent) error {
+		return eventHandler.HandleEvent(ctx, gevent.FromCloudWatchEvent(event))
+	})
 }
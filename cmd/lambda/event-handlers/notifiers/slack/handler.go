@@ -11,6 +11,7 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/config"
 	"github.com/common-fate/granted-approvals/pkg/deploy"
 	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
 	slacknotifier "github.com/common-fate/granted-approvals/pkg/notifiers/slack"
 	"github.com/joho/godotenv"
 	"github.com/sethvargo/go-envconfig"
@@ -87,5 +88,5 @@ func (h *handler) handleEvent(ctx context.Context, event events.CloudWatchEvent)
 	if err != nil {
 		panic(err)
 	}
-	return notifier.HandleEvent(ctx, event)
+	return notifier.HandleEvent(ctx, gevent.FromCloudWatchEvent(event))
 }
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/ddb"
+	ahconfig "github.com/common-fate/granted-approvals/accesshandler/pkg/config"
+	"github.com/common-fate/granted-approvals/pkg/config"
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/reconciler"
+	"github.com/joho/godotenv"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.ReconcilerConfig
+	ctx := context.Background()
+	_ = godotenv.Load()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+
+	db, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	dc, err := deploy.GetDeploymentConfig()
+	if err != nil {
+		panic(err)
+	}
+	providers, err := dc.ReadProviders(ctx)
+	if err != nil {
+		panic(err)
+	}
+	err = ahconfig.ConfigureProviders(ctx, providers)
+	if err != nil {
+		panic(err)
+	}
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: cfg.EventBusArn})
+	if err != nil {
+		panic(err)
+	}
+
+	r := reconciler.Reconciler{
+		DB:          db,
+		Clock:       clock.New(),
+		EventPutter: eventBus,
+		Providers:   ahconfig.Providers,
+		Remediate:   cfg.Remediate,
+		Window:      cfg.Window,
+	}
+	zap.S().Infow("starting grant reconciler", "config", cfg)
+	lambda.Start(r.Reconcile)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/config"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/sweeper"
+	"github.com/joho/godotenv"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.SweeperConfig
+	ctx := context.Background()
+	_ = godotenv.Load()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+
+	db, err := ddb.New(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	requests, err := storage.NewRequestWriter(ctx, cfg.TableName)
+	if err != nil {
+		panic(err)
+	}
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: cfg.EventBusArn})
+	if err != nil {
+		panic(err)
+	}
+
+	s := sweeper.Sweeper{
+		DB:          db,
+		Clock:       clock.New(),
+		EventPutter: eventBus,
+		Requests:    requests,
+	}
+	zap.S().Infow("starting pending request sweeper", "config", cfg)
+	lambda.Start(s.Sweep)
+}
@@ -2,16 +2,26 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
+	lambdasvc "github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/awslabs/aws-lambda-go-api-proxy/handlerfunc"
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/internal"
 	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/cfaws"
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	slacknotifier "github.com/common-fate/granted-approvals/pkg/notifiers/slack"
+	"github.com/common-fate/granted-approvals/pkg/service/accesssvc"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
 	"github.com/common-fate/granted-approvals/pkg/storage"
 	"github.com/go-chi/chi/v5"
 	"github.com/pkg/errors"
@@ -47,7 +57,8 @@ func buildHandler() (*Lambda, error) {
 	}
 
 	l := Lambda{
-		Server: s.Routes(),
+		Server:                  s.Routes(),
+		ProcessSlackInteraction: s.processSlackInteraction,
 	}
 	return &l, nil
 }
@@ -55,10 +66,23 @@ func buildHandler() (*Lambda, error) {
 type Config struct {
 	LogLevel    string `env:"LOG_LEVEL,default=info"`
 	DynamoTable string `env:"APPROVALS_TABLE_NAME,required"`
+	// The following are used to review requests from Slack.
+	Region           string `env:"AWS_REGION"`
+	AccessHandlerURL string `env:"ACCESS_HANDLER_URL"`
+	EventBusArn      string `env:"EVENT_BUS_ARN"`
+	AdminGroup       string `env:"APPROVALS_ADMIN_GROUP"`
+	FrontendURL      string `env:"APPROVALS_FRONTEND_URL"`
+	// FunctionName is the name of this Lambda function, which is invoked asynchronously to process Slack interactions.
+	FunctionName string `env:"AWS_LAMBDA_FUNCTION_NAME"`
 }
 
 type Server struct {
-	db *ddb.Client
+	db           *ddb.Client
+	access       slacknotifier.Reviewer
+	interactions InteractionQueue
+	dc           deploy.DeployConfigReader
+	adminGroup   string
+	frontendURL  string
 }
 
 func NewServer(ctx context.Context, cfg Config) (*Server, error) {
@@ -66,17 +90,58 @@ func NewServer(ctx context.Context, cfg Config) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
+	ahc, err := internal.BuildAccessHandlerClient(ctx, internal.BuildAccessHandlerClientOpts{Region: cfg.Region, AccessHandlerURL: cfg.AccessHandlerURL})
+	if err != nil {
+		return nil, err
+	}
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: cfg.EventBusArn})
+	if err != nil {
+		return nil, err
+	}
+	dc, err := deploy.GetDeploymentConfig()
+	if err != nil {
+		return nil, err
+	}
+	requests, err := storage.NewRequestWriter(ctx, cfg.DynamoTable)
+	if err != nil {
+		return nil, err
+	}
+	awsCfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clk := clock.New()
 	s := Server{
 		db: db,
+		interactions: &LambdaInteractionQueue{
+			Client:       lambdasvc.NewFromConfig(awsCfg),
+			FunctionName: cfg.FunctionName,
+		},
+		access: &accesssvc.Service{
+			Clock: clk,
+			DB:    db,
+			Granter: grantsvc.New(grantsvc.GranterOpts{
+				AHClient:         ahc,
+				DB:               db,
+				Clock:            clk,
+				EventBus:         eventBus,
+				DeploymentConfig: dc,
+				Requests:         requests,
+			}),
+			EventPutter: eventBus,
+			AHClient:    ahc,
+			Requests:    requests,
+		},
+		dc:          dc,
+		adminGroup:  cfg.AdminGroup,
+		frontendURL: cfg.FrontendURL,
 	}
 	return &s, nil
 }
 
 func (s *Server) Routes() http.Handler {
 	r := chi.NewRouter()
-	r.Post("/webhook/v1/slack/interactivity", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
+	r.Post("/webhook/v1/slack/interactivity", s.handleSlackInteractivity)
 
 	r.Post("/webhook/v1/access-token/verify", func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -172,10 +237,27 @@ func (s *Server) Routes() http.Handler {
 }
 
 type Lambda struct {
-	Server http.Handler
+	Server                  http.Handler
+	ProcessSlackInteraction func(ctx context.Context, e SlackInteractionEvent) error
 }
 
-func (h *Lambda) Handler(ctx context.Context, req events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+// Handler handles requests from API Gateway, as well as the asynchronous
+// invocations used to process Slack interactions after they have been acknowledged.
+func (h *Lambda) Handler(ctx context.Context, payload json.RawMessage) (interface{}, error) {
+	var interaction SlackInteractionEvent
+	err := json.Unmarshal(payload, &interaction)
+	if err != nil {
+		return nil, err
+	}
+	if interaction.SlackInteraction != nil {
+		return nil, h.ProcessSlackInteraction(ctx, interaction)
+	}
+
+	var req events.APIGatewayProxyRequest
+	err = json.Unmarshal(payload, &req)
+	if err != nil {
+		return nil, err
+	}
 	adapter := handlerfunc.New(h.Server.ServeHTTP)
 	return adapter.ProxyWithContext(ctx, req)
 }
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	slacknotifier "github.com/common-fate/granted-approvals/pkg/notifiers/slack"
+	"github.com/pkg/errors"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+// SlackInteractionEvent is the payload used to process a Slack interaction asynchronously,
+// after it has been acknowledged.
+type SlackInteractionEvent struct {
+	// SlackInteraction is the interaction payload sent by Slack.
+	SlackInteraction json.RawMessage `json:"slackInteraction"`
+}
+
+// InteractionQueue processes Slack interactions after they have been acknowledged.
+type InteractionQueue interface {
+	Enqueue(ctx context.Context, e SlackInteractionEvent) error
+}
+
+// LambdaInteractionQueue processes Slack interactions by asynchronously invoking the webhook Lambda function.
+type LambdaInteractionQueue struct {
+	Client       *lambda.Client
+	FunctionName string
+}
+
+func (q *LambdaInteractionQueue) Enqueue(ctx context.Context, e SlackInteractionEvent) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = q.Client.Invoke(ctx, &lambda.InvokeInput{
+		FunctionName:   &q.FunctionName,
+		InvocationType: types.InvocationTypeEvent,
+		Payload:        payload,
+	})
+	return err
+}
+
+// loadSlackNotifier loads the Slack notifier configuration, including the interactivity configuration.
+func (s *Server) loadSlackNotifier(ctx context.Context) (*slacknotifier.SlackNotifier, error) {
+	notifier := &slacknotifier.SlackNotifier{
+		DB:          s.db,
+		FrontendURL: s.frontendURL,
+	}
+	// don't cache notification config, matching the behaviour of the Slack notifier.
+	notificationsConfig, err := s.dc.ReadNotifications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slackCfg, ok := notificationsConfig[slacknotifier.NotificationsTypeSlack]
+	if !ok {
+		return nil, apio.NewRequestError(errors.New("slack is not configured"), http.StatusNotFound)
+	}
+	err = notifier.Config().Load(ctx, &gconfig.MapLoader{Values: slackCfg})
+	if err != nil {
+		return nil, err
+	}
+	err = notifier.InteractivityConfig().Load(ctx, &gconfig.MapLoader{Values: slackCfg})
+	if err != nil {
+		logger.Get(ctx).Infow("slack interactivity is not configured", zap.Error(err))
+		return nil, apio.NewRequestError(errors.New("slack interactivity is not configured"), http.StatusNotFound)
+	}
+	return notifier, nil
+}
+
+// handleSlackInteractivity handles reviewers pressing the review buttons in Slack messages.
+// Requests are verified using the signing secret of the Slack app.
+//
+// Slack requires interactions to be acknowledged within 3 seconds, so the interaction is
+// acknowledged straight away and the review is processed asynchronously by processSlackInteraction.
+func (s *Server) handleSlackInteractivity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.Get(ctx)
+
+	notifier, err := s.loadSlackNotifier(ctx)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	verifier, err := slack.NewSecretsVerifier(r.Header, notifier.SigningSecret())
+	if err == nil {
+		_, err = verifier.Write(body)
+	}
+	if err == nil {
+		err = verifier.Ensure()
+	}
+	if err != nil {
+		// log the error message and return an opaque response.
+		log.Infow("invalid slack request signature", zap.Error(err))
+		apio.ErrorString(ctx, w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	payload := []byte(form.Get("payload"))
+	var cb slack.InteractionCallback
+	err = json.Unmarshal(payload, &cb)
+	if err != nil {
+		apio.Error(ctx, w, apio.NewRequestError(errors.Wrap(err, "parsing interaction payload"), http.StatusBadRequest))
+		return
+	}
+
+	err = s.interactions.Enqueue(ctx, SlackInteractionEvent{SlackInteraction: payload})
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// processSlackInteraction reviews a request from an interaction which has already been verified and acknowledged.
+func (s *Server) processSlackInteraction(ctx context.Context, e SlackInteractionEvent) error {
+	log := zap.S()
+
+	var cb slack.InteractionCallback
+	err := json.Unmarshal(e.SlackInteraction, &cb)
+	if err != nil {
+		return errors.Wrap(err, "parsing interaction payload")
+	}
+	notifier, err := s.loadSlackNotifier(ctx)
+	if err != nil {
+		return err
+	}
+	err = notifier.Init(ctx)
+	if err != nil {
+		return err
+	}
+	return notifier.HandleInteraction(ctx, log, slacknotifier.HandleInteractionOpts{
+		Reviewer:   s.access,
+		AdminGroup: s.adminGroup,
+		Callback:   cb,
+	})
+}
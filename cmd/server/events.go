@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/config"
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/common-fate/granted-approvals/pkg/eventhandler"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	slacknotifier "github.com/common-fate/granted-approvals/pkg/notifiers/slack"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"go.uber.org/zap"
+)
+
+// subscribeEventHandlers subscribes the handlers which are usually invoked by EventBridge
+// in a Lambda deployment: the audit trail event handler and the Slack notifier.
+func subscribeEventHandlers(ctx context.Context, sub gevent.Subscriber, cfg config.Config, dc deploy.DeployConfigReader) error {
+	db, err := ddb.New(ctx, cfg.DynamoTable)
+	if err != nil {
+		return err
+	}
+	requests, err := storage.NewRequestWriter(ctx, cfg.DynamoTable)
+	if err != nil {
+		return err
+	}
+	eh, err := eventhandler.New(ctx, db, requests)
+	if err != nil {
+		return err
+	}
+	sub.Subscribe("grant.*", eh.HandleEvent)
+
+	notify := func(ctx context.Context, e gevent.Event) error {
+		notifier := &slacknotifier.SlackNotifier{
+			DB:          db,
+			FrontendURL: cfg.FrontendURL,
+		}
+		// notification config is re-read for each event, matching the behaviour of the Lambda notifier.
+		notificationsConfig, err := dc.ReadNotifications(ctx)
+		if err != nil {
+			return err
+		}
+		slackCfg, ok := notificationsConfig[slacknotifier.NotificationsTypeSlack]
+		if !ok {
+			zap.S().Debugw("notifications not configured, skipping handling event")
+			return nil
+		}
+		err = notifier.Config().Load(ctx, &gconfig.MapLoader{Values: slackCfg})
+		if err != nil {
+			return err
+		}
+		err = notifier.Init(ctx)
+		if err != nil {
+			return err
+		}
+		return notifier.HandleEvent(ctx, e)
+	}
+	sub.Subscribe("grant.*", notify)
+	sub.Subscribe("request.*", notify)
+	return nil
+}
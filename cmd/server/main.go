@@ -15,6 +15,8 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/deploy"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/identity/identitysync"
+	"github.com/common-fate/granted-approvals/pkg/oncall"
+	"github.com/common-fate/granted-approvals/pkg/ticket"
 
 	"github.com/common-fate/granted-approvals/pkg/config"
 	"github.com/common-fate/granted-approvals/pkg/server"
@@ -76,17 +78,28 @@ func run() error {
 		return err
 	}
 
-	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{
-		EventBusARN: cfg.EventBusArn,
-	})
+	dc, err := deploy.GetDeploymentConfig()
 	if err != nil {
 		return err
 	}
 
-	dc, err := deploy.GetDeploymentConfig()
+	eventBus, err := gevent.NewEventBus(ctx, gevent.BusOpts{
+		Type:          cfg.EventBusType,
+		EventBusARN:   cfg.EventBusArn,
+		WebhookURL:    cfg.EventBusWebhookURL,
+		WebhookSecret: cfg.EventBusWebhookSecret,
+	})
 	if err != nil {
 		return err
 	}
+	// if events are dispatched in-process, the event handlers need to run in the server
+	// rather than being invoked by EventBridge.
+	if sub, ok := eventBus.(gevent.Subscriber); ok {
+		err = subscribeEventHandlers(ctx, sub, cfg, dc)
+		if err != nil {
+			return err
+		}
+	}
 
 	td := psetup.TemplateData{
 		AccessHandlerExecutionRoleARN: cfg.AccessHandlerExecutionRoleARN,
@@ -108,6 +121,23 @@ func run() error {
 		return err
 	}
 
+	occ, err := deploy.UnmarshalFeatureMap(cfg.OnCallSettings)
+	if err != nil {
+		panic(err)
+	}
+	onCall, err := oncall.NewResolver(ctx, occ)
+	if err != nil {
+		return err
+	}
+	tc, err := deploy.UnmarshalFeatureMap(cfg.TicketSettings)
+	if err != nil {
+		panic(err)
+	}
+	tickets, err := ticket.NewVerifier(ctx, tc)
+	if err != nil {
+		return err
+	}
+
 	api, err := api.New(ctx, api.Opts{
 		Log:                 log,
 		DynamoTable:         cfg.DynamoTable,
@@ -121,6 +151,8 @@ func run() error {
 		IDPType:             cfg.IdpProvider,
 		AdminGroupID:        cfg.AdminGroup,
 		DeploymentConfig:    dc,
+		OnCall:              onCall,
+		Tickets:             tickets,
 		TemplateData:        td,
 	})
 	if err != nil {
@@ -21,6 +21,8 @@ const identityConfig = app.node.tryGetContext("identityConfiguration");
 const notificationsConfiguration = app.node.tryGetContext(
   "notificationsConfiguration"
 );
+const onCallConfiguration = app.node.tryGetContext("onCallConfiguration");
+const ticketConfiguration = app.node.tryGetContext("ticketConfiguration");
 const productionReleasesBucket = app.node.tryGetContext(
   "productionReleasesBucket"
 );
@@ -68,6 +70,8 @@ if (stackTarget === "dev") {
     samlMetadata: samlMetadata || "",
     notificationsConfiguration: notificationsConfiguration || "{}",
     identityProviderSyncConfiguration: identityConfig || "{}",
+    onCallConfiguration: onCallConfiguration || "{}",
+    ticketConfiguration: ticketConfiguration || "{}",
     remoteConfigUrl: remoteConfigUrl || "",
     remoteConfigHeaders: remoteConfigHeaders || "",
     apiGatewayWafAclArn: apiGatewayWafAclArn,
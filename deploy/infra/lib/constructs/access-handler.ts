@@ -67,6 +67,7 @@ export class AccessHandler extends Construct {
                 "states:DescribeExecution",
                 "states:GetExecutionHistory",
                 "states:StopExecution",
+                "states:ListExecutions",
               ],
               resources: ["*"],
             }),
@@ -16,6 +16,8 @@ import { Notifiers } from "./notifiers";
 import { AccessHandler } from "./access-handler";
 import { CfnWebACLAssociation } from "aws-cdk-lib/aws-wafv2";
 import { CacheSync } from "./cache-sync";
+import { Reconciler } from "./reconciler";
+import { Sweeper } from "./sweeper";
 
 interface Props {
   appName: string;
@@ -28,6 +30,8 @@ interface Props {
   providerConfig: string;
   notificationsConfiguration: string;
   identityProviderSyncConfiguration: string;
+  onCallConfiguration: string;
+  ticketConfiguration: string;
   deploymentSuffix: string;
   remoteConfigUrl: string;
   remoteConfigHeaders: string;
@@ -44,6 +48,8 @@ export class AppBackend extends Construct {
   private _eventHandler: EventHandler;
   private _idpSync: IdpSync;
   private _cacheSync: CacheSync;
+  private _reconciler: Reconciler;
+  private _sweeper: Sweeper;
   private _KMSkey: cdk.aws_kms.Key;
   private _webhook: apigateway.Resource;
   private _webhookLambda: lambda.Function;
@@ -72,11 +78,54 @@ export class AppBackend extends Construct {
       handler: "webhook",
       environment: {
         APPROVALS_TABLE_NAME: this._dynamoTable.tableName,
+        APPROVALS_FRONTEND_URL: props.frontendUrl,
+        APPROVALS_ADMIN_GROUP: props.adminGroupId,
+        ACCESS_HANDLER_URL: props.accessHandler.getApiUrl(),
+        EVENT_BUS_ARN: props.eventBus.eventBusArn,
+        NOTIFICATIONS_SETTINGS: props.notificationsConfiguration,
+        PROVIDER_CONFIG: props.providerConfig,
+        REMOTE_CONFIG_URL: props.remoteConfigUrl,
+        REMOTE_CONFIG_HEADERS: props.remoteConfigHeaders,
       },
     });
 
     this._dynamoTable.grantReadWriteData(this._webhookLambda);
 
+    // the webhook handles reviews from Slack, which grant access using the access handler.
+    this._webhookLambda.addToRolePolicy(
+      new PolicyStatement({
+        resources: [props.accessHandler.getApiGateway().arnForExecuteApi()],
+        actions: ["execute-api:Invoke"],
+      })
+    );
+    this._webhookLambda.addToRolePolicy(
+      new iam.PolicyStatement({
+        actions: ["ssm:GetParameter"],
+        resources: [
+          `arn:aws:ssm:${Stack.of(this).region}:${
+            Stack.of(this).account
+          }:parameter/granted/secrets/notifications/*`,
+        ],
+      })
+    );
+    props.eventBus.grantPutEventsTo(this._webhookLambda);
+
+    // Slack interactions are acknowledged straight away and then processed by asynchronously invoking the webhook.
+    // This is a separate policy rather than part of the role's default policy, which the function depends on.
+    const webhookSelfInvokePolicy = new iam.Policy(
+      this,
+      "WebhookSelfInvokePolicy",
+      {
+        statements: [
+          new iam.PolicyStatement({
+            actions: ["lambda:InvokeFunction"],
+            resources: [this._webhookLambda.functionArn],
+          }),
+        ],
+      }
+    );
+    this._webhookLambda.role?.attachInlinePolicy(webhookSelfInvokePolicy);
+
     this._apigateway = new apigateway.RestApi(this, "RestAPI", {
       restApiName: this._appName,
     });
@@ -114,6 +163,8 @@ export class AppBackend extends Construct {
         EVENT_BUS_ARN: props.eventBus.eventBusArn,
         EVENT_BUS_SOURCE: props.eventBusSourceName,
         IDENTITY_SETTINGS: props.identityProviderSyncConfiguration,
+        ONCALL_SETTINGS: props.onCallConfiguration,
+        TICKET_SETTINGS: props.ticketConfiguration,
         PAGINATION_KMS_KEY_ARN: this._KMSkey.keyArn,
         ACCESS_HANDLER_EXECUTION_ROLE_ARN: props.accessHandler.getAccessHandlerExecutionRoleArn(),
         DEPLOYMENT_SUFFIX: props.deploymentSuffix,
@@ -155,6 +206,28 @@ export class AppBackend extends Construct {
         ],
       })
     );
+    // the on-call source credentials are read when requests are made for rules with an on-call schedule.
+    this._lambda.addToRolePolicy(
+      new iam.PolicyStatement({
+        actions: ["ssm:GetParameter"],
+        resources: [
+          `arn:aws:ssm:${Stack.of(this).region}:${
+            Stack.of(this).account
+          }:parameter/granted/secrets/oncall/*`,
+        ],
+      })
+    );
+    // the ticketing system credentials are read when requests are made for rules which require a ticket.
+    this._lambda.addToRolePolicy(
+      new iam.PolicyStatement({
+        actions: ["ssm:GetParameter"],
+        resources: [
+          `arn:aws:ssm:${Stack.of(this).region}:${
+            Stack.of(this).account
+          }:parameter/granted/secrets/tickets/*`,
+        ],
+      })
+    );
 
     // allow the Approvals API to write SSM parameters as part of the guided setup workflow.
     this._lambda.addToRolePolicy(
@@ -291,6 +364,17 @@ export class AppBackend extends Construct {
       dynamoTable: this._dynamoTable,
       accessHandler: props.accessHandler,
     });
+    this._reconciler = new Reconciler(this, "Reconciler", {
+      dynamoTable: this._dynamoTable,
+      eventBus: props.eventBus,
+      providerConfig: props.providerConfig,
+      remoteConfigUrl: props.remoteConfigUrl,
+      remoteConfigHeaders: props.remoteConfigHeaders,
+    });
+    this._sweeper = new Sweeper(this, "Sweeper", {
+      dynamoTable: this._dynamoTable,
+      eventBus: props.eventBus,
+    });
   }
 
   /**
@@ -359,6 +443,12 @@ export class AppBackend extends Construct {
   getCacheSync(): CacheSync {
     return this._cacheSync;
   }
+  getReconciler(): Reconciler {
+    return this._reconciler;
+  }
+  getSweeper(): Sweeper {
+    return this._sweeper;
+  }
 
   getKmsKeyArn(): string {
     return this._KMSkey.keyArn;
@@ -47,12 +47,32 @@ export class Granter extends Construct {
             {
               Variable: "$.grant.end",
               TimestampGreaterThanPath: "$$.State.EnteredTime",
-              Next: "Wait for Grant Start Time",
+              Next: "Check if Access is Already Active",
             },
           ],
           Default: "Fail",
           Comment: "Do not provision any access if the end time is in the past",
         },
+        "Check if Access is Already Active": {
+          Type: "Choice",
+          Choices: [
+            {
+              And: [
+                {
+                  Variable: "$.skipActivation",
+                  IsPresent: true,
+                },
+                {
+                  Variable: "$.skipActivation",
+                  BooleanEquals: true,
+                },
+              ],
+              Next: "Wait for Window End",
+            },
+          ],
+          Default: "Wait for Grant Start Time",
+          Comment: "Extended grants which are already active only need to be expired at the new end time",
+        },
         "Wait for Grant Start Time": {
           Type: "Wait",
           TimestampPath: "$.grant.start",
@@ -0,0 +1,79 @@
+import { Duration, Stack } from "aws-cdk-lib";
+import { Table } from "aws-cdk-lib/aws-dynamodb";
+import * as events from "aws-cdk-lib/aws-events";
+import { EventBus } from "aws-cdk-lib/aws-events";
+import * as targets from "aws-cdk-lib/aws-events-targets";
+import { PolicyStatement } from "aws-cdk-lib/aws-iam";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import { Construct } from "constructs";
+import * as path from "path";
+
+interface Props {
+  dynamoTable: Table;
+  eventBus: EventBus;
+  providerConfig: string;
+  remoteConfigUrl: string;
+  remoteConfigHeaders: string;
+}
+
+// Reconciler periodically compares the status of grants with the state of the
+// Access Providers and emits grant.drifted events if they don't match.
+export class Reconciler extends Construct {
+  private _lambda: lambda.Function;
+  private eventRule: events.Rule;
+
+  constructor(scope: Construct, id: string, props: Props) {
+    super(scope, id);
+    const code = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "reconciler.zip")
+    );
+
+    this._lambda = new lambda.Function(this, "HandlerFunction", {
+      code,
+      timeout: Duration.minutes(5),
+      environment: {
+        APPROVALS_TABLE_NAME: props.dynamoTable.tableName,
+        EVENT_BUS_ARN: props.eventBus.eventBusArn,
+        PROVIDER_CONFIG: props.providerConfig,
+        REMOTE_CONFIG_URL: props.remoteConfigUrl,
+        REMOTE_CONFIG_HEADERS: props.remoteConfigHeaders,
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "reconciler",
+    });
+
+    props.dynamoTable.grantReadData(this._lambda);
+    props.eventBus.grantPutEventsTo(this._lambda);
+
+    // the reconciler calls the Access Providers directly, so it needs the same
+    // permissions as the access handler to read provider secrets and assume roles.
+    this._lambda.addToRolePolicy(
+      new PolicyStatement({
+        actions: ["ssm:GetParameter"],
+        resources: [
+          `arn:aws:ssm:${Stack.of(this).region}:${
+            Stack.of(this).account
+          }:parameter/granted/providers/*`,
+        ],
+      })
+    );
+    this._lambda.addToRolePolicy(
+      new PolicyStatement({
+        actions: ["sts:AssumeRole"],
+        resources: ["*"],
+      })
+    );
+
+    this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
+      schedule: events.Schedule.cron({ minute: "0/15" }),
+    });
+    this.eventRule.addTarget(new targets.LambdaFunction(this._lambda));
+    targets.addLambdaPermission(this.eventRule, this._lambda);
+  }
+  getLogGroupName(): string {
+    return this._lambda.logGroup.logGroupName;
+  }
+  getFunctionName(): string {
+    return this._lambda.functionName;
+  }
+}
@@ -0,0 +1,53 @@
+import { Duration } from "aws-cdk-lib";
+import { Table } from "aws-cdk-lib/aws-dynamodb";
+import * as events from "aws-cdk-lib/aws-events";
+import { EventBus } from "aws-cdk-lib/aws-events";
+import * as targets from "aws-cdk-lib/aws-events-targets";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import { Construct } from "constructs";
+import * as path from "path";
+
+interface Props {
+  dynamoTable: Table;
+  eventBus: EventBus;
+}
+
+// Sweeper periodically checks pending requests against the review SLA of their
+// Access Rule, reminding reviewers, escalating and expiring requests which aren't reviewed in time.
+export class Sweeper extends Construct {
+  private _lambda: lambda.Function;
+  private eventRule: events.Rule;
+
+  constructor(scope: Construct, id: string, props: Props) {
+    super(scope, id);
+    const code = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "sweeper.zip")
+    );
+
+    this._lambda = new lambda.Function(this, "HandlerFunction", {
+      code,
+      timeout: Duration.minutes(5),
+      environment: {
+        APPROVALS_TABLE_NAME: props.dynamoTable.tableName,
+        EVENT_BUS_ARN: props.eventBus.eventBusArn,
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "sweeper",
+    });
+
+    props.dynamoTable.grantReadWriteData(this._lambda);
+    props.eventBus.grantPutEventsTo(this._lambda);
+
+    this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
+      schedule: events.Schedule.cron({ minute: "0/5" }),
+    });
+    this.eventRule.addTarget(new targets.LambdaFunction(this._lambda));
+    targets.addLambdaPermission(this.eventRule, this._lambda);
+  }
+  getLogGroupName(): string {
+    return this._lambda.logGroup.logGroupName;
+  }
+  getFunctionName(): string {
+    return this._lambda.functionName;
+  }
+}
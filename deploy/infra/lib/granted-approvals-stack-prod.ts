@@ -103,6 +103,16 @@ export class CustomerGrantedStack extends cdk.Stack {
       description: "The Identity Provider Sync configuration in JSON format",
       default: "{}",
     });
+    const onCallConfiguration = new CfnParameter(this, "OnCallConfiguration", {
+      type: "String",
+      description: "The on-call schedule source configuration in JSON format",
+      default: "{}",
+    });
+    const ticketConfiguration = new CfnParameter(this, "TicketConfiguration", {
+      type: "String",
+      description: "The ticketing system configuration in JSON format",
+      default: "{}",
+    });
 
     const remoteConfigUrl = new CfnParameter(
       this,
@@ -190,6 +200,8 @@ export class CustomerGrantedStack extends cdk.Stack {
       adminGroupId: grantedAdminGroupId.valueAsString,
       identityProviderSyncConfiguration: identityConfig.valueAsString,
       notificationsConfiguration: notificationsConfiguration.valueAsString,
+      onCallConfiguration: onCallConfiguration.valueAsString,
+      ticketConfiguration: ticketConfiguration.valueAsString,
       providerConfig: providerConfig.valueAsString,
       deploymentSuffix: suffix.valueAsString,
       dynamoTable: db.getTable(),
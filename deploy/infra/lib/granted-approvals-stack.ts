@@ -23,6 +23,8 @@ interface Props extends cdk.StackProps {
   devConfig: DevEnvironmentConfig | null;
   notificationsConfiguration: string;
   identityProviderSyncConfiguration: string;
+  onCallConfiguration: string;
+  ticketConfiguration: string;
   adminGroupId: string;
   cloudfrontWafAclArn: string;
   apiGatewayWafAclArn: string;
@@ -41,6 +43,8 @@ export class DevGrantedStack extends cdk.Stack {
       adminGroupId,
       notificationsConfiguration,
       identityProviderSyncConfiguration,
+      onCallConfiguration,
+      ticketConfiguration,
       remoteConfigUrl,
       remoteConfigHeaders,
       cloudfrontWafAclArn,
@@ -93,6 +97,8 @@ export class DevGrantedStack extends cdk.Stack {
       providerConfig: props.providerConfig,
       identityProviderSyncConfiguration: identityProviderSyncConfiguration,
       notificationsConfiguration: notificationsConfiguration,
+      onCallConfiguration: onCallConfiguration,
+      ticketConfiguration: ticketConfiguration,
       deploymentSuffix: stage,
       dynamoTable: db.getTable(),
       remoteConfigUrl,
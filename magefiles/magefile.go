@@ -112,6 +112,22 @@ func (Build) CacheSyncer() error {
 	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/cache-sync", "cmd/lambda/cache-sync/handler.go")
 }
 
+func (Build) Reconciler() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/reconciler", "cmd/lambda/reconciler/handler.go")
+}
+
+func (Build) Sweeper() error {
+	env := map[string]string{
+		"GOOS":   "linux",
+		"GOARCH": "amd64",
+	}
+	return sh.RunWith(env, "go", "build", "-ldflags", ldFlags(), "-o", "bin/sweeper", "cmd/lambda/sweeper/handler.go")
+}
+
 func (Build) SlackNotifier() error {
 	env := map[string]string{
 		"GOOS":   "linux",
@@ -187,7 +203,7 @@ func PackageBackend() error {
 func Package() {
 	mg.Deps(PackageBackend, PackageGranter, PackageAccessHandler, PackageSlackNotifier)
 	mg.Deps(PackageEventHandler, PackageSyncer, PackageWebhook, PackageFrontendDeployer)
-	mg.Deps(PackageCacheSyncer)
+	mg.Deps(PackageCacheSyncer, PackageReconciler, PackageSweeper)
 }
 
 // PackageGranter zips the Go granter so that it can be deployed to Lambda.
@@ -220,6 +236,18 @@ func PackageCacheSyncer() error {
 	return sh.Run("zip", "--junk-paths", "bin/cache-sync.zip", "bin/cache-sync")
 }
 
+// PackageReconciler zips the Go grant reconciler so that it can be deployed to Lambda.
+func PackageReconciler() error {
+	mg.Deps(Build.Reconciler)
+	return sh.Run("zip", "--junk-paths", "bin/reconciler.zip", "bin/reconciler")
+}
+
+// PackageSweeper zips the Go pending request sweeper so that it can be deployed to Lambda.
+func PackageSweeper() error {
+	mg.Deps(Build.Sweeper)
+	return sh.Run("zip", "--junk-paths", "bin/sweeper.zip", "bin/sweeper")
+}
+
 // PackageNotifier zips the Go notifier so that it can be deployed to Lambda.
 func PackageSlackNotifier() error {
 	mg.Deps(Build.SlackNotifier)
@@ -299,6 +327,22 @@ func Dotenv() error {
 		}
 		providerConf = string(b)
 	}
+	onCallConf := "{}"
+	if cfg.Deployment.Parameters.OnCallConfiguration != nil {
+		b, err := json.Marshal(cfg.Deployment.Parameters.OnCallConfiguration)
+		if err != nil {
+			return err
+		}
+		onCallConf = string(b)
+	}
+	ticketConf := "{}"
+	if cfg.Deployment.Parameters.TicketConfiguration != nil {
+		b, err := json.Marshal(cfg.Deployment.Parameters.TicketConfiguration)
+		if err != nil {
+			return err
+		}
+		ticketConf = string(b)
+	}
 	idpType := identitysync.IDPTypeCognito
 	if cfg.Deployment.Parameters.IdentityProviderType != "" {
 		idpType = cfg.Deployment.Parameters.IdentityProviderType
@@ -311,6 +355,8 @@ func Dotenv() error {
 	myEnv["EVENT_BUS_SOURCE"] = o.EventBusSource
 	myEnv["IDENTITY_SETTINGS"] = idConf
 	myEnv["PROVIDER_CONFIG"] = providerConf
+	myEnv["ONCALL_SETTINGS"] = onCallConf
+	myEnv["TICKET_SETTINGS"] = ticketConf
 	myEnv["STATE_MACHINE_ARN"] = o.GranterStateMachineArn
 	myEnv["IDENTITY_PROVIDER"] = idpType
 	myEnv["APPROVALS_ADMIN_GROUP"] = cfg.Deployment.Parameters.AdministratorGroupID
@@ -0,0 +1,52 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// Delegation allows a user to review requests on behalf of an approver for a period of time,
+// for example while the approver is out of office.
+//
+// Delegates are added as Reviewers on requests which the approver is a Reviewer of while the delegation is active.
+// Delegations aren't transitive, so the delegates of a delegate don't review requests on behalf of the approver.
+type Delegation struct {
+	ID string `json:"id" dynamodbav:"id"`
+	// DelegatorID is the approver whose reviews are delegated.
+	DelegatorID string `json:"delegatorId" dynamodbav:"delegatorId"`
+	// DelegateID is the user who reviews requests on behalf of the approver.
+	DelegateID string    `json:"delegateId" dynamodbav:"delegateId"`
+	StartTime  time.Time `json:"startTime" dynamodbav:"startTime"`
+	EndTime    time.Time `json:"endTime" dynamodbav:"endTime"`
+	CreatedAt  time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+// IsActive returns true if the delegation applies at the given time.
+func (d Delegation) IsActive(now time.Time) bool {
+	return !now.Before(d.StartTime) && now.Before(d.EndTime)
+}
+
+// DDBKeys provides the keys for storing the object in DynamoDB
+func (d *Delegation) DDBKeys() (ddb.Keys, error) {
+	k := ddb.Keys{
+		PK:     keys.Delegation.PK1,
+		SK:     keys.Delegation.SK1(d.DelegatorID, d.ID),
+		GSI1PK: keys.Delegation.GSI1PK(d.DelegateID),
+		GSI1SK: keys.Delegation.GSI1SK(d.ID),
+	}
+	return k, nil
+}
+
+func (d Delegation) ToAPI() types.Delegation {
+	return types.Delegation{
+		Id:          d.ID,
+		DelegatorId: d.DelegatorID,
+		DelegateId:  d.DelegateID,
+		StartTime:   d.StartTime,
+		EndTime:     d.EndTime,
+		CreatedAt:   d.CreatedAt,
+	}
+}
@@ -1,6 +1,7 @@
 package access
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/common-fate/ddb"
@@ -20,6 +21,8 @@ const (
 	DECLINED  Status = "DECLINED"
 	CANCELLED Status = "CANCELLED"
 	PENDING   Status = "PENDING"
+	// EXPIRED requests were closed because they weren't reviewed within the review SLA of the access rule.
+	EXPIRED Status = "EXPIRED"
 )
 
 type Grant struct {
@@ -89,10 +92,117 @@ type Request struct {
 	Grant *Grant `json:"grant,omitempty" dynamodbav:"grant,omitempty"`
 	// ApprovalMethod explains whether an approval was AUTOMATIC, or REVIEWED
 	ApprovalMethod *types.ApprovalMethod `json:"approvalMethod,omitempty" dynamodbav:"approvalMethod,omitempty"`
+	// ApprovalStage is the index of the approval stage which the request is waiting on.
+	// Access rules without multi-stage approvals only have a single stage.
+	ApprovalStage int `json:"approvalStage" dynamodbav:"approvalStage"`
+	// ApprovalProgress is the progress of the stage which the request is waiting on,
+	// for stages which require more than one approval.
+	ApprovalProgress *ApprovalProgress `json:"approvalProgress,omitempty" dynamodbav:"approvalProgress,omitempty"`
+	// ExtensionOf is the ID of the request whose grant this request extends.
+	// Extension requests go through the same approval workflow as other requests, but when they
+	// are approved the existing grant is extended by the requested duration rather than creating a new grant.
+	ExtensionOf *string `json:"extensionOf,omitempty" dynamodbav:"extensionOf,omitempty"`
+	// SubmittedBy is the ID of the user who made the request, if they made it on behalf of RequestedBy.
+	// RequestedBy is the subject of the grant.
+	SubmittedBy *string `json:"submittedBy,omitempty" dynamodbav:"submittedBy,omitempty"`
+	// Recurrence is set if the request repeats. Recurring requests are reviewed once, and when they are approved
+	// a request with its own grant is created for each occurrence.
+	Recurrence *Recurrence `json:"recurrence,omitempty" dynamodbav:"recurrence,omitempty"`
+	// RecurrenceOf is the ID of the recurring request which this request is an occurrence of.
+	RecurrenceOf *string `json:"recurrenceOf,omitempty" dynamodbav:"recurrenceOf,omitempty"`
+	// BreakGlass is set if the request bypassed approval using break-glass access.
+	BreakGlass *BreakGlass `json:"breakGlass,omitempty" dynamodbav:"breakGlass,omitempty"`
+	// ReminderSentAt is set when reviewers are reminded about the request because it hasn't been reviewed in time.
+	ReminderSentAt *time.Time `json:"reminderSentAt,omitempty" dynamodbav:"reminderSentAt,omitempty"`
+	// EscalatedAt is set when the escalation approvers of the access rule are added as reviewers.
+	EscalatedAt *time.Time `json:"escalatedAt,omitempty" dynamodbav:"escalatedAt,omitempty"`
+	// Version is incremented each time a review updates the request, so that concurrent reviews can be detected.
+	Version int `json:"version,omitempty" dynamodbav:"version,omitempty"`
 	// CreatedAt is a read-only field after the request has been created.
 	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
 }
+
+// BreakGlass holds the details of a request which bypassed approval during an incident.
+type BreakGlass struct {
+	Justification string `json:"justification" dynamodbav:"justification"`
+	// AcknowledgedAt is when the user acknowledged that the request will be reviewed after the fact.
+	AcknowledgedAt time.Time `json:"acknowledgedAt" dynamodbav:"acknowledgedAt"`
+	// ReviewRequired is true until an approver has reviewed the request.
+	ReviewRequired bool `json:"reviewRequired" dynamodbav:"reviewRequired"`
+}
+
+func (b BreakGlass) ToAPI() types.RequestBreakGlass {
+	return types.RequestBreakGlass{
+		Justification:  b.Justification,
+		ReviewRequired: b.ReviewRequired,
+	}
+}
+
+// IsRequestor returns true if the user requested the access, or submitted the request on behalf of the user who did.
+// Requestors can't review the request.
+func (r *Request) IsRequestor(userID string) bool {
+	return userID == r.RequestedBy || (r.SubmittedBy != nil && userID == *r.SubmittedBy)
+}
+
+// IsBreakGlassReviewPending returns true if the request used break-glass access
+// and is still waiting on an after-the-fact review by an approver.
+func (r *Request) IsBreakGlassReviewPending() bool {
+	return r.BreakGlass != nil && r.BreakGlass.ReviewRequired
+}
+
+// Recurrence holds the occurrences of a recurring request.
+type Recurrence struct {
+	// Rule is the RRULE which the request repeats on.
+	Rule string `json:"rule" dynamodbav:"rule"`
+	// Occurrences are the start times of each occurrence, up to the recurrence horizon of the access rule.
+	Occurrences []time.Time `json:"occurrences" dynamodbav:"occurrences"`
+	// OccurrenceRequestIDs are the IDs of the requests created for each occurrence when the request is approved.
+	OccurrenceRequestIDs []string `json:"occurrenceRequestIds,omitempty" dynamodbav:"occurrenceRequestIds,omitempty"`
+}
+
+// Timings returns the timing of each occurrence.
+func (r Recurrence) Timings(duration time.Duration) []Timing {
+	timings := make([]Timing, len(r.Occurrences))
+	for i := range r.Occurrences {
+		timings[i] = Timing{Duration: duration, StartTime: &r.Occurrences[i]}
+	}
+	return timings
+}
+
+func (r *Recurrence) ToAPI() *types.RequestRecurrence {
+	if r == nil {
+		return nil
+	}
+	res := types.RequestRecurrence{
+		Rule:        r.Rule,
+		Occurrences: r.Occurrences,
+	}
+	if len(r.OccurrenceRequestIDs) > 0 {
+		res.OccurrenceRequestIds = &r.OccurrenceRequestIDs
+	}
+	return &res
+}
+
+// ApprovalProgress tracks the number of approvals received for an approval stage
+// which requires more than one approval.
+type ApprovalProgress struct {
+	Approvals int `json:"approvals" dynamodbav:"approvals"`
+	Required  int `json:"required" dynamodbav:"required"`
+}
+
+// String returns a human-readable summary of the progress, such as "1 of 2 approvals".
+func (p ApprovalProgress) String() string {
+	return fmt.Sprintf("%d of %d approvals", p.Approvals, p.Required)
+}
+
+func (p ApprovalProgress) ToAPI() types.ApprovalProgress {
+	return types.ApprovalProgress{
+		Approvals: p.Approvals,
+		Required:  p.Required,
+	}
+}
+
 type GetIntervalOpts struct {
 	Now time.Time
 }
@@ -110,6 +220,22 @@ func (r *Request) GetInterval(opts ...func(o *GetIntervalOpts)) (start time.Time
 	return r.RequestedTiming.GetInterval(opts...)
 }
 
+// GetTiming returns the override timing if it is present, otherwise the requested timing.
+func (r *Request) GetTiming() Timing {
+	if r.OverrideTiming != nil {
+		return *r.OverrideTiming
+	}
+	return r.RequestedTiming
+}
+
+// Timings returns the timing of each occurrence if the request is recurring, otherwise the timing of the request.
+func (r *Request) Timings() []Timing {
+	if r.Recurrence != nil {
+		return r.Recurrence.Timings(r.GetTiming().Duration)
+	}
+	return []Timing{r.GetTiming()}
+}
+
 // IsScheduled will return true if this request is scheduled, first checking for override timing, then for original timing
 func (r *Request) IsScheduled() bool {
 	if r.OverrideTiming != nil {
@@ -124,17 +250,31 @@ func (r *Request) ToAPI() types.Request {
 		AccessRuleVersion: r.RuleVersion,
 		Timing:            r.RequestedTiming.ToAPI(),
 		Reason:            r.Data.Reason,
+		FormData:          r.Data.FormDataToAPI(),
+		Ticket:            r.Data.Ticket.ToAPI(),
 		ID:                r.ID,
 		RequestedAt:       r.CreatedAt,
 		Requestor:         r.RequestedBy,
 		Status:            types.RequestStatus(r.Status),
 		UpdatedAt:         r.UpdatedAt,
 		ApprovalMethod:    r.ApprovalMethod,
+		ExtensionOf:       r.ExtensionOf,
+		SubmittedBy:       r.SubmittedBy,
+		Recurrence:        r.Recurrence.ToAPI(),
+		RecurrenceOf:      r.RecurrenceOf,
 	}
 	if r.Grant != nil {
 		g := r.Grant.ToAPI()
 		req.Grant = &g
 	}
+	if r.ApprovalProgress != nil {
+		p := r.ApprovalProgress.ToAPI()
+		req.ApprovalProgress = &p
+	}
+	if r.BreakGlass != nil {
+		bg := r.BreakGlass.ToAPI()
+		req.BreakGlass = &bg
+	}
 
 	// show the updated timing rather than the requested timing if it's been overridden by an approver.
 	if r.OverrideTiming != nil {
@@ -149,6 +289,8 @@ func (r *Request) ToAPIDetail(accessRule rule.AccessRule, canReview bool, reques
 		AccessRule:     accessRule.ToAPI(),
 		Timing:         r.RequestedTiming.ToAPI(),
 		Reason:         r.Data.Reason,
+		FormData:       r.Data.FormDataToAPI(),
+		Ticket:         r.Data.Ticket.ToAPI(),
 		ID:             r.ID,
 		RequestedAt:    r.CreatedAt,
 		Requestor:      r.RequestedBy,
@@ -156,6 +298,10 @@ func (r *Request) ToAPIDetail(accessRule rule.AccessRule, canReview bool, reques
 		UpdatedAt:      r.UpdatedAt,
 		CanReview:      canReview,
 		ApprovalMethod: r.ApprovalMethod,
+		ExtensionOf:    r.ExtensionOf,
+		SubmittedBy:    r.SubmittedBy,
+		Recurrence:     r.Recurrence.ToAPI(),
+		RecurrenceOf:   r.RecurrenceOf,
 		Arguments: types.RequestDetail_Arguments{
 			AdditionalProperties: make(map[string]types.With),
 		},
@@ -196,6 +342,14 @@ func (r *Request) ToAPIDetail(accessRule rule.AccessRule, canReview bool, reques
 		g := r.Grant.ToAPI()
 		req.Grant = &g
 	}
+	if r.ApprovalProgress != nil {
+		p := r.ApprovalProgress.ToAPI()
+		req.ApprovalProgress = &p
+	}
+	if r.BreakGlass != nil {
+		bg := r.BreakGlass.ToAPI()
+		req.BreakGlass = &bg
+	}
 	// show the updated timing rather than the requested timing if it's been overridden by an approver.
 	if r.OverrideTiming != nil {
 		req.Timing = r.OverrideTiming.ToAPI()
@@ -211,14 +365,20 @@ func (r *Request) DDBKeys() (ddb.Keys, error) {
 	// - Declined and Cancelled requests should have an end time = createdAt so they get a somewhat natural order in the results
 	// - REVOKED grants should have end time = created at
 	// - ERROR grants should have end times = created at
+	// - Approved recurring requests should have end time = created at, as each occurrence has its own request which is listed instead
 	end := r.CreatedAt
-	if r.Status == APPROVED || r.Status == PENDING {
+	isApprovedRecurrence := r.Recurrence != nil && len(r.Recurrence.OccurrenceRequestIDs) > 0
+	if (r.Status == APPROVED || r.Status == PENDING) && !isApprovedRecurrence {
 		if r.Grant != nil {
 			//any grant status other than revoked or error should be equal to grant.end.
 			//this is to make sure the error and revoke grants are pushed to the past column in the frontend
 			if !(r.Grant.Status == ac_types.GrantStatusREVOKED || r.Grant.Status == ac_types.GrantStatusERROR) {
 				end = r.Grant.End
 			}
+		} else if r.Recurrence != nil && len(r.Recurrence.Occurrences) > 0 {
+			// recurring requests remain upcoming until their last occurrence ends.
+			last := r.Recurrence.Occurrences[len(r.Recurrence.Occurrences)-1]
+			end = last.Add(r.GetTiming().Duration)
 		} else if r.IsScheduled() {
 			_, end = r.GetInterval()
 		} else {
@@ -297,4 +457,51 @@ func (t *Timing) GetInterval(opts ...func(o *GetIntervalOpts)) (start time.Time,
 // through filling in form fields in the web application.
 type RequestData struct {
 	Reason *string `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	// FormData are the values of the form fields of the Access Rule.
+	FormData []FormFieldValue `json:"formData,omitempty" dynamodbav:"formData,omitempty"`
+	// Ticket is the ticket linked to the request, if the Access Rule requires one.
+	Ticket *Ticket `json:"ticket,omitempty" dynamodbav:"ticket,omitempty"`
+}
+
+// Ticket is a ticket in a ticketing system which justifies a request.
+// The details of the ticket are stored as they were when the ticket was verified.
+type Ticket struct {
+	System  string `json:"system" dynamodbav:"system"`
+	ID      string `json:"id" dynamodbav:"id"`
+	URL     string `json:"url" dynamodbav:"url"`
+	Summary string `json:"summary" dynamodbav:"summary"`
+	Status  string `json:"status" dynamodbav:"status"`
+}
+
+func (t *Ticket) ToAPI() *types.RequestTicket {
+	if t == nil {
+		return nil
+	}
+	return &types.RequestTicket{
+		System:  types.TicketSystem(t.System),
+		Id:      t.ID,
+		Url:     t.URL,
+		Summary: t.Summary,
+		Status:  t.Status,
+	}
+}
+
+// FormFieldValue is the value of a form field of an Access Rule.
+// The label of the field is stored alongside the value, so that the request
+// still displays correctly if the field is changed on the Access Rule later.
+type FormFieldValue struct {
+	ID    string `json:"id" dynamodbav:"id"`
+	Label string `json:"label" dynamodbav:"label"`
+	Value string `json:"value" dynamodbav:"value"`
+}
+
+func (d RequestData) FormDataToAPI() *[]types.FormFieldValue {
+	if len(d.FormData) == 0 {
+		return nil
+	}
+	res := make([]types.FormFieldValue, len(d.FormData))
+	for i, v := range d.FormData {
+		res[i] = types.FormFieldValue{Id: v.ID, Label: v.Label, Value: v.Value}
+	}
+	return &res
 }
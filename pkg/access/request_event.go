@@ -26,6 +26,8 @@ type RequestEvent struct {
 	GrantFailureReason *string               `json:"grantFailureReason,omitempty" dynamodbav:"grantFailureReason,omitempty"`
 	RequestCreated     *bool                 `json:"requestCreated,omitempty" dynamodbav:"requestCreated,omitempty"`
 	RecordedEvent      *map[string]string    `json:"recordedEvent,omitempty" dynamodbav:"recordedEvent,omitempty"`
+	FromApprovalStage  *int                  `json:"fromApprovalStage,omitempty" dynamodbav:"fromApprovalStage,omitempty"`
+	ToApprovalStage    *int                  `json:"toApprovalStage,omitempty" dynamodbav:"toApprovalStage,omitempty"`
 }
 
 func NewRequestCreatedEvent(requestID string, createdAt time.Time, actor *string) RequestEvent {
@@ -50,6 +52,10 @@ func NewStatusChangeEvent(requestID string, createdAt time.Time, actor *string,
 	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, FromStatus: &from, ToStatus: &to}
 }
 
+func NewApprovalStageChangeEvent(requestID string, createdAt time.Time, actor *string, from, to int) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, FromApprovalStage: &from, ToApprovalStage: &to}
+}
+
 func NewTimingChangeEvent(requestID string, createdAt time.Time, actor *string, from, to Timing) RequestEvent {
 	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, FromTiming: &from, ToTiming: &to}
 }
@@ -84,6 +90,8 @@ func (r *RequestEvent) ToAPI() types.RequestEvent {
 		RequestCreated:     r.RequestCreated,
 		GrantFailureReason: r.GrantFailureReason,
 		RecordedEvent:      r.RecordedEvent,
+		FromApprovalStage:  r.FromApprovalStage,
+		ToApprovalStage:    r.ToApprovalStage,
 	}
 }
 
@@ -17,6 +17,7 @@ func TestRequestMarshalDDB(t *testing.T) {
 	}
 
 	reason := "test reason"
+	occurrences := []time.Time{time.Date(2022, 1, 4, 2, 0, 0, 0, time.UTC), time.Date(2022, 1, 11, 2, 0, 0, 0, time.UTC)}
 
 	testcases := []testcase{
 		{
@@ -107,6 +108,49 @@ func TestRequestMarshalDDB(t *testing.T) {
 			},
 			want: `{"PK":"ACCESS_REQUEST#","SK":"req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J","GSI1PK":"ACCESS_REQUEST#user","GSI1SK":"req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J","GSI2PK":"ACCESS_REQUEST#APPROVED","GSI2SK":"user#req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J","GSI3PK":"ACCESS_REQUEST#user","GSI3SK":"2022-01-01T10:00:00Z","GSI4PK":"ACCESS_REQUEST#user#rul_123","GSI4SK":"2022-01-01T10:00:00Z"}`,
 		},
+		{
+			name: "recurring request ends with its last occurrence",
+			give: Request{
+				ID:          "req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J",
+				RequestedBy: "user",
+				Rule:        "rul_123",
+				RuleVersion: "2022-01-01T10:00:00Z",
+				Status:      APPROVED,
+				RequestedTiming: Timing{
+					Duration:  time.Hour * 2,
+					StartTime: &occurrences[0],
+				},
+				Recurrence: &Recurrence{
+					Rule:        "FREQ=WEEKLY;COUNT=2",
+					Occurrences: occurrences,
+				},
+				CreatedAt: time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+			},
+			want: `{"PK":"ACCESS_REQUEST#","SK":"req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J","GSI1PK":"ACCESS_REQUEST#user","GSI1SK":"req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J","GSI2PK":"ACCESS_REQUEST#APPROVED","GSI2SK":"user#req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J","GSI3PK":"ACCESS_REQUEST#user","GSI3SK":"2022-01-11T04:00:00Z","GSI4PK":"ACCESS_REQUEST#user#rul_123","GSI4SK":"2022-01-11T04:00:00Z"}`,
+		},
+		{
+			name: "recurring request with occurrences created ends when it is created",
+			give: Request{
+				ID:          "req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J",
+				RequestedBy: "user",
+				Rule:        "rul_123",
+				RuleVersion: "2022-01-01T10:00:00Z",
+				Status:      APPROVED,
+				RequestedTiming: Timing{
+					Duration:  time.Hour * 2,
+					StartTime: &occurrences[0],
+				},
+				Recurrence: &Recurrence{
+					Rule:                 "FREQ=WEEKLY;COUNT=2",
+					Occurrences:          occurrences,
+					OccurrenceRequestIDs: []string{"req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J-0", "req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J-1"},
+				},
+				CreatedAt: time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+			},
+			want: `{"PK":"ACCESS_REQUEST#","SK":"req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J","GSI1PK":"ACCESS_REQUEST#user","GSI1SK":"req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J","GSI2PK":"ACCESS_REQUEST#APPROVED","GSI2SK":"user#req_28w2Eebt2Q8nFQJ2dKa1FTE9X0J","GSI3PK":"ACCESS_REQUEST#user","GSI3SK":"2022-01-01T10:00:00Z","GSI4PK":"ACCESS_REQUEST#user#rul_123","GSI4SK":"2022-01-01T10:00:00Z"}`,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -14,10 +14,8 @@ const (
 )
 
 // Review is a review of a Request.
-// When Requests are created, Reviews are created for all approvers
-// who need to review the request.
-// When an approver completes the review the status of the Review is
-// updated to be COMPLETE.
+// A Review is created each time an approver reviews the request. Requests which
+// require multiple approvals remain PENDING until enough approving Reviews have been made.
 type Review struct {
 	ID              string   `json:"id" dynamodbav:"id"`
 	RequestID       string   `json:"requestId" dynamodbav:"requestId"`
@@ -25,12 +23,75 @@ type Review struct {
 	Decision        Decision `json:"decision" dynamodbav:"decision"`
 	Comment         *string  `json:"comment,omitempty" dynamodbav:"comment,omitempty"`
 	OverrideTimings *Timing  `json:"overrideTimings,omitempty" dynamodbav:"overrideTimings,omitempty"`
+	// ApprovalStage is the index of the approval stage which the request was waiting on when it was reviewed.
+	ApprovalStage int `json:"approvalStage" dynamodbav:"approvalStage"`
+	// OnBehalfOf are the approvers who delegated their review to the reviewer.
+	OnBehalfOf []string `json:"onBehalfOf,omitempty" dynamodbav:"onBehalfOf,omitempty"`
+}
+
+// Approvers returns the approvers who the review represents: the approvers who
+// delegated their review to the reviewer, or otherwise the reviewer themselves.
+func (r *Review) Approvers() []string {
+	if len(r.OnBehalfOf) > 0 {
+		return r.OnBehalfOf
+	}
+	return []string{r.ReviewerID}
 }
 
 func (r *Review) DDBKeys() (ddb.Keys, error) {
 	k := ddb.Keys{
-		PK: keys.AccessReview.PK1(r.ReviewerID),
-		SK: keys.AccessReview.SK1(r.RequestID, r.ID),
+		PK:     keys.AccessReview.PK1(r.ReviewerID),
+		SK:     keys.AccessReview.SK1(r.RequestID, r.ID),
+		GSI1PK: keys.AccessReview.GSI1PK,
+		GSI1SK: keys.AccessReview.GSI1SK(r.RequestID, r.ID),
 	}
 	return k, nil
 }
+
+// Reviews is a list of the reviews made on a Request.
+type Reviews []Review
+
+// Count returns the number of reviews with the decision for an approval stage.
+// Each approver is only counted once: a review is only counted if it represents an approver
+// who isn't represented by an earlier review, so that several delegates of the same approver,
+// or an approver and their delegate, only count as a single review.
+func (r Reviews) Count(stage int, decision Decision) int {
+	counted := make(map[string]bool)
+	count := 0
+	for _, rv := range r {
+		if rv.ApprovalStage != stage || rv.Decision != decision {
+			continue
+		}
+		isNew := false
+		for _, a := range rv.Approvers() {
+			if !counted[a] {
+				counted[a] = true
+				isNew = true
+			}
+		}
+		if isNew {
+			count++
+		}
+	}
+	return count
+}
+
+// HasApproved returns true if the reviewer has approved any stage of the request.
+func (r Reviews) HasApproved(reviewerID string) bool {
+	for _, rv := range r {
+		if rv.ReviewerID == reviewerID && rv.Decision == DecisionApproved {
+			return true
+		}
+	}
+	return false
+}
+
+// HasReviewedStage returns true if the reviewer has reviewed the approval stage.
+func (r Reviews) HasReviewedStage(reviewerID string, stage int) bool {
+	for _, rv := range r {
+		if rv.ReviewerID == reviewerID && rv.ApprovalStage == stage {
+			return true
+		}
+	}
+	return false
+}
@@ -13,6 +13,12 @@ type Reviewer struct {
 	// Request is the associated request.
 	Request       Request       `json:"request" dynamodbav:"request"`
 	Notifications Notifications `json:"notifications" dynamodbav:"notifications"`
+	// ApprovalStage is the index of the approval stage that the reviewer was added for.
+	// Reviewers can only review the request while it is waiting on their stage.
+	ApprovalStage int `json:"approvalStage" dynamodbav:"approvalStage"`
+	// DelegatedBy are the approvers who the reviewer is reviewing the request on behalf of.
+	// It is empty if the reviewer is an approver for the request themselves.
+	DelegatedBy []string `json:"delegatedBy,omitempty" dynamodbav:"delegatedBy,omitempty"`
 }
 
 type Notifications struct {
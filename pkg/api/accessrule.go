@@ -7,7 +7,10 @@ import (
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/auth"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/policy"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
 	"github.com/common-fate/granted-approvals/pkg/storage"
@@ -301,3 +304,49 @@ func (a *API) UserGetAccessRuleApprovers(w http.ResponseWriter, r *http.Request,
 	apio.JSON(ctx, w, types.ListAccessRuleApproversResponse{Users: users}, http.StatusOK)
 
 }
+
+// Evaluates an access rule policy against a sample request
+// (POST /api/v1/admin/access-rules/test-policy)
+func (a *API) AdminTestAccessRulePolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.TestAccessRulePolicyRequest
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	q := storage.GetUser{ID: b.UserId}
+	_, err = a.DB.Query(ctx, &q)
+	if errors.As(err, &identity.UserNotFoundError{}) {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	in := policy.Input{
+		User:   *q.Result,
+		Timing: access.TimingFromRequestTiming(b.Timing),
+		Now:    a.Clock.Now(),
+	}
+	if b.With != nil {
+		in.With = b.With.AdditionalProperties
+	}
+	if b.History != nil {
+		in.History = policy.History{
+			Requests:  b.History.Requests,
+			Approved:  b.History.Approved,
+			Declined:  b.History.Declined,
+			Cancelled: b.History.Cancelled,
+		}
+	}
+
+	decision, err := policy.Evaluate(b.Policy, in)
+	if err != nil {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	apio.JSON(ctx, w, types.TestAccessRulePolicyResponse{Decision: types.PolicyDecision(decision)}, http.StatusOK)
+}
@@ -692,3 +692,61 @@ func TestLookupAccessRules(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminTestAccessRulePolicy(t *testing.T) {
+	type testcase struct {
+		name     string
+		give     string
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "approve",
+			give:     `{"policy":"\"developers\" in user.groups ? \"approve\" : \"review\"","userId":"usr_1","timing":{"durationSeconds":3600}}`,
+			wantCode: http.StatusOK,
+			wantBody: `{"decision":"approve"}`,
+		},
+		{
+			name:     "history",
+			give:     `{"policy":"history.declined > 0 ? \"deny\" : \"review\"","userId":"usr_1","timing":{"durationSeconds":3600},"history":{"requests":1,"approved":0,"declined":1,"cancelled":0}}`,
+			wantCode: http.StatusOK,
+			wantBody: `{"decision":"deny"}`,
+		},
+		{
+			name:     "invalid decision",
+			give:     `{"policy":"\"maybe\"","userId":"usr_1","timing":{"durationSeconds":3600}}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"invalid policy: policy returned \"maybe\", expected one of \"approve\", \"review\" or \"deny\""}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetUser{Result: &identity.User{ID: "usr_1", Groups: []string{"developers"}}})
+
+			a := API{DB: db}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/access-rules/test-policy", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
 	"github.com/common-fate/granted-approvals/pkg/service/psetupsvc"
 	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
 
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/types"
@@ -66,6 +67,7 @@ type API struct {
 	IdentitySyncer      auth.IdentitySyncer
 	// Set this to nil if cognito is not configured as the IDP for the deployment
 	Cognito CognitoService
+	Clock   clock.Clock
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_cognito_service.go -package=mocks . CognitoService
@@ -90,6 +92,7 @@ type AccessService interface {
 	CreateRequest(ctx context.Context, user *identity.User, in types.CreateRequestRequest) (*accesssvc.CreateRequestResult, error)
 	AddReviewAndGrantAccess(ctx context.Context, opts accesssvc.AddReviewOpts) (*accesssvc.AddReviewResult, error)
 	CancelRequest(ctx context.Context, opts accesssvc.CancelRequestOpts) error
+	ExtendRequest(ctx context.Context, user *identity.User, requestID string, in types.ExtendRequestRequest) (*accesssvc.CreateRequestResult, error)
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_accessrule_service.go -package=mocks . AccessRuleService
@@ -114,7 +117,7 @@ var _ types.ServerInterface = &API{}
 type Opts struct {
 	Log                 *zap.SugaredLogger
 	AccessHandlerClient ahtypes.ClientWithResponsesInterface
-	EventSender         *gevent.Sender
+	EventSender         gevent.EventBus
 	IdentitySyncer      auth.IdentitySyncer
 	DeploymentConfig    deploy.DeployConfigReader
 	DynamoTable         string
@@ -125,6 +128,10 @@ type Opts struct {
 	CognitoUserPoolID   string
 	IDPType             string
 	AdminGroupID        string
+	// OnCall looks up on-call users for access rules with an on-call schedule. It is optional.
+	OnCall accesssvc.OnCallResolver
+	// Tickets verifies the tickets linked to requests for access rules which require a ticket. It is optional.
+	Tickets accesssvc.TicketVerifier
 }
 
 // New creates a new API.
@@ -145,6 +152,11 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 		return nil, err
 	}
 
+	requests, err := storage.NewRequestWriter(ctx, opts.DynamoTable)
+	if err != nil {
+		return nil, err
+	}
+
 	clk := clock.New()
 
 	granter := grantsvc.New(grantsvc.GranterOpts{
@@ -153,6 +165,7 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 		Clock:            clk,
 		EventBus:         opts.EventSender,
 		DeploymentConfig: opts.DeploymentConfig,
+		Requests:         requests,
 	})
 
 	a := API{
@@ -171,12 +184,17 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 				Clock:    clk,
 				DB:       db,
 				AHClient: opts.AccessHandlerClient,
+				Requests: requests,
 				Cache: &cachesvc.Service{
 					DB:                  db,
 					AccessHandlerClient: opts.AccessHandlerClient,
 				},
 			},
-			AHClient: opts.AccessHandlerClient,
+			AHClient:   opts.AccessHandlerClient,
+			OnCall:     opts.OnCall,
+			Tickets:    opts.Tickets,
+			Requests:   requests,
+			AdminGroup: opts.AdminGroup,
 		},
 		Cache: &cachesvc.Service{
 			DB:                  db,
@@ -186,6 +204,7 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 			Clock:    clk,
 			DB:       db,
 			AHClient: opts.AccessHandlerClient,
+			Requests: requests,
 			Cache: &cachesvc.Service{
 				DB:                  db,
 				AccessHandlerClient: opts.AccessHandlerClient,
@@ -201,6 +220,7 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 		Granter:             granter,
 		IdentitySyncer:      opts.IdentitySyncer,
 		IdentityProvider:    opts.IDPType,
+		Clock:               clk,
 	}
 
 	// only initialise this if cognito is the IDP
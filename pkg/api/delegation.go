@@ -0,0 +1,137 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/auth"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// List delegations
+// (GET /api/v1/delegations)
+func (a *API) ListDelegations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	mine := storage.ListDelegationsForDelegator{DelegatorID: u.ID}
+	_, err := a.DB.Query(ctx, &mine)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	toMe := storage.ListDelegationsForDelegate{DelegateID: u.ID}
+	_, err = a.DB.Query(ctx, &toMe)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	res := types.ListDelegationsResponse{
+		Delegations:   make([]types.Delegation, len(mine.Result)),
+		DelegatedToMe: make([]types.Delegation, len(toMe.Result)),
+	}
+	for i, d := range mine.Result {
+		res.Delegations[i] = d.ToAPI()
+	}
+	for i, d := range toMe.Result {
+		res.DelegatedToMe[i] = d.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Create a delegation
+// (POST /api/v1/delegations)
+func (a *API) CreateDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	var b types.CreateDelegationJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+
+	now := a.Clock.Now()
+	var fieldErr *apio.FieldError
+	switch {
+	case b.DelegateId == u.ID:
+		fieldErr = &apio.FieldError{Field: "delegateId", Error: "you can't delegate your reviews to yourself"}
+	case !b.EndTime.After(b.StartTime):
+		fieldErr = &apio.FieldError{Field: "endTime", Error: "end time must be after the start time"}
+	case !b.EndTime.After(now):
+		fieldErr = &apio.FieldError{Field: "endTime", Error: "end time must be in the future"}
+	}
+	if fieldErr != nil {
+		apio.Error(ctx, w, &apio.APIError{
+			Err:    errors.New("delegation validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{*fieldErr},
+		})
+		return
+	}
+
+	// the delegate must be an active user, so that they can review requests.
+	uq := storage.GetUser{ID: b.DelegateId}
+	_, err = a.DB.Query(ctx, &uq)
+	if errors.As(err, &identity.UserNotFoundError{}) {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	if uq.Result.Status != types.IdpStatusACTIVE {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("delegate is not an active user"), http.StatusBadRequest))
+		return
+	}
+
+	d := access.Delegation{
+		ID:          types.NewDelegationID(),
+		DelegatorID: u.ID,
+		DelegateID:  b.DelegateId,
+		StartTime:   b.StartTime,
+		EndTime:     b.EndTime,
+		CreatedAt:   now,
+	}
+	err = a.DB.Put(ctx, &d)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, d.ToAPI(), http.StatusCreated)
+}
+
+// Delete a delegation
+// (DELETE /api/v1/delegations/{delegationId})
+func (a *API) DeleteDelegation(w http.ResponseWriter, r *http.Request, delegationId string) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	// users can only delete the delegations which they have made.
+	q := storage.ListDelegationsForDelegator{DelegatorID: u.ID}
+	_, err := a.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	for _, d := range q.Result {
+		if d.ID == delegationId {
+			err = a.DB.Delete(ctx, &d)
+			if err != nil {
+				apio.Error(ctx, w, err)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	apio.Error(ctx, w, apio.NewRequestError(errors.New("delegation not found"), http.StatusNotFound))
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDelegation(t *testing.T) {
+	type testcase struct {
+		name         string
+		give         string
+		delegate     *identity.User
+		delegateErr  error
+		wantCode     int
+		wantBodyPart string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			give:     `{"delegateId":"usr_2","startTime":"2099-01-01T00:00:00Z","endTime":"2099-01-08T00:00:00Z"}`,
+			delegate: &identity.User{ID: "usr_2", Status: types.IdpStatusACTIVE},
+			wantCode: http.StatusCreated,
+		},
+		{
+			name:         "delegate to self",
+			give:         `{"delegateId":"usr_1","startTime":"2099-01-01T00:00:00Z","endTime":"2099-01-08T00:00:00Z"}`,
+			wantCode:     http.StatusBadRequest,
+			wantBodyPart: "you can't delegate your reviews to yourself",
+		},
+		{
+			name:         "end before start",
+			give:         `{"delegateId":"usr_2","startTime":"2099-01-08T00:00:00Z","endTime":"2099-01-01T00:00:00Z"}`,
+			wantCode:     http.StatusBadRequest,
+			wantBodyPart: "end time must be after the start time",
+		},
+		{
+			name:         "ended in the past",
+			give:         `{"delegateId":"usr_2","startTime":"2020-01-01T00:00:00Z","endTime":"2020-01-08T00:00:00Z"}`,
+			wantCode:     http.StatusBadRequest,
+			wantBodyPart: "end time must be in the future",
+		},
+		{
+			// the server time is 1st Jan 2022, 10:00am UTC.
+			name:     "ends after the server time",
+			give:     `{"delegateId":"usr_2","startTime":"2021-12-31T00:00:00Z","endTime":"2022-01-01T11:00:00Z"}`,
+			delegate: &identity.User{ID: "usr_2", Status: types.IdpStatusACTIVE},
+			wantCode: http.StatusCreated,
+		},
+		{
+			name:         "delegate not found",
+			give:         `{"delegateId":"usr_2","startTime":"2099-01-01T00:00:00Z","endTime":"2099-01-08T00:00:00Z"}`,
+			delegateErr:  identity.UserNotFoundError{User: "usr_2"},
+			wantCode:     http.StatusBadRequest,
+			wantBodyPart: "user usr_2 not found",
+		},
+		{
+			name:         "delegate archived",
+			give:         `{"delegateId":"usr_2","startTime":"2099-01-01T00:00:00Z","endTime":"2099-01-08T00:00:00Z"}`,
+			delegate:     &identity.User{ID: "usr_2", Status: types.IdpStatusARCHIVED},
+			wantCode:     http.StatusBadRequest,
+			wantBodyPart: "delegate is not an active user",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetUser{Result: tc.delegate}, tc.delegateErr)
+
+			a := API{DB: db}
+			handler := newTestServer(t, &a, withRequestUser(identity.User{ID: "usr_1"}))
+
+			req, err := http.NewRequest("POST", "/api/v1/delegations", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Contains(t, string(data), tc.wantBodyPart)
+		})
+	}
+}
+
+func TestListDelegations(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	mine := access.Delegation{ID: "del_1", DelegatorID: "usr_1", DelegateID: "usr_2", StartTime: start, EndTime: start.Add(time.Hour), CreatedAt: start}
+	toMe := access.Delegation{ID: "del_2", DelegatorID: "usr_3", DelegateID: "usr_1", StartTime: start, EndTime: start.Add(time.Hour), CreatedAt: start}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.ListDelegationsForDelegator{Result: []access.Delegation{mine}})
+	db.MockQuery(&storage.ListDelegationsForDelegate{Result: []access.Delegation{toMe}})
+
+	a := API{DB: db}
+	handler := newTestServer(t, &a, withRequestUser(identity.User{ID: "usr_1"}))
+
+	req, err := http.NewRequest("GET", "/api/v1/delegations", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	data, err := io.ReadAll(rr.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `{"delegatedToMe":[{"createdAt":"2022-01-01T00:00:00Z","delegateId":"usr_1","delegatorId":"usr_3","endTime":"2022-01-01T01:00:00Z","id":"del_2","startTime":"2022-01-01T00:00:00Z"}],"delegations":[{"createdAt":"2022-01-01T00:00:00Z","delegateId":"usr_2","delegatorId":"usr_1","endTime":"2022-01-01T01:00:00Z","id":"del_1","startTime":"2022-01-01T00:00:00Z"}]}`, string(data))
+}
+
+func TestDeleteDelegation(t *testing.T) {
+	type testcase struct {
+		name     string
+		give     string
+		wantCode int
+	}
+
+	testcases := []testcase{
+		{name: "ok", give: "del_1", wantCode: http.StatusOK},
+		// users can't delete delegations which they haven't made.
+		{name: "not found", give: "del_other", wantCode: http.StatusNotFound},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListDelegationsForDelegator{Result: []access.Delegation{{ID: "del_1", DelegatorID: "usr_1", DelegateID: "usr_2"}}})
+
+			a := API{DB: db}
+			handler := newTestServer(t, &a, withRequestUser(identity.User{ID: "usr_1"}))
+
+			req, err := http.NewRequest("DELETE", "/api/v1/delegations/"+tc.give, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+		})
+	}
+}
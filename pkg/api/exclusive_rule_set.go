@@ -0,0 +1,181 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/auth"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/accesssvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// List exclusive rule sets
+// (GET /api/v1/admin/exclusive-rule-sets)
+func (a *API) AdminListExclusiveRuleSets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := storage.ListExclusiveRuleSets{}
+	_, err := a.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListExclusiveRuleSetsResponse{
+		ExclusiveRuleSets: make([]types.ExclusiveRuleSet, len(q.Result)),
+	}
+	for i, s := range q.Result {
+		res.ExclusiveRuleSets[i] = s.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Create an exclusive rule set
+// (POST /api/v1/admin/exclusive-rule-sets)
+func (a *API) AdminCreateExclusiveRuleSet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	var b types.AdminCreateExclusiveRuleSetJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+
+	name := strings.TrimSpace(b.Name)
+	if name == "" {
+		apio.Error(ctx, w, &apio.APIError{
+			Err:    errors.New("exclusive rule set validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{{Field: "name", Error: "name is required"}},
+		})
+		return
+	}
+
+	var ruleIDs []string
+	seen := make(map[string]bool)
+	for _, id := range b.RuleIds {
+		if !seen[id] {
+			seen[id] = true
+			ruleIDs = append(ruleIDs, id)
+		}
+	}
+	if len(ruleIDs) < 2 {
+		apio.Error(ctx, w, &apio.APIError{
+			Err:    errors.New("exclusive rule set validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{{Field: "ruleIds", Error: "at least two different access rules are required"}},
+		})
+		return
+	}
+	for _, id := range ruleIDs {
+		q := storage.GetAccessRuleCurrent{ID: id}
+		_, err = a.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			apio.Error(ctx, w, apio.NewRequestError(fmt.Errorf("access rule %s not found", id), http.StatusBadRequest))
+			return
+		}
+		if err != nil {
+			apio.Error(ctx, w, err)
+			return
+		}
+	}
+
+	set := rule.ExclusiveRuleSet{
+		ID:        types.NewExclusiveRuleSetID(),
+		Name:      name,
+		RuleIDs:   ruleIDs,
+		CreatedBy: u.ID,
+		CreatedAt: a.Clock.Now(),
+	}
+	if b.Description != nil {
+		set.Description = *b.Description
+	}
+	err = a.DB.Put(ctx, &set)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, set.ToAPI(), http.StatusCreated)
+}
+
+// Delete an exclusive rule set
+// (DELETE /api/v1/admin/exclusive-rule-sets/{exclusiveRuleSetId})
+func (a *API) AdminDeleteExclusiveRuleSet(w http.ResponseWriter, r *http.Request, exclusiveRuleSetId string) {
+	ctx := r.Context()
+	q := storage.GetExclusiveRuleSet{ID: exclusiveRuleSetId}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(errors.New("exclusive rule set not found"), http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	err = a.DB.Delete(ctx, q.Result)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// List exclusive rule set violations
+// (GET /api/v1/admin/exclusive-rule-sets/violations)
+func (a *API) AdminListExclusiveRuleViolations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sq := storage.ListExclusiveRuleSets{}
+	_, err := a.DB.Query(ctx, &sq)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	res := types.ListExclusiveRuleViolationsResponse{
+		Violations: []types.ExclusiveRuleViolation{},
+	}
+	if len(sq.Result) == 0 {
+		apio.JSON(ctx, w, res, http.StatusOK)
+		return
+	}
+
+	var requests []access.Request
+	var next string
+	for {
+		q := storage.ListRequestsForStatus{Status: access.APPROVED}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		qr, err := a.DB.Query(ctx, &q, opts...)
+		if err != nil && err != ddb.ErrNoItems {
+			apio.Error(ctx, w, err)
+			return
+		}
+		requests = append(requests, q.Result...)
+		if qr == nil || qr.NextPage == "" {
+			break
+		}
+		next = qr.NextPage
+	}
+
+	for _, v := range accesssvc.FindExclusiveRuleViolations(sq.Result, requests) {
+		violation := types.ExclusiveRuleViolation{
+			ExclusiveRuleSetId: v.Set.ID,
+			UserId:             v.UserID,
+		}
+		for _, req := range v.Requests {
+			violation.RequestIds = append(violation.RequestIds, req.ID)
+			violation.RuleIds = append(violation.RuleIds, req.Rule)
+		}
+		res.Violations = append(res.Violations, violation)
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminCreateExclusiveRuleSet(t *testing.T) {
+	type testcase struct {
+		name         string
+		give         string
+		ruleErr      error
+		wantCode     int
+		wantBodyPart string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			give:     `{"name":"prod changes","ruleIds":["rul_deploy","rul_approve"]}`,
+			wantCode: http.StatusCreated,
+		},
+		{
+			name:         "blank name",
+			give:         `{"name":"  ","ruleIds":["rul_deploy","rul_approve"]}`,
+			wantCode:     http.StatusBadRequest,
+			wantBodyPart: "name is required",
+		},
+		{
+			name:         "duplicate rules",
+			give:         `{"name":"prod changes","ruleIds":["rul_deploy","rul_deploy"]}`,
+			wantCode:     http.StatusBadRequest,
+			wantBodyPart: "at least two different access rules are required",
+		},
+		{
+			name:         "rule not found",
+			give:         `{"name":"prod changes","ruleIds":["rul_deploy","rul_approve"]}`,
+			ruleErr:      ddb.ErrNoItems,
+			wantCode:     http.StatusBadRequest,
+			wantBodyPart: "access rule rul_deploy not found",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetAccessRuleCurrent{Result: &rule.AccessRule{}}, tc.ruleErr)
+
+			a := API{DB: db}
+			handler := newTestServer(t, &a, withRequestUser(identity.User{ID: "usr_admin"}), withIsAdmin(true))
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/exclusive-rule-sets", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Contains(t, string(data), tc.wantBodyPart)
+		})
+	}
+}
+
+func TestAdminListExclusiveRuleViolations(t *testing.T) {
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	grant := &access.Grant{Start: now, End: now.Add(time.Hour), Status: ahTypes.GrantStatusACTIVE}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.ListExclusiveRuleSets{Result: []rule.ExclusiveRuleSet{{ID: "ers_1", RuleIDs: []string{"rul_deploy", "rul_approve"}}}})
+	db.MockQuery(&storage.ListRequestsForStatus{Result: []access.Request{
+		{ID: "req_1", RequestedBy: "usr_1", Rule: "rul_deploy", Status: access.APPROVED, Grant: grant},
+		{ID: "req_2", RequestedBy: "usr_1", Rule: "rul_approve", Status: access.APPROVED, Grant: grant},
+	}})
+
+	a := API{DB: db}
+	handler := newTestServer(t, &a, withIsAdmin(true))
+
+	req, err := http.NewRequest("GET", "/api/v1/admin/exclusive-rule-sets/violations", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	data, err := io.ReadAll(rr.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `{"violations":[{"exclusiveRuleSetId":"ers_1","requestIds":["req_1","req_2"],"ruleIds":["rul_deploy","rul_approve"],"userId":"usr_1"}]}`, string(data))
+}
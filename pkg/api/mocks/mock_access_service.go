@@ -80,3 +80,18 @@ func (mr *MockAccessServiceMockRecorder) CreateRequest(arg0, arg1, arg2 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequest", reflect.TypeOf((*MockAccessService)(nil).CreateRequest), arg0, arg1, arg2)
 }
+
+// ExtendRequest mocks base method.
+func (m *MockAccessService) ExtendRequest(arg0 context.Context, arg1 *identity.User, arg2 string, arg3 types.ExtendRequestRequest) (*accesssvc.CreateRequestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendRequest", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*accesssvc.CreateRequestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendRequest indicates an expected call of ExtendRequest.
+func (mr *MockAccessServiceMockRecorder) ExtendRequest(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendRequest", reflect.TypeOf((*MockAccessService)(nil).ExtendRequest), arg0, arg1, arg2, arg3)
+}
@@ -200,7 +200,17 @@ func (a *API) UserGetRequest(w http.ResponseWriter, r *http.Request, requestId s
 		apio.Error(ctx, w, err)
 		return
 	}
-	apio.JSON(ctx, w, qrv.Result.Request.ToAPIDetail(*qr.Result, true, requestArguments), http.StatusOK)
+	reviews := storage.ListReviewsForRequest{RequestID: requestId}
+	_, err = a.DB.Query(ctx, &reviews)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	// reviewers can only review the request while it is waiting on their approval stage, and only once.
+	canReview := qrv.Result.ApprovalStage == qrv.Result.Request.ApprovalStage &&
+		!reviews.Result.HasApproved(u.ID) &&
+		!reviews.Result.HasReviewedStage(u.ID, qrv.Result.Request.ApprovalStage)
+	apio.JSON(ctx, w, qrv.Result.Request.ToAPIDetail(*qr.Result, canReview, requestArguments), http.StatusOK)
 }
 
 // Creates a request
@@ -227,18 +237,31 @@ func (a *API) UserCreateRequest(w http.ResponseWriter, r *http.Request) {
 		apio.Error(ctx, w, apio.NewRequestError(grantValidationError, http.StatusBadRequest))
 		return
 	}
-	if err == accesssvc.ErrNoMatchingGroup {
+	if err == accesssvc.ErrNoMatchingGroup || err == accesssvc.ErrBreakGlassNotAllowed {
 		// the user isn't authorized to make requests on this rule.
 		err = apio.NewRequestError(err, http.StatusUnauthorized)
+	} else if err == accesssvc.ErrRequestDeniedByPolicy || err == accesssvc.ErrOnBehalfOfNotAllowed {
+		err = apio.NewRequestError(err, http.StatusForbidden)
+	} else if err == accesssvc.ErrBreakGlassJustificationRequired || err == accesssvc.ErrBreakGlassNotAcknowledged || err == accesssvc.ErrBreakGlassOnBehalfOf {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
 	} else if err == accesssvc.ErrRuleNotFound {
 		err = apio.NewRequestError(fmt.Errorf("access rule %s not found", incomingRequest.AccessRuleId), http.StatusNotFound)
+	} else if errors.As(err, &accesssvc.ExclusiveRuleConflictError{}) || errors.As(err, &accesssvc.SubjectNotEligibleError{}) {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
 	}
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
 	}
 
-	apio.JSON(ctx, w, result.Request.ToAPI(), http.StatusCreated)
+	// if the request was made on behalf of a group, a request is created for each member.
+	res := types.CreateRequestResponse{
+		Requests: []types.Request{result.Request.ToAPI()},
+	}
+	for _, o := range result.Others {
+		res.Requests = append(res.Requests, o.Request.ToAPI())
+	}
+	apio.JSON(ctx, w, res, http.StatusCreated)
 }
 
 func (a *API) CancelRequest(w http.ResponseWriter, r *http.Request, requestId string) {
@@ -267,6 +290,48 @@ func (a *API) CancelRequest(w http.ResponseWriter, r *http.Request, requestId st
 	apio.JSON(ctx, w, struct{}{}, http.StatusOK)
 }
 
+// Extend a request
+// (POST /api/v1/requests/{requestId}/extend)
+func (a *API) ExtendRequest(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	var b types.ExtendRequestRequest
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	result, err := a.Access.ExtendRequest(ctx, u, requestId, b)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(errors.New("request not found"), http.StatusNotFound)
+	}
+	if err == accesssvc.ErrUserNotAuthorized || err == accesssvc.ErrNoMatchingGroup {
+		// wrap the error in a 401 status code
+		err = apio.NewRequestError(err, http.StatusUnauthorized)
+	}
+	if err == accesssvc.ErrRuleNotFound {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err == accesssvc.ErrRequestCannotBeExtended || err == accesssvc.ErrRequestOverlapsExistingGrant {
+		// wrap the error in a 400 status code
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err == accesssvc.ErrRequestDeniedByPolicy {
+		err = apio.NewRequestError(err, http.StatusForbidden)
+	}
+	if errors.As(err, &accesssvc.ExclusiveRuleConflictError{}) {
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	apio.JSON(ctx, w, result.Request.ToAPI(), http.StatusCreated)
+}
+
 func (a *API) RevokeRequest(w http.ResponseWriter, r *http.Request, requestID string) {
 	ctx := r.Context()
 
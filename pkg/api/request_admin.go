@@ -104,5 +104,5 @@ func (a *API) AdminGetRequest(w http.ResponseWriter, r *http.Request, requestId
 		apio.Error(ctx, w, err)
 		return
 	}
-	apio.JSON(ctx, w, q.Result.ToAPIDetail(*qr.Result, q.Result.RequestedBy != u.ID, requestArguments), http.StatusOK)
+	apio.JSON(ctx, w, q.Result.ToAPIDetail(*qr.Result, !q.Result.IsRequestor(u.ID), requestArguments), http.StatusOK)
 }
@@ -52,7 +52,7 @@ func TestUserCreateRequest(t *testing.T) {
 				},
 			},
 			wantCode: http.StatusCreated,
-			wantBody: `{"accessRuleId":"rul_123","accessRuleVersion":"0001-01-01T00:00:00Z","id":"123","requestedAt":"0001-01-01T00:00:00Z","requestor":"testuser","status":"PENDING","timing":{"durationSeconds":10},"updatedAt":"0001-01-01T00:00:00Z"}`,
+			wantBody: `{"requests":[{"accessRuleId":"rul_123","accessRuleVersion":"0001-01-01T00:00:00Z","id":"123","requestedAt":"0001-01-01T00:00:00Z","requestor":"testuser","status":"PENDING","timing":{"durationSeconds":10},"updatedAt":"0001-01-01T00:00:00Z"}]}`,
 		},
 		{
 			name:     "no duration",
@@ -80,6 +80,45 @@ func TestUserCreateRequest(t *testing.T) {
 			wantCode:      http.StatusUnauthorized,
 			wantBody:      `{"error":"user was not in a matching group for the access rule"}`,
 		},
+		{
+			name:          "on behalf of a user who the requester isn't allowed to request for",
+			give:          `{"timing":{"durationSeconds": 10}, "accessRuleId": "rul_123", "onBehalfOf": {"userId": "usr_2"}}`,
+			mockCreateErr: accesssvc.ErrOnBehalfOfNotAllowed,
+			wantCode:      http.StatusForbidden,
+			wantBody:      `{"error":"user is not allowed to request access on behalf of these users"}`,
+		},
+		{
+			name: "on behalf of a group returns every request",
+			give: `{"timing":{"durationSeconds": 10}, "accessRuleId": "rul_123", "onBehalfOf": {"groupId": "grp_1"}}`,
+			mockCreate: &accesssvc.CreateRequestResult{
+				Request: access.Request{
+					ID:          "123",
+					RequestedBy: "usr_2",
+					Rule:        "rul_123",
+					RuleVersion: "0001-01-01T00:00:00Z",
+					Status:      access.PENDING,
+					RequestedTiming: access.Timing{
+						Duration: time.Second * 10,
+					},
+				},
+				Others: []accesssvc.CreateRequestResult{
+					{
+						Request: access.Request{
+							ID:          "456",
+							RequestedBy: "usr_3",
+							Rule:        "rul_123",
+							RuleVersion: "0001-01-01T00:00:00Z",
+							Status:      access.PENDING,
+							RequestedTiming: access.Timing{
+								Duration: time.Second * 10,
+							},
+						},
+					},
+				},
+			},
+			wantCode: http.StatusCreated,
+			wantBody: `{"requests":[{"accessRuleId":"rul_123","accessRuleVersion":"0001-01-01T00:00:00Z","id":"123","requestedAt":"0001-01-01T00:00:00Z","requestor":"usr_2","status":"PENDING","timing":{"durationSeconds":10},"updatedAt":"0001-01-01T00:00:00Z"},{"accessRuleId":"rul_123","accessRuleVersion":"0001-01-01T00:00:00Z","id":"456","requestedAt":"0001-01-01T00:00:00Z","requestor":"usr_3","status":"PENDING","timing":{"durationSeconds":10},"updatedAt":"0001-01-01T00:00:00Z"}]}`,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -189,6 +228,95 @@ func TestUserCancelRequest(t *testing.T) {
 
 }
 
+func TestUserExtendRequest(t *testing.T) {
+	type testcase struct {
+		name          string
+		give          string
+		mockExtend    *accesssvc.CreateRequestResult
+		mockExtendErr error
+		wantCode      int
+		wantBody      string
+	}
+
+	original := "req_123"
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: `{"extensionDurationSeconds": 3600}`,
+			mockExtend: &accesssvc.CreateRequestResult{
+				Request: access.Request{
+					ID:          "req_456",
+					RequestedBy: "testuser",
+					Rule:        "rul_123",
+					RuleVersion: "0001-01-01T00:00:00Z",
+					Status:      access.PENDING,
+					RequestedTiming: access.Timing{
+						Duration: time.Hour,
+					},
+					ExtensionOf: &original,
+				},
+			},
+			wantCode: http.StatusCreated,
+			wantBody: `{"accessRuleId":"rul_123","accessRuleVersion":"0001-01-01T00:00:00Z","extensionOf":"req_123","id":"req_456","requestedAt":"0001-01-01T00:00:00Z","requestor":"testuser","status":"PENDING","timing":{"durationSeconds":3600},"updatedAt":"0001-01-01T00:00:00Z"}`,
+		},
+		{
+			name:     "no duration",
+			give:     `{}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"request body has an error: doesn't match the schema: Error at \"/extensionDurationSeconds\": property \"extensionDurationSeconds\" is missing"}`,
+		},
+		{
+			name:          "not found",
+			give:          `{"extensionDurationSeconds": 3600}`,
+			mockExtendErr: ddb.ErrNoItems,
+			wantCode:      http.StatusNotFound,
+			wantBody:      `{"error":"request not found"}`,
+		},
+		{
+			name:          "unauthorized",
+			give:          `{"extensionDurationSeconds": 3600}`,
+			mockExtendErr: accesssvc.ErrUserNotAuthorized,
+			wantCode:      http.StatusUnauthorized,
+			wantBody:      `{"error":"user is not authorized to perform this action"}`,
+		},
+		{
+			name:          "cannot be extended",
+			give:          `{"extensionDurationSeconds": 3600}`,
+			mockExtendErr: accesssvc.ErrRequestCannotBeExtended,
+			wantCode:      http.StatusBadRequest,
+			wantBody:      `{"error":"only requests with a pending or active grant can be extended"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAccess := mocks.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().ExtendRequest(gomock.Any(), gomock.Any(), "req_123", gomock.Any()).Return(tc.mockExtend, tc.mockExtendErr).AnyTimes()
+			a := API{Access: mockAccess}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/requests/req_123/extend", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
+
 func TestUserGetRequest(t *testing.T) {
 
 	type testcase struct {
@@ -202,6 +330,8 @@ func TestUserGetRequest(t *testing.T) {
 		mockGetRequest *access.Request
 		// request body (Request type)
 		mockGetReviewer *access.Reviewer
+		mockListReviews access.Reviews
+		apiUserID       string
 		// expected HTTP response code
 		wantCode int
 		// expected HTTP response body
@@ -247,6 +377,31 @@ func TestUserGetRequest(t *testing.T) {
 			// note canReview is true in the response
 			wantBody: `{"accessRule":{"description":"","id":"test","isCurrent":false,"name":"","target":{"provider":{"id":"","type":""}},"timeConstraints":{"maxDurationSeconds":0},"version":""},"arguments":{},"canReview":true,"id":"req_123","requestedAt":"0001-01-01T00:00:00Z","requestor":"","status":"PENDING","timing":{"durationSeconds":0},"updatedAt":"0001-01-01T00:00:00Z"}`,
 		},
+		{
+			name:     "reviewer cannot review request they have already reviewed",
+			givenID:  `req_123`,
+			wantCode: http.StatusOK,
+			mockGetRequest: &access.Request{
+				RequestedBy: "randomUser",
+				ID:          "req_123",
+				Status:      access.PENDING,
+				Rule:        "abcd",
+				RuleVersion: "efgh",
+			},
+			mockGetAccessRuleVersion:     &rule.AccessRule{ID: "test"},
+			withRequestArgumentsResponse: make(map[string]types.RequestArgument),
+			mockGetReviewer: &access.Reviewer{Request: access.Request{
+				ID:               "req_123",
+				Status:           access.PENDING,
+				Rule:             "abcd",
+				RuleVersion:      "efgh",
+				ApprovalProgress: &access.ApprovalProgress{Approvals: 1, Required: 2},
+			}},
+			apiUserID:       "reviewer",
+			mockListReviews: access.Reviews{{ReviewerID: "reviewer", Decision: access.DecisionApproved}},
+			// note canReview is false in the response
+			wantBody: `{"accessRule":{"description":"","id":"test","isCurrent":false,"name":"","target":{"provider":{"id":"","type":""}},"timeConstraints":{"maxDurationSeconds":0},"version":""},"approvalProgress":{"approvals":1,"required":2},"arguments":{},"canReview":false,"id":"req_123","requestedAt":"0001-01-01T00:00:00Z","requestor":"","status":"PENDING","timing":{"durationSeconds":0},"updatedAt":"0001-01-01T00:00:00Z"}`,
+		},
 		{
 			name:              "noRequestFound",
 			givenID:           `wrongID`,
@@ -275,6 +430,7 @@ func TestUserGetRequest(t *testing.T) {
 			db := ddbmock.New(t)
 			db.MockQueryWithErr(&storage.GetRequest{Result: tc.mockGetRequest}, tc.mockGetRequestErr)
 			db.MockQueryWithErr(&storage.GetRequestReviewer{Result: tc.mockGetReviewer}, tc.mockGetReviewerErr)
+			db.MockQuery(&storage.ListReviewsForRequest{Result: tc.mockListReviews})
 			db.MockQuery(&storage.GetAccessRuleVersion{Result: tc.mockGetAccessRuleVersion})
 			db.MockQuery(&storage.ListCachedProviderOptions{Result: []cache.ProviderOption{}})
 			ctrl := gomock.NewController(t)
@@ -283,7 +439,7 @@ func TestUserGetRequest(t *testing.T) {
 				rs.EXPECT().RequestArguments(gomock.Any(), gomock.Any()).Return(tc.withRequestArgumentsResponse, nil)
 			}
 			a := API{DB: db, Rules: rs}
-			handler := newTestServer(t, &a)
+			handler := newTestServer(t, &a, withRequestUser(identity.User{ID: tc.apiUserID}))
 
 			req, err := http.NewRequest("GET", "/api/v1/requests/"+tc.givenID, strings.NewReader(""))
 			if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/auth"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/service/accesssvc"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
 	"github.com/common-fate/granted-approvals/pkg/storage"
 	"github.com/common-fate/granted-approvals/pkg/types"
 	"golang.org/x/sync/errgroup"
@@ -88,7 +89,7 @@ func (a *API) ReviewRequest(w http.ResponseWriter, r *http.Request, requestId st
 		AccessRule:      *rule,
 		OverrideTiming:  overrideTiming,
 	})
-	if err == accesssvc.ErrRequestOverlapsExistingGrant {
+	if err == accesssvc.ErrRequestOverlapsExistingGrant || err == accesssvc.ErrRequestAlreadyReviewed || errors.As(err, &accesssvc.ExclusiveRuleConflictError{}) || err == grantsvc.ErrNoUpcomingOccurrences {
 		// wrap the error in a 400 status code
 		err = apio.NewRequestError(err, http.StatusBadRequest)
 	}
@@ -3,7 +3,9 @@ package api
 import (
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/apikit/openapi"
 	"github.com/common-fate/granted-approvals/pkg/auth"
@@ -18,6 +20,7 @@ type testOptions struct {
 	// comes from this user.
 	RequestUser identity.User
 	IsAdmin     bool
+	Clock       clock.Clock
 }
 
 func withRequestUser(user identity.User) func(*testOptions) {
@@ -32,6 +35,12 @@ func withIsAdmin(isAdmin bool) func(*testOptions) {
 	}
 }
 
+func withClock(clk clock.Clock) func(*testOptions) {
+	return func(to *testOptions) {
+		to.Clock = clk
+	}
+}
+
 // newTestServer creates a configured API server for use in Go tests.
 // The default time of the server is 1st Jan 2022, 10:00am UTC.
 // This can be overriden by providing a custom clock with the withClock() option.
@@ -42,6 +51,13 @@ func newTestServer(t *testing.T, a *API, opts ...func(*testOptions)) http.Handle
 		o(&to)
 	}
 
+	if to.Clock == nil {
+		clk := clock.NewMock()
+		clk.Set(time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC))
+		to.Clock = clk
+	}
+	a.Clock = to.Clock
+
 	// zaptest outputs logs if a test fails.
 	log := zaptest.NewLogger(t)
 
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type Config struct {
 	Host              string `env:"APPROVALS_HOST,default=0.0.0.0:8080"`
 	LogLevel          string `env:"LOG_LEVEL,default=info"`
@@ -12,8 +14,8 @@ type Config struct {
 	RunAccessHandler  bool   `env:"RUN_ACCESS_HANDLER,default=true"`
 	MockAccessHandler bool   `env:"MOCK_ACCESS_HANDLER,default=false"`
 	SentryDSN         string `env:"SENTRY_DSN"`
-	EventBusArn       string `env:"EVENT_BUS_ARN,required"`
-	EventBusSource    string `env:"EVENT_BUS_SOURCE,required"`
+	EventBusArn       string `env:"EVENT_BUS_ARN"`
+	EventBusSource    string `env:"EVENT_BUS_SOURCE"`
 	IdpProvider       string `env:"IDENTITY_PROVIDER,required"`
 	DeploymentSuffix  string `env:"DEPLOYMENT_SUFFIX"`
 	// This should be an instance of deploy.FeatureMap which is a specific json format for this
@@ -23,6 +25,17 @@ type Config struct {
 	AccessHandlerExecutionRoleARN string `env:"ACCESS_HANDLER_EXECUTION_ROLE_ARN,required"`
 	RemoteConfigURL               string `env:"REMOTE_CONFIG_URL"`
 	RemoteConfigHeaders           string `env:"REMOTE_CONFIG_HEADERS"`
+	// EventBusType is the transport used to send events: eventbridge, inprocess or webhook.
+	// The inprocess bus runs the event handlers in the server, so that it can run without AWS.
+	EventBusType          string `env:"EVENT_BUS_TYPE,default=eventbridge"`
+	EventBusWebhookURL    string `env:"EVENT_BUS_WEBHOOK_URL"`
+	EventBusWebhookSecret string `env:"EVENT_BUS_WEBHOOK_SECRET"`
+	// This should be an instance of deploy.FeatureMap which is a specific json format for this
+	// Use deploy.UnmarshalFeatureMap to unmarshal this data into a FeatureMap
+	OnCallSettings string `env:"ONCALL_SETTINGS,default={}"`
+	// This should be an instance of deploy.FeatureMap which is a specific json format for this
+	// Use deploy.UnmarshalFeatureMap to unmarshal this data into a FeatureMap
+	TicketSettings string `env:"TICKET_SETTINGS,default={}"`
 }
 
 type NotificationsConfig struct {
@@ -57,6 +70,22 @@ type CacheSyncConfig struct {
 	AccessHandlerURL string `env:"ACCESS_HANDLER_URL,default=http://0.0.0.0:9092"`
 }
 
+type ReconcilerConfig struct {
+	TableName   string `env:"APPROVALS_TABLE_NAME,required"`
+	LogLevel    string `env:"LOG_LEVEL,default=info"`
+	EventBusArn string `env:"EVENT_BUS_ARN,required"`
+	// Remediate causes the reconciler to re-grant or re-revoke access when drift is detected.
+	Remediate bool `env:"RECONCILER_REMEDIATE,default=false"`
+	// Window bounds how long after they end EXPIRED and REVOKED grants are checked.
+	Window time.Duration `env:"RECONCILER_WINDOW,default=24h"`
+}
+
+type SweeperConfig struct {
+	TableName   string `env:"APPROVALS_TABLE_NAME,required"`
+	LogLevel    string `env:"LOG_LEVEL,default=info"`
+	EventBusArn string `env:"EVENT_BUS_ARN,required"`
+}
+
 type FrontendDeployerConfig struct {
 	LogLevel                             string `env:"LOG_LEVEL,default=info"`
 	Region                               string `env:"AWS_REGION,required"`
@@ -49,6 +49,22 @@ func (c Config) CDKContextArgs() []string {
 		args = append(args, "-c", fmt.Sprintf("notificationsConfiguration=%s", string(cfg)))
 	}
 
+	if c.Deployment.Parameters.OnCallConfiguration != nil {
+		cfg, err := json.Marshal(c.Deployment.Parameters.OnCallConfiguration)
+		if err != nil {
+			panic(err)
+		}
+		args = append(args, "-c", fmt.Sprintf("onCallConfiguration=%s", string(cfg)))
+	}
+
+	if c.Deployment.Parameters.TicketConfiguration != nil {
+		cfg, err := json.Marshal(c.Deployment.Parameters.TicketConfiguration)
+		if err != nil {
+			panic(err)
+		}
+		args = append(args, "-c", fmt.Sprintf("ticketConfiguration=%s", string(cfg)))
+	}
+
 	if c.Deployment.Parameters.IdentityProviderType != "" {
 		args = append(args, "-c", fmt.Sprintf("idpType=%s", string(c.Deployment.Parameters.IdentityProviderType)))
 	}
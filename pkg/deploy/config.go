@@ -168,6 +168,8 @@ type Parameters struct {
 	ProviderConfiguration           ProviderMap `yaml:"ProviderConfiguration,omitempty"`
 	IdentityConfiguration           FeatureMap  `yaml:"IdentityConfiguration,omitempty"`
 	NotificationsConfiguration      FeatureMap  `yaml:"NotificationsConfiguration,omitempty"`
+	OnCallConfiguration             FeatureMap  `yaml:"OnCallConfiguration,omitempty"`
+	TicketConfiguration             FeatureMap  `yaml:"TicketConfiguration,omitempty"`
 }
 
 // UnmarshalFeatureMap parses the JSON configuration data and returns
@@ -358,6 +360,28 @@ func (c *Config) CfnParams() ([]types.Parameter, error) {
 			ParameterValue: &configStr,
 		})
 	}
+	if c.Deployment.Parameters.OnCallConfiguration != nil {
+		config, err := json.Marshal(c.Deployment.Parameters.OnCallConfiguration)
+		if err != nil {
+			return nil, err
+		}
+		configStr := string(config)
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("OnCallConfiguration"),
+			ParameterValue: &configStr,
+		})
+	}
+	if c.Deployment.Parameters.TicketConfiguration != nil {
+		config, err := json.Marshal(c.Deployment.Parameters.TicketConfiguration)
+		if err != nil {
+			return nil, err
+		}
+		configStr := string(config)
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("TicketConfiguration"),
+			ParameterValue: &configStr,
+		})
+	}
 	if p.AdministratorGroupID != "" {
 		res = append(res, types.Parameter{
 			ParameterKey:   aws.String("AdministratorGroupID"),
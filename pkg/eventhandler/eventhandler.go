@@ -4,9 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
-	"github.com/aws/aws-lambda-go/events"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
@@ -17,17 +17,20 @@ import (
 
 // EventHandler provides handler methods for updating items in Db in response to external events such as from teh access handler
 type EventHandler struct {
-	db ddb.Storage
+	db       ddb.Storage
+	requests dbupdate.RequestWriter
 }
 
-func New(ctx context.Context, db ddb.Storage) (*EventHandler, error) {
-	return &EventHandler{db: db}, nil
+// New creates an EventHandler. Requests are written with requests, so that grant status
+// changes don't overwrite concurrent updates to the request.
+func New(ctx context.Context, db ddb.Storage, requests dbupdate.RequestWriter) (*EventHandler, error) {
+	return &EventHandler{db: db, requests: requests}, nil
 }
 
-func (n *EventHandler) HandleEvent(ctx context.Context, event events.CloudWatchEvent) (err error) {
+func (n *EventHandler) HandleEvent(ctx context.Context, event gevent.Event) (err error) {
 	log := zap.S().With("event", event)
-	log.Info("received event from eventbridge")
-	if strings.HasPrefix(event.DetailType, "grant") {
+	log.Info("received event")
+	if strings.HasPrefix(event.Type, "grant") {
 		err = n.HandleGrantEvent(ctx, log, event)
 		if err != nil {
 			return err
@@ -39,7 +42,7 @@ func (n *EventHandler) HandleEvent(ctx context.Context, event events.CloudWatchE
 }
 
 // HandleGrantEvent will update the status of a grant in response to events emitted by the access handler
-func (n *EventHandler) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+func (n *EventHandler) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger, event gevent.Event) error {
 	var grantEvent gevent.GrantEventPayload
 	err := json.Unmarshal(event.Detail, &grantEvent)
 	if err != nil {
@@ -55,43 +58,62 @@ func (n *EventHandler) HandleGrantEvent(ctx context.Context, log *zap.SugaredLog
 		return fmt.Errorf("request: %s does not have a grant", grantEvent.Grant.ID)
 	}
 
-	if event.DetailType == gevent.GrantRevokedType {
+	if event.Type == gevent.GrantRevokedType {
 		log.Infow("Ignored grant revoke event")
 		return nil
 	}
-	oldStatus := gq.Result.Grant.Status
-	newStatus := grantEvent.Grant.Status
-	gq.Result.Grant.Status = newStatus
-	gq.Result.Grant.UpdatedAt = event.Time
+	// drift doesn't change the grant status, it is recorded in the audit trail for the request.
+	if event.Type == gevent.GrantDriftedType {
+		var grantDriftedEvent gevent.GrantDrifted
+		err := json.Unmarshal(event.Detail, &grantDriftedEvent)
+		if err != nil {
+			return err
+		}
+		requestEvent := access.NewRecordedEvent(gq.Result.ID, nil, event.Time, map[string]string{
+			"event":          gevent.GrantDriftedType,
+			"grantStatus":    string(gq.Result.Grant.Status),
+			"providerActive": strconv.FormatBool(grantDriftedEvent.ProviderActive),
+			"remediated":     strconv.FormatBool(grantDriftedEvent.Remediated),
+		})
+		log.Infow("inserting request event for grant drift")
+		return n.db.Put(ctx, &requestEvent)
+	}
 	// I anticipate that this would be succeptible to a race condition, recoverable if the eventbridge retries the event handler
 	// this is because the grant events are sourced from the access handler prior to the request being saved to dynamodb on creation
 	// we could solve this by saving the request to the DB prior to making the call to the access handler?
-	if event.DetailType == gevent.GrantCreatedType {
+	if event.Type == gevent.GrantCreatedType {
 		requestEvent := access.NewGrantCreatedEvent(gq.Result.ID, event.Time)
 		log.Infow("inserting request event for grant created")
 		return n.db.Put(ctx, &requestEvent)
 	}
-	var requestEvent access.RequestEvent
-
-	if event.DetailType == gevent.GrantFailedType {
+	var grantFailedEvent gevent.GrantFailed
+	if event.Type == gevent.GrantFailedType {
 		// Grant revoked events have an actor which should be included in the audit trail
-		var grantFailedEvent gevent.GrantFailed
 		err := json.Unmarshal(event.Detail, &grantFailedEvent)
 		if err != nil {
 			return err
 		}
-		requestEvent = access.NewGrantFailedEvent(gq.Result.ID, event.Time, oldStatus, newStatus, grantFailedEvent.Reason)
-		log.Infow("inserting request event for grant failed")
-
-	} else {
-		requestEvent = access.NewGrantStatusChangeEvent(gq.Result.ID, event.Time, nil, oldStatus, newStatus)
-		log.Infow("inserting request event for grant status change")
 	}
-	items, err := dbupdate.GetUpdateRequestItems(ctx, n.db, *gq.Result)
-	if err != nil {
-		return err
-	}
-	items = append(items, &requestEvent)
-	// Updates the grant status
-	return n.db.PutBatch(ctx, items...)
+
+	// the request is read again and the status change reapplied if it was updated concurrently.
+	newStatus := grantEvent.Grant.Status
+	return dbupdate.RetryUpdateRequest(ctx, n.db, n.requests, gq.Result, func(r *access.Request) ([]ddb.Keyer, error) {
+		if r.Grant == nil {
+			return nil, fmt.Errorf("request: %s does not have a grant", r.ID)
+		}
+		oldStatus := r.Grant.Status
+		r.Grant.Status = newStatus
+		r.Grant.UpdatedAt = event.Time
+
+		var requestEvent access.RequestEvent
+		if event.Type == gevent.GrantFailedType {
+			requestEvent = access.NewGrantFailedEvent(r.ID, event.Time, oldStatus, newStatus, grantFailedEvent.Reason)
+			log.Infow("inserting request event for grant failed")
+		} else {
+			requestEvent = access.NewGrantStatusChangeEvent(r.ID, event.Time, nil, oldStatus, newStatus)
+			log.Infow("inserting request event for grant status change")
+		}
+		// Updates the grant status
+		return []ddb.Keyer{&requestEvent}, nil
+	})
 }
@@ -0,0 +1,111 @@
+package gevent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/segmentio/ksuid"
+)
+
+// Source is the source of all events emitted by Granted Approvals.
+const Source = "commonfate.io/granted"
+
+const (
+	// BusTypeEventBridge sends events to an AWS EventBridge bus.
+	BusTypeEventBridge = "eventbridge"
+	// BusTypeInProcess dispatches events to handlers running in the same process.
+	// It is intended for local development and for running the server without AWS.
+	BusTypeInProcess = "inprocess"
+	// BusTypeWebhook sends events to an HTTP endpoint, signed with a shared secret.
+	BusTypeWebhook = "webhook"
+)
+
+// EventBus sends events to a transport such as EventBridge or a webhook.
+type EventBus interface {
+	Put(ctx context.Context, e EventTyper) error
+}
+
+// Handler handles an event received from an event bus.
+type Handler func(ctx context.Context, e Event) error
+
+// Subscriber is implemented by event buses which deliver events to handlers directly.
+type Subscriber interface {
+	// Subscribe registers a handler for an event type. The pattern may be an
+	// exact event type such as "grant.activated", a prefix such as "grant.*",
+	// or "*" to receive all events.
+	Subscribe(pattern string, h Handler)
+}
+
+// Event is a transport-neutral representation of an event.
+type Event struct {
+	ID     string          `json:"id"`
+	Type   string          `json:"type"`
+	Source string          `json:"source"`
+	Time   time.Time       `json:"time"`
+	Detail json.RawMessage `json:"detail"`
+}
+
+// NewEvent serializes an event so that it can be delivered to handlers.
+func NewEvent(e EventTyper, now time.Time) (Event, error) {
+	d, err := json.Marshal(e)
+	if err != nil {
+		return Event{}, err
+	}
+	return Event{
+		ID:     ksuid.New().String(),
+		Type:   e.EventType(),
+		Source: Source,
+		Time:   now,
+		Detail: d,
+	}, nil
+}
+
+// FromCloudWatchEvent converts an event received from EventBridge by a Lambda function.
+func FromCloudWatchEvent(e events.CloudWatchEvent) Event {
+	return Event{
+		ID:     e.ID,
+		Type:   e.DetailType,
+		Source: e.Source,
+		Time:   e.Time,
+		Detail: e.Detail,
+	}
+}
+
+// MatchesType returns true if the event type matches a subscription pattern.
+func MatchesType(pattern string, eventType string) bool {
+	if pattern == "*" {
+		return true
+	}
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(eventType, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == eventType
+}
+
+type BusOpts struct {
+	// Type is the transport to use. Defaults to BusTypeEventBridge.
+	Type          string
+	EventBusARN   string
+	WebhookURL    string
+	WebhookSecret string
+}
+
+// NewEventBus creates an event bus for the configured transport.
+func NewEventBus(ctx context.Context, opts BusOpts) (EventBus, error) {
+	switch opts.Type {
+	case BusTypeEventBridge, "":
+		if opts.EventBusARN == "" {
+			return nil, fmt.Errorf("an event bus ARN is required for the %s event bus", BusTypeEventBridge)
+		}
+		return NewSender(ctx, SenderOpts{EventBusARN: opts.EventBusARN})
+	case BusTypeInProcess:
+		return NewInProcessBus(), nil
+	case BusTypeWebhook:
+		return NewWebhookSender(WebhookOpts{URL: opts.WebhookURL, Secret: opts.WebhookSecret})
+	}
+	return nil, fmt.Errorf("unsupported event bus type: %s", opts.Type)
+}
@@ -8,6 +8,7 @@ const (
 	GrantExpiredType   = "grant.expired"
 	GrantRevokedType   = "grant.revoked"
 	GrantFailedType    = "grant.failed"
+	GrantDriftedType   = "grant.drifted"
 )
 
 // GrantCreated is emitted when a new grant is
@@ -79,6 +80,25 @@ func (GrantFailed) EventType() string {
 	return GrantFailedType
 }
 
+// GrantDrifted is emitted when the reconciler finds
+// that the state of the access in the provider doesn't
+// match the status of the grant recorded in Granted.
+// For example, a user may have been manually removed
+// from an Okta group while their grant is ACTIVE, or
+// access may have been left behind after a failed revoke.
+type GrantDrifted struct {
+	Grant types.Grant `json:"grant"`
+	// ProviderActive is whether the provider reported the access as being active.
+	ProviderActive bool `json:"providerActive"`
+	// Remediated is true if the reconciler re-granted or re-revoked
+	// the access to restore the expected state.
+	Remediated bool `json:"remediated"`
+}
+
+func (GrantDrifted) EventType() string {
+	return GrantDriftedType
+}
+
 // GrantEventPayload is a payload which is common to
 // all Grant events. It is used to conveniently unmarshal
 // the Grant payloads in our event handler code.
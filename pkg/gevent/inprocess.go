@@ -0,0 +1,81 @@
+package gevent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/benbjohnson/clock"
+	"go.uber.org/zap"
+)
+
+type subscription struct {
+	pattern string
+	handler Handler
+}
+
+// InProcessBus fans events out to handlers subscribed in the same process.
+// Handlers are run asynchronously, so that a slow or failing handler doesn't
+// block the caller, matching the behaviour of EventBridge.
+type InProcessBus struct {
+	Clock clock.Clock
+
+	mu            sync.RWMutex
+	subscriptions []subscription
+	wg            sync.WaitGroup
+}
+
+// NewInProcessBus creates a new InProcessBus
+func NewInProcessBus() *InProcessBus {
+	return &InProcessBus{Clock: clock.New()}
+}
+
+func (b *InProcessBus) Subscribe(pattern string, h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscriptions = append(b.subscriptions, subscription{pattern: pattern, handler: h})
+}
+
+func (b *InProcessBus) Put(ctx context.Context, e EventTyper) error {
+	// return early if we don't have an event to send.
+	if e == nil {
+		return nil
+	}
+
+	evt, err := NewEvent(e, b.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, s := range b.subscriptions {
+		if !MatchesType(s.pattern, evt.Type) {
+			continue
+		}
+		b.wg.Add(1)
+		go b.dispatch(s.handler, evt)
+	}
+	return nil
+}
+
+// dispatch runs a handler with a fresh context, as the context passed to Put
+// is usually cancelled when the API request which emitted the event completes.
+func (b *InProcessBus) dispatch(h Handler, evt Event) {
+	defer b.wg.Done()
+	log := zap.S().With("event.id", evt.ID, "event.type", evt.Type)
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorw("event handler panicked", "error", fmt.Sprint(r))
+		}
+	}()
+	err := h(context.Background(), evt)
+	if err != nil {
+		log.Errorw("error handling event", "error", err)
+	}
+}
+
+// Wait blocks until all events which have been put have been handled.
+func (b *InProcessBus) Wait() {
+	b.wg.Wait()
+}
@@ -0,0 +1,65 @@
+package gevent
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesType(t *testing.T) {
+	type testcase struct {
+		pattern   string
+		eventType string
+		want      bool
+	}
+
+	testcases := []testcase{
+		{pattern: "*", eventType: GrantActivatedType, want: true},
+		{pattern: "grant.*", eventType: GrantActivatedType, want: true},
+		{pattern: "grant.*", eventType: RequestCreatedType, want: false},
+		{pattern: GrantActivatedType, eventType: GrantActivatedType, want: true},
+		{pattern: GrantActivatedType, eventType: GrantExpiredType, want: false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.pattern+" "+tc.eventType, func(t *testing.T) {
+			assert.Equal(t, tc.want, MatchesType(tc.pattern, tc.eventType))
+		})
+	}
+}
+
+func TestInProcessBus(t *testing.T) {
+	bus := NewInProcessBus()
+
+	var mu sync.Mutex
+	var got []string
+	record := func(name string) Handler {
+		return func(ctx context.Context, e Event) error {
+			var te testEvent
+			err := json.Unmarshal(e.Detail, &te)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			got = append(got, name+":"+e.Type+":"+te.Data)
+			return nil
+		}
+	}
+	bus.Subscribe("event.*", record("prefix"))
+	bus.Subscribe("event.test", record("exact"))
+	bus.Subscribe("other.event", record("other"))
+
+	err := bus.Put(context.Background(), testEvent{Data: "testing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bus.Wait()
+
+	sort.Strings(got)
+	assert.Equal(t, []string{"exact:event.test:testing", "prefix:event.test:testing"}, got)
+}
@@ -7,6 +7,18 @@ const (
 	RequestApprovedType  = "request.approved"
 	RequestCancelledType = "request.cancelled"
 	RequestDeclinedType  = "request.declined"
+	// RequestStageAdvancedType is emitted when a stage of a multi-stage approval is complete.
+	RequestStageAdvancedType = "request.stage_advanced"
+	// RequestReviewedType is emitted when a review is recorded but the request still needs more reviews.
+	RequestReviewedType = "request.reviewed"
+	// RequestBreakGlassType is emitted when a user bypasses approval using break-glass access.
+	RequestBreakGlassType = "request.breakglass"
+	// RequestReminderType is emitted when reviewers are reminded about a pending request.
+	RequestReminderType = "request.reminder"
+	// RequestEscalatedType is emitted when escalation approvers are added to a pending request.
+	RequestEscalatedType = "request.escalated"
+	// RequestExpiredType is emitted when a pending request is closed because it wasn't reviewed in time.
+	RequestExpiredType = "request.expired"
 )
 
 // RequestCreated is emitted when a user requests access
@@ -47,6 +59,77 @@ func (RequestDeclined) EventType() string {
 	return RequestDeclinedType
 }
 
+// RequestStageAdvanced is emitted when a stage of a
+// multi-stage approval workflow has been approved and the
+// request is waiting on the next stage.
+type RequestStageAdvanced struct {
+	Request    access.Request `json:"request"`
+	ReviewerID string         `json:"reviewerId"`
+}
+
+func (RequestStageAdvanced) EventType() string {
+	return RequestStageAdvancedType
+}
+
+// RequestReviewed is emitted when a review is made
+// on a request which requires more than one approval,
+// and the request is still waiting on further reviews.
+type RequestReviewed struct {
+	Request    access.Request `json:"request"`
+	ReviewerID string         `json:"reviewerId"`
+}
+
+func (RequestReviewed) EventType() string {
+	return RequestReviewedType
+}
+
+// RequestBreakGlass is emitted when a user bypasses
+// approval on an access rule using break-glass access.
+// Every approver for the access rule is notified, and the
+// request must be reviewed by an approver afterwards.
+type RequestBreakGlass struct {
+	Request access.Request `json:"request"`
+}
+
+func (RequestBreakGlass) EventType() string {
+	return RequestBreakGlassType
+}
+
+// RequestReminder is emitted when a request hasn't been
+// reviewed within the reminder threshold of the access rule's
+// review SLA. The reviewers are reminded about the request.
+type RequestReminder struct {
+	Request access.Request `json:"request"`
+}
+
+func (RequestReminder) EventType() string {
+	return RequestReminderType
+}
+
+// RequestEscalated is emitted when a request hasn't been
+// reviewed within the escalation threshold of the access rule's
+// review SLA, and the escalation approvers have been added as reviewers.
+type RequestEscalated struct {
+	Request access.Request `json:"request"`
+	// ReviewerIDs are the IDs of the escalation approvers which were added as reviewers.
+	ReviewerIDs []string `json:"reviewerIds"`
+}
+
+func (RequestEscalated) EventType() string {
+	return RequestEscalatedType
+}
+
+// RequestExpired is emitted when a request is closed with the
+// EXPIRED status because it wasn't reviewed within the
+// expiry threshold of the access rule's review SLA.
+type RequestExpired struct {
+	Request access.Request `json:"request"`
+}
+
+func (RequestExpired) EventType() string {
+	return RequestExpiredType
+}
+
 // RequestEventPayload is a payload which is common to
 // all Request events. It is used to conveniently unmarshal
 // the Request payloads in our event handler code.
@@ -9,7 +9,8 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/cfaws"
 )
 
-// EventSender provides methods to submit events to a Granted EventBridge bus.
+// Sender provides methods to submit events to a Granted EventBridge bus.
+// It is the EventBridge implementation of EventBus.
 type Sender struct {
 	client      *eventbridge.Client
 	eventBusArn string
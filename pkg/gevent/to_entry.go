@@ -23,7 +23,7 @@ func ToEntry(e EventTyper, eventBusName string) (types.PutEventsRequestEntry, er
 		EventBusName: &eventBusName,
 		Detail:       aws.String(string(d)),
 		DetailType:   aws.String(e.EventType()),
-		Source:       aws.String("commonfate.io/granted"),
+		Source:       aws.String(Source),
 	}
 
 	return entry, nil
@@ -0,0 +1,114 @@
+package gevent
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/benbjohnson/clock"
+)
+
+const (
+	// SignatureHeader contains the hex-encoded HMAC-SHA256 signature of a webhook request.
+	SignatureHeader = "X-Granted-Signature"
+	// TimestampHeader contains the unix time that a webhook request was signed at.
+	TimestampHeader = "X-Granted-Timestamp"
+)
+
+// WebhookSender sends events as JSON to an HTTP endpoint.
+//
+// Each request is signed with a shared secret, so that the receiver
+// can verify the event was sent by Granted Approvals. See VerifySignature.
+type WebhookSender struct {
+	url    string
+	secret string
+	client *http.Client
+	clock  clock.Clock
+}
+
+type WebhookOpts struct {
+	URL    string
+	Secret string
+	// HTTPClient is optional and defaults to a client with a 10 second timeout.
+	HTTPClient *http.Client
+}
+
+// NewWebhookSender creates a new WebhookSender
+func NewWebhookSender(opts WebhookOpts) (*WebhookSender, error) {
+	if opts.URL == "" {
+		return nil, errors.New("a URL is required for the webhook event bus")
+	}
+	if opts.Secret == "" {
+		return nil, errors.New("a secret is required for the webhook event bus")
+	}
+	client := opts.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookSender{
+		url:    opts.URL,
+		secret: opts.Secret,
+		client: client,
+		clock:  clock.New(),
+	}, nil
+}
+
+func (s *WebhookSender) Put(ctx context.Context, e EventTyper) error {
+	// return early if we don't have an event to send.
+	if e == nil {
+		return nil
+	}
+
+	now := s.clock.Now()
+	evt, err := NewEvent(e, now)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(s.secret, timestamp, body))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("failed to send event to webhook, received status code: %d", res.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the signature for a webhook request body. The timestamp is included in
+// the signature so that receivers can reject requests which are replayed later.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature returns true if the signature matches the timestamp and body of a webhook request.
+func VerifySignature(secret string, timestamp string, body []byte, signature string) bool {
+	want := Sign(secret, timestamp, body)
+	return hmac.Equal([]byte(want), []byte(signature))
+}
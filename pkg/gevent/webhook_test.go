@@ -0,0 +1,70 @@
+package gevent
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSender(t *testing.T) {
+	type testcase struct {
+		name    string
+		status  int
+		wantErr bool
+	}
+
+	testcases := []testcase{
+		{name: "ok", status: http.StatusOK},
+		{name: "receiver error", status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got Event
+			var verified bool
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				verified = VerifySignature("secret", r.Header.Get(TimestampHeader), body, r.Header.Get(SignatureHeader))
+				err = json.Unmarshal(body, &got)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.WriteHeader(tc.status)
+			}))
+			defer ts.Close()
+
+			s, err := NewWebhookSender(WebhookOpts{URL: ts.URL, Secret: "secret"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.Put(context.Background(), testEvent{Data: "testing"})
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.True(t, verified)
+			assert.Equal(t, "event.test", got.Type)
+			assert.Equal(t, Source, got.Source)
+			assert.JSONEq(t, `{"data":"testing"}`, string(got.Detail))
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"type":"event.test"}`)
+	sig := Sign("secret", "1660000000", body)
+
+	assert.True(t, VerifySignature("secret", "1660000000", body, sig))
+	assert.False(t, VerifySignature("other", "1660000000", body, sig))
+	assert.False(t, VerifySignature("secret", "1660000001", body, sig))
+	assert.False(t, VerifySignature("secret", "1660000000", []byte(`{}`), sig))
+}
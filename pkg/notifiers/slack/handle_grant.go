@@ -5,13 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/aws/aws-lambda-go/events"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/storage"
 	"go.uber.org/zap"
 )
 
-func (n *SlackNotifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+func (n *SlackNotifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger, event gevent.Event) error {
 	var grantEvent gevent.GrantEventPayload
 	err := json.Unmarshal(event.Detail, &grantEvent)
 	if err != nil {
@@ -31,7 +30,7 @@ func (n *SlackNotifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLo
 	var msg string
 	var fallback string
 	// get the message text based on the event type
-	switch event.DetailType {
+	switch event.Type {
 	case gevent.GrantActivatedType:
 		msg = fmt.Sprintf("Your access to *%s* is now active.", rq.Result.Name)
 		fallback = fmt.Sprintf("Your access to %s is now active.", rq.Result.Name)
@@ -45,7 +44,7 @@ func (n *SlackNotifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLo
 		msg = fmt.Sprintf("Your access to *%s* has been cancelled by your administrator. Please contact your cloud administrator for more information.", rq.Result.Name)
 		fallback = fmt.Sprintf("Your access to %s has been cancelled by your administrator", rq.Result.Name)
 	default:
-		zap.S().Infow("unhandled grant event", "detailType", event.DetailType)
+		zap.S().Infow("unhandled grant event", "detailType", event.Type)
 	}
 	if msg != "" {
 		_, err = SendMessage(ctx, n.client, gq.Result.Grant.Subject, msg, fallback)
@@ -9,7 +9,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/aws/aws-lambda-go/events"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/providerregistry"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
@@ -25,7 +24,7 @@ import (
 	"go.uber.org/zap"
 )
 
-func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.SugaredLogger, event events.CloudWatchEvent) error {
+func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.SugaredLogger, event gevent.Event) error {
 	var requestEvent gevent.RequestEventPayload
 	err := json.Unmarshal(event.Detail, &requestEvent)
 	if err != nil {
@@ -46,9 +45,15 @@ func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.Sugared
 		return errors.Wrap(err, "getting requestor")
 	}
 
-	switch event.DetailType {
+	switch event.Type {
 	case gevent.RequestCreatedType:
-		if ruleQuery.Result.Approval.IsRequired() {
+		if req.BreakGlass != nil {
+			// the approvers are paged when the break-glass event is handled.
+			msg := fmt.Sprintf(":rotating_light: Your break-glass request to access *%s* has been automatically approved. Every approver has been notified and your access will be reviewed after the fact.", ruleQuery.Result.Name)
+			fallback := fmt.Sprintf("Your break-glass request to access %s has been automatically approved.", ruleQuery.Result.Name)
+			_ = n.SendDMWithLogOnError(ctx, log, req.RequestedBy, msg, fallback)
+		} else if req.Status == access.PENDING {
+			// the request requires approval, either because the rule has approvers or because the rule's policy requires review.
 			msg := fmt.Sprintf("Your request to access *%s* requires approval. We've notified the approvers and will let you know once your request has been reviewed.", ruleQuery.Result.Name)
 			fallback := fmt.Sprintf("Your request to access %s requires approval.", ruleQuery.Result.Name)
 
@@ -57,87 +62,52 @@ func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.Sugared
 				log.Errorw("Failed to send direct message", "email", userQuery.Result.Email, "msg", msg, "error", err)
 			}
 
-			// Notify approvers
-			reviewURL, err := notifiers.ReviewURL(n.FrontendURL, req.ID)
-			if err != nil {
-				return errors.Wrap(err, "building review URL")
-			}
-
-			// get the requestor's Slack user ID if it exists to render it nicely in the message to approvers.
-			var slackUserID string
-			requestor, err := n.client.GetUserByEmailContext(ctx, userQuery.Result.Email)
-			if err != nil {
-				zap.S().Infow("couldn't get slack user from requestor - falling back to email address", "requestor.id", userQuery.Result.ID, zap.Error(err))
-			}
-			if requestor != nil {
-				slackUserID = requestor.ID
-			}
-
-			var wg sync.WaitGroup
-
-			reviewers := storage.ListRequestReviewers{RequestID: req.ID}
-			_, err = n.DB.Query(ctx, &reviewers)
-
+			err = n.messageReviewers(ctx, log, req, rule, userQuery.Result)
 			if err != nil {
-				return errors.Wrap(err, "getting reviewers")
+				return err
 			}
-
-			log.Infow("messaging reviewers", "reviewers", reviewers)
-
-			for _, usr := range reviewers.Result {
-				if usr.ReviewerID == req.RequestedBy {
-					log.Infow("skipping sending approval message to requestor", "user.id", usr)
-					continue
-				}
-
-				wg.Add(1)
-				go func(usr access.Reviewer) {
-					defer wg.Done()
-					approver := storage.GetUser{ID: usr.ReviewerID}
-					_, err := n.DB.Query(ctx, &approver)
-					if err != nil {
-						log.Errorw("failed to fetch user by id while trying to send message in slack", "user.id", usr, zap.Error(err))
-						return
-					}
-					requestArguments, err := n.RenderRequestArguments(ctx, log, req, rule)
-					if err != nil {
-						log.Errorw("failed to generate request arguments, skipping including them in the slack message", "error", err)
-					}
-					summary, msg := BuildRequestMessage(RequestMessageOpts{
-						Request:          req,
-						RequestArguments: requestArguments,
-						Rule:             rule,
-						RequestorSlackID: slackUserID,
-						RequestorEmail:   userQuery.Result.Email,
-						ReviewURLs:       reviewURL,
-					})
-
-					ts, err := SendMessageBlocks(ctx, n.client, approver.Result.Email, msg, summary)
-					if err != nil {
-						log.Errorw("failed to send request approval message", "user", usr, "msg", msg, zap.Error(err))
-					}
-
-					updatedUsr := usr
-					updatedUsr.Notifications = access.Notifications{
-						SlackMessageID: &ts,
-					}
-					log.Infow("updating reviewer with slack msg id", "updatedUsr.SlackMessageID", ts)
-
-					err = n.DB.Put(ctx, &updatedUsr)
-
-					if err != nil {
-						log.Errorw("failed to update reviewer", "user", usr, zap.Error(err))
-					}
-				}(usr)
-			}
-
-			wg.Wait()
 		} else {
 			//Review not required
 			msg := fmt.Sprintf(":white_check_mark: Your request to access *%s* has been automatically approved. Hang tight - we're provisioning the role now and will let you know when it's ready.", ruleQuery.Result.Name)
 			fallback := fmt.Sprintf("Your request to access %s has been automatically approved.", ruleQuery.Result.Name)
 			_ = n.SendDMWithLogOnError(ctx, log, req.RequestedBy, msg, fallback)
 		}
+	case gevent.RequestBreakGlassType:
+		// break-glass requests are reviewed after the fact, so page every approver.
+		err = n.messageReviewers(ctx, log, req, rule, userQuery.Result)
+		if err != nil {
+			return err
+		}
+	case gevent.RequestStageAdvancedType:
+		// a stage of a multi-stage approval is complete, so notify the reviewers for the next stage.
+		err = n.messageReviewers(ctx, log, req, rule, userQuery.Result)
+		if err != nil {
+			return err
+		}
+	case gevent.RequestReviewedType:
+		// the request needs more reviews, so update the messages sent to the reviewers
+		// to show the approval progress.
+		reviewers := storage.ListRequestReviewers{RequestID: req.ID}
+		_, err = n.DB.Query(ctx, &reviewers)
+		if err != nil {
+			return errors.Wrap(err, "getting reviewers")
+		}
+
+		for _, rev := range reviewers.Result {
+			if rev.ApprovalStage != req.ApprovalStage || rev.Notifications.SlackMessageID == nil {
+				continue
+			}
+			err := n.UpdateSlackMessage(ctx, log, UpdateSlackMessageOpts{
+				Review:            rev,
+				Request:           req,
+				RequestReviewerId: requestEvent.ReviewerID,
+				Rule:              rule,
+				DbRequestor:       userQuery.Result,
+			})
+			if err != nil {
+				log.Errorw("failed to update slack message", "user", rev, zap.Error(err))
+			}
+		}
 	case gevent.RequestApprovedType:
 		msg := fmt.Sprintf("Your request to access *%s* has been approved. Hang tight - we're provisioning the access now and will let you know when it's ready.", ruleQuery.Result.Name)
 		fallback := fmt.Sprintf("Your request to access %s has been approved.", ruleQuery.Result.Name)
@@ -186,6 +156,63 @@ func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.Sugared
 				log.Errorw("failed to update slack message", "user", usr, "req", req, zap.Error(err))
 			}
 		}
+	case gevent.RequestReminderType:
+		// remind the reviewers for the stage which the request is waiting on.
+		reviewURL, err := notifiers.ReviewURL(n.FrontendURL, req.ID)
+		if err != nil {
+			return errors.Wrap(err, "building review URL")
+		}
+		reviewers := storage.ListRequestReviewers{RequestID: req.ID}
+		_, err = n.DB.Query(ctx, &reviewers)
+		if err != nil {
+			return errors.Wrap(err, "getting reviewers")
+		}
+		msg := fmt.Sprintf(":alarm_clock: Reminder: the <%s|request from %s to access *%s*> is still waiting on a review.", reviewURL.Review, userQuery.Result.Email, rule.Name)
+		fallback := fmt.Sprintf("Reminder: the request from %s to access %s is still waiting on a review.", userQuery.Result.Email, rule.Name)
+		for _, rev := range reviewers.Result {
+			if req.IsRequestor(rev.ReviewerID) || rev.ApprovalStage != req.ApprovalStage {
+				continue
+			}
+			_ = n.SendDMWithLogOnError(ctx, log, rev.ReviewerID, msg, fallback)
+		}
+	case gevent.RequestEscalatedType:
+		// only the escalation approvers are messaged, as the other reviewers have already been sent the request.
+		var escalated gevent.RequestEscalated
+		err = json.Unmarshal(event.Detail, &escalated)
+		if err != nil {
+			return err
+		}
+		if len(escalated.ReviewerIDs) == 0 {
+			return nil
+		}
+		err = n.messageReviewers(ctx, log, req, rule, userQuery.Result, escalated.ReviewerIDs...)
+		if err != nil {
+			return err
+		}
+	case gevent.RequestExpiredType:
+		msg := fmt.Sprintf("Your request to access *%s* has expired as it wasn't reviewed in time.", ruleQuery.Result.Name)
+		fallback := fmt.Sprintf("Your request to access %s has expired.", ruleQuery.Result.Name)
+		_ = n.SendDMWithLogOnError(ctx, log, req.RequestedBy, msg, fallback)
+
+		reviewers := storage.ListRequestReviewers{RequestID: req.ID}
+		_, err = n.DB.Query(ctx, &reviewers)
+		if err != nil {
+			return errors.Wrap(err, "getting reviewers")
+		}
+		for _, rev := range reviewers.Result {
+			if rev.Notifications.SlackMessageID == nil {
+				continue
+			}
+			err := n.UpdateSlackMessage(ctx, log, UpdateSlackMessageOpts{
+				Review:      rev,
+				Request:     req,
+				Rule:        rule,
+				DbRequestor: userQuery.Result,
+			})
+			if err != nil {
+				log.Errorw("failed to update slack message", "user", rev, zap.Error(err))
+			}
+		}
 	case gevent.RequestDeclinedType:
 		msg := fmt.Sprintf("Your request to access *%s* has been declined.", ruleQuery.Result.Name)
 		fallback := fmt.Sprintf("Your request to access %s has been declined.", ruleQuery.Result.Name)
@@ -217,6 +244,107 @@ func (n *SlackNotifier) HandleRequestEvent(ctx context.Context, log *zap.Sugared
 	return nil
 }
 
+// messageReviewers sends a review request message to the reviewers of the request who are
+// reviewing the stage that the request is waiting on, and stores the Slack message ID on the Reviewer.
+//
+// If onlyReviewerIDs is not empty, only those reviewers are messaged.
+func (n *SlackNotifier) messageReviewers(ctx context.Context, log *zap.SugaredLogger, req access.Request, rule rule.AccessRule, requestor *identity.User, onlyReviewerIDs ...string) error {
+	reviewURL, err := notifiers.ReviewURL(n.FrontendURL, req.ID)
+	if err != nil {
+		return errors.Wrap(err, "building review URL")
+	}
+
+	// get the requestor's Slack user ID if it exists to render it nicely in the message to approvers.
+	var slackUserID string
+	slackRequestor, err := n.client.GetUserByEmailContext(ctx, requestor.Email)
+	if err != nil {
+		zap.S().Infow("couldn't get slack user from requestor - falling back to email address", "requestor.id", requestor.ID, zap.Error(err))
+	}
+	if slackRequestor != nil {
+		slackUserID = slackRequestor.ID
+	}
+
+	var wg sync.WaitGroup
+
+	reviewers := storage.ListRequestReviewers{RequestID: req.ID}
+	_, err = n.DB.Query(ctx, &reviewers)
+
+	if err != nil {
+		return errors.Wrap(err, "getting reviewers")
+	}
+
+	log.Infow("messaging reviewers", "reviewers", reviewers)
+
+	for _, usr := range reviewers.Result {
+		if req.IsRequestor(usr.ReviewerID) {
+			log.Infow("skipping sending approval message to requestor", "user.id", usr)
+			continue
+		}
+		// in multi-stage approvals, only the reviewers for the current stage are notified.
+		if usr.ApprovalStage != req.ApprovalStage {
+			continue
+		}
+		if len(onlyReviewerIDs) > 0 && !contains(onlyReviewerIDs, usr.ReviewerID) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(usr access.Reviewer) {
+			defer wg.Done()
+			approver := storage.GetUser{ID: usr.ReviewerID}
+			_, err := n.DB.Query(ctx, &approver)
+			if err != nil {
+				log.Errorw("failed to fetch user by id while trying to send message in slack", "user.id", usr, zap.Error(err))
+				return
+			}
+			requestArguments, err := n.RenderRequestArguments(ctx, log, req, rule)
+			if err != nil {
+				log.Errorw("failed to generate request arguments, skipping including them in the slack message", "error", err)
+			}
+			// delegates are told which approvers they are reviewing the request on behalf of.
+			var onBehalfOf []string
+			for _, id := range usr.DelegatedBy {
+				delegator := storage.GetUser{ID: id}
+				_, err := n.DB.Query(ctx, &delegator)
+				if err != nil {
+					log.Errorw("failed to fetch delegating approver while trying to send message in slack", "user.id", id, zap.Error(err))
+					continue
+				}
+				onBehalfOf = append(onBehalfOf, delegator.Result.Email)
+			}
+			summary, msg := BuildRequestMessage(RequestMessageOpts{
+				Request:          req,
+				RequestArguments: requestArguments,
+				Rule:             rule,
+				RequestorSlackID: slackUserID,
+				RequestorEmail:   requestor.Email,
+				ReviewURLs:       reviewURL,
+				OnBehalfOf:       onBehalfOf,
+			})
+
+			ts, err := SendMessageBlocks(ctx, n.client, approver.Result.Email, msg, summary)
+			if err != nil {
+				log.Errorw("failed to send request approval message", "user", usr, "msg", msg, zap.Error(err))
+			}
+
+			updatedUsr := usr
+			updatedUsr.Notifications = access.Notifications{
+				SlackMessageID: &ts,
+			}
+			log.Infow("updating reviewer with slack msg id", "updatedUsr.SlackMessageID", ts)
+
+			err = n.DB.Put(ctx, &updatedUsr)
+
+			if err != nil {
+				log.Errorw("failed to update reviewer", "user", usr, zap.Error(err))
+			}
+		}(usr)
+	}
+
+	wg.Wait()
+	return nil
+}
+
 type UpdateSlackMessageOpts struct {
 	Review            access.Reviewer
 	Request           access.Request
@@ -238,7 +366,8 @@ func (n *SlackNotifier) UpdateSlackMessage(ctx context.Context, log *zap.Sugared
 	// do the same but for the request reveiwer
 	reqReviewer := storage.GetUser{ID: opts.RequestReviewerId}
 	_, err = n.DB.Query(ctx, &reqReviewer)
-	if err != nil && opts.Request.Status != access.CANCELLED {
+	// cancelled and expired requests weren't reviewed, so there may not be a request reviewer.
+	if err != nil && opts.Request.Status != access.CANCELLED && opts.Request.Status != access.EXPIRED {
 		return errors.Wrap(err, "getting reviewer 2")
 	}
 
@@ -290,6 +419,8 @@ type RequestMessageOpts struct {
 	RequestorEmail   string
 	Reviewer         *identity.User
 	RequestReviewer  *identity.User
+	// OnBehalfOf are the emails of the approvers who delegated their review to the recipient of the message.
+	OnBehalfOf []string
 }
 
 func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Message) {
@@ -299,6 +430,11 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 	}
 
 	summary = fmt.Sprintf("New request for %s from %s", o.Rule.Name, o.RequestorEmail)
+	title := fmt.Sprintf("*<%s|New request for %s> from %s*", o.ReviewURLs.Review, o.Rule.Name, requestor)
+	if o.Request.BreakGlass != nil {
+		summary = fmt.Sprintf("Break-glass access to %s by %s", o.Rule.Name, o.RequestorEmail)
+		title = fmt.Sprintf(":rotating_light: *<%s|Break-glass access to %s> by %s*", o.ReviewURLs.Review, o.Rule.Name, requestor)
+	}
 
 	when := "ASAP"
 	if o.Request.RequestedTiming.StartTime != nil {
@@ -308,6 +444,9 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 
 	status := strings.ToLower(string(o.Request.Status))
 	status = strings.ToUpper(string(status[0])) + status[1:]
+	if o.Request.Status == access.PENDING && o.Request.ApprovalProgress != nil {
+		status = fmt.Sprintf("%s (%s)", status, o.Request.ApprovalProgress)
+	}
 
 	requestDetails := []*slack.TextBlockObject{
 		{
@@ -331,6 +470,13 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 		})
 	}
 
+	if o.Request.BreakGlass != nil {
+		requestDetails = append(requestDetails, &slack.TextBlockObject{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*Break-glass Justification:*\n%s", o.Request.BreakGlass.Justification),
+		})
+	}
+
 	// Only show the Request reason if it is not empty
 	if o.Request.Data.Reason != nil && len(*o.Request.Data.Reason) > 0 {
 		requestDetails = append(requestDetails, &slack.TextBlockObject{
@@ -344,7 +490,7 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 			Type: slack.MBTSection,
 			Text: &slack.TextBlockObject{
 				Type: slack.MarkdownType,
-				Text: fmt.Sprintf("*<%s|New request for %s> from %s*", o.ReviewURLs.Review, o.Rule.Name, requestor),
+				Text: title,
 			},
 		},
 		slack.SectionBlock{
@@ -353,14 +499,51 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 		},
 	)
 
-	if o.Reviewer != nil || o.Request.Status == access.CANCELLED {
+	// form data is shown in its own section, as Slack limits the number of fields in a section.
+	if len(o.Request.Data.FormData) > 0 {
+		var lines []string
+		for _, v := range o.Request.Data.FormData {
+			lines = append(lines, fmt.Sprintf("*%s:* %s", v.Label, v.Value))
+		}
+		msg.Blocks.BlockSet = append(msg.Blocks.BlockSet, slack.SectionBlock{
+			Type: slack.MBTSection,
+			Text: &slack.TextBlockObject{
+				Type: slack.MarkdownType,
+				Text: strings.Join(lines, "\n"),
+			},
+		})
+	}
+
+	if t := o.Request.Data.Ticket; t != nil {
+		msg.Blocks.BlockSet = append(msg.Blocks.BlockSet, slack.SectionBlock{
+			Type: slack.MBTSection,
+			Text: &slack.TextBlockObject{
+				Type: slack.MarkdownType,
+				Text: fmt.Sprintf("*Ticket:* <%s|%s> %s (%s)", t.URL, t.ID, t.Summary, t.Status),
+			},
+		})
+	}
+
+	if len(o.OnBehalfOf) > 0 {
+		delegationContextBlock := slack.NewContextBlock("", slack.TextBlockObject{
+			Type: slack.MarkdownType,
+			Text: fmt.Sprintf("You're reviewing this request on behalf of %s", strings.Join(o.OnBehalfOf, ", ")),
+		})
+		msg.Blocks.BlockSet = append(msg.Blocks.BlockSet, delegationContextBlock)
+	}
+
+	if o.Reviewer != nil || o.Request.Status == access.CANCELLED || o.Request.Status == access.EXPIRED {
 		t := time.Now()
 		when = fmt.Sprintf("<!date^%d^{date_short_pretty} at {time}|%s>", t.Unix(), t.String())
 
-		text := fmt.Sprintf("*Reviewed by* %s at %s", o.RequestReviewer.Email, when)
-
-		if o.Request.Status == access.CANCELLED {
+		var text string
+		switch o.Request.Status {
+		case access.CANCELLED:
 			text = fmt.Sprintf("*Cancelled by* %s at %s", o.RequestorEmail, when)
+		case access.EXPIRED:
+			text = fmt.Sprintf("*Expired* at %s as it wasn't reviewed in time", when)
+		default:
+			text = fmt.Sprintf("*Reviewed by* %s at %s", o.RequestReviewer.Email, when)
 		}
 
 		reviewContextBlock := slack.NewContextBlock("", slack.TextBlockObject{
@@ -371,27 +554,42 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 		msg.Blocks.BlockSet = append(msg.Blocks.BlockSet, reviewContextBlock)
 	}
 
-	// If the request has just been sent (PENDING), then append Action Blocks
-	if o.Request.Status == access.PENDING {
-		msg.Blocks.BlockSet = append(msg.Blocks.BlockSet, slack.NewActionBlock("review_actions",
+	// If the request has just been sent (PENDING), or is a break-glass request waiting on review, then append Action Blocks
+	if o.Request.Status == access.PENDING || o.Request.IsBreakGlassReviewPending() {
+		actions := []slack.BlockElement{
 			slack.ButtonBlockElement{
 				Type:     slack.METButton,
 				Text:     &slack.TextBlockObject{Type: slack.PlainTextType, Text: "Approve"},
 				Style:    slack.StylePrimary,
-				ActionID: "approve",
-				Value:    "approve",
-				URL:      o.ReviewURLs.Approve,
+				ActionID: ActionApprove,
+				Value:    o.Request.ID,
 			},
 			slack.ButtonBlockElement{
 				Type:     slack.METButton,
-				Text:     &slack.TextBlockObject{Type: slack.PlainTextType, Text: "Close Request"},
+				Text:     &slack.TextBlockObject{Type: slack.PlainTextType, Text: "Decline"},
 				Style:    slack.StyleDanger,
-				ActionID: "deny",
-				Value:    "deny",
-				URL:      o.ReviewURLs.Deny,
+				ActionID: ActionDecline,
+				Value:    o.Request.ID,
 			},
-		))
-
+		}
+		// break-glass access has already been granted, so the duration can't be shortened.
+		if o.Request.Status == access.PENDING {
+			options := shorterDurationOptions(o.Request, o.Rule)
+			if len(options) > 0 {
+				actions = append(actions, slack.NewOptionsSelectBlockElement(slack.OptTypeStatic,
+					&slack.TextBlockObject{Type: slack.PlainTextType, Text: "Approve for..."},
+					ActionApproveDuration,
+					options...,
+				))
+			}
+		}
+		actions = append(actions, slack.ButtonBlockElement{
+			Type:     slack.METButton,
+			Text:     &slack.TextBlockObject{Type: slack.PlainTextType, Text: "View Request"},
+			ActionID: "view",
+			URL:      o.ReviewURLs.Review,
+		})
+		msg.Blocks.BlockSet = append(msg.Blocks.BlockSet, slack.NewActionBlock("review_actions", actions...))
 	}
 
 	return summary, msg
@@ -466,3 +664,12 @@ func (n *SlackNotifier) RenderRequestArguments(ctx context.Context, log *zap.Sug
 	})
 	return labelArr, nil
 }
+
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
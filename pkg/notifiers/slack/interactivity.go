@@ -0,0 +1,272 @@
+package slacknotifier
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/accesssvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+// Action IDs for the review buttons in the messages sent to reviewers.
+const (
+	ActionApprove = "approve"
+	ActionDecline = "decline"
+	// ActionApproveDuration approves a request for a shorter duration than was requested.
+	// The value of the selected option is the request ID and the duration in seconds, separated by a colon.
+	ActionApproveDuration = "approve_duration"
+)
+
+// approveDurations are the durations offered to reviewers who want to approve a request for a shorter duration.
+var approveDurations = []time.Duration{
+	15 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+	2 * time.Hour,
+	4 * time.Hour,
+	8 * time.Hour,
+}
+
+// Reviewer reviews access requests. It is implemented by accesssvc.Service.
+type Reviewer interface {
+	AddReviewAndGrantAccess(ctx context.Context, opts accesssvc.AddReviewOpts) (*accesssvc.AddReviewResult, error)
+}
+
+type HandleInteractionOpts struct {
+	Reviewer Reviewer
+	// AdminGroup is the ID of the administrators group. Administrators can review any request.
+	AdminGroup string
+	Callback   slack.InteractionCallback
+}
+
+// reviewAction is a review submitted by pressing a button in a Slack message.
+type reviewAction struct {
+	RequestID string
+	Decision  access.Decision
+	// Duration is set if the reviewer approved the request for a shorter duration.
+	Duration *time.Duration
+}
+
+// HandleInteraction reviews a request when a reviewer presses one of the review buttons in
+// a Slack message. Once the request has been reviewed, the messages sent to every reviewer are updated.
+//
+// Reviewing a request can grant access, which regularly takes longer than the 3 seconds Slack allows
+// for acknowledging an interaction, so HandleInteraction should be called after the interaction has been acknowledged.
+// Errors which the reviewer can act on, such as the request having already been reviewed,
+// are sent to the reviewer as an ephemeral message through the response URL of the interaction rather than being returned.
+func (n *SlackNotifier) HandleInteraction(ctx context.Context, log *zap.SugaredLogger, opts HandleInteractionOpts) error {
+	cb := opts.Callback
+	if cb.Type != slack.InteractionTypeBlockActions {
+		log.Infow("ignoring unhandled interaction type", "type", cb.Type)
+		return nil
+	}
+	var action *reviewAction
+	for _, ba := range cb.ActionCallback.BlockActions {
+		a, ok, err := parseReviewAction(ba)
+		if err != nil {
+			return err
+		}
+		if ok {
+			action = &a
+			break
+		}
+	}
+	if action == nil {
+		// buttons which link to the web dashboard also send an interaction, which we can ignore.
+		return nil
+	}
+	log = log.With("request.id", action.RequestID, "decision", action.Decision, "slack.user.id", cb.User.ID)
+
+	slackUser, err := n.client.GetUserInfoContext(ctx, cb.User.ID)
+	if err != nil {
+		return errors.Wrap(err, "getting slack user")
+	}
+	userq := storage.GetUserByEmail{Email: slackUser.Profile.Email}
+	_, err = n.DB.Query(ctx, &userq)
+	if err == ddb.ErrNoItems {
+		n.replyEphemeral(ctx, log, cb, fmt.Sprintf("We couldn't find a Granted Approvals user with the email %s.", slackUser.Profile.Email))
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "getting user")
+	}
+	user := userq.Result
+
+	reqq := storage.GetRequest{ID: action.RequestID}
+	_, err = n.DB.Query(ctx, &reqq)
+	if err != nil {
+		return errors.Wrap(err, "getting request")
+	}
+	req := *reqq.Result
+
+	ruleq := storage.GetAccessRuleCurrent{ID: req.Rule}
+	_, err = n.DB.Query(ctx, &ruleq)
+	if err != nil {
+		return errors.Wrap(err, "getting access rule")
+	}
+
+	reviewers := storage.ListRequestReviewers{RequestID: req.ID}
+	_, err = n.DB.Query(ctx, &reviewers)
+	if err != nil {
+		return errors.Wrap(err, "getting reviewers")
+	}
+
+	var overrideTiming *access.Timing
+	if action.Duration != nil {
+		overrideTiming = &access.Timing{
+			Duration:  *action.Duration,
+			StartTime: req.RequestedTiming.StartTime,
+		}
+	}
+
+	result, err := opts.Reviewer.AddReviewAndGrantAccess(ctx, accesssvc.AddReviewOpts{
+		ReviewerID:      user.ID,
+		ReviewerIsAdmin: user.BelongsToGroup(opts.AdminGroup),
+		Reviewers:       reviewers.Result,
+		Decision:        action.Decision,
+		OverrideTiming:  overrideTiming,
+		Request:         req,
+		AccessRule:      *ruleq.Result,
+	})
+	if err != nil {
+		msg, ok := reviewErrorMessage(err)
+		if !ok {
+			return err
+		}
+		log.Infow("review was not added", zap.Error(err))
+		n.replyEphemeral(ctx, log, cb, msg)
+		return nil
+	}
+	log.Infow("added review from slack")
+
+	// reviewing the request can add reviewers, such as the approvers of the next stage, so reload them.
+	reviewers = storage.ListRequestReviewers{RequestID: req.ID}
+	_, err = n.DB.Query(ctx, &reviewers)
+	if err != nil {
+		return errors.Wrap(err, "getting reviewers")
+	}
+
+	requestor := storage.GetUser{ID: req.RequestedBy}
+	_, err = n.DB.Query(ctx, &requestor)
+	if err != nil {
+		return errors.Wrap(err, "getting requestor")
+	}
+	n.updateReviewerMessages(ctx, log, result.Request, *ruleq.Result, reviewers.Result, user.ID, requestor.Result)
+	return nil
+}
+
+// updateReviewerMessages updates the messages sent to every reviewer of the request to show the review.
+func (n *SlackNotifier) updateReviewerMessages(ctx context.Context, log *zap.SugaredLogger, req access.Request, rule rule.AccessRule, reviewers []access.Reviewer, reviewerID string, requestor *identity.User) {
+	for _, rev := range reviewers {
+		if rev.Notifications.SlackMessageID == nil {
+			continue
+		}
+		err := n.UpdateSlackMessage(ctx, log, UpdateSlackMessageOpts{
+			Review:            rev,
+			Request:           req,
+			RequestReviewerId: reviewerID,
+			Rule:              rule,
+			DbRequestor:       requestor,
+		})
+		if err != nil {
+			log.Errorw("failed to update slack message", "user", rev, zap.Error(err))
+		}
+	}
+}
+
+// replyEphemeral sends a message which only the user who interacted with the message can see.
+func (n *SlackNotifier) replyEphemeral(ctx context.Context, log *zap.SugaredLogger, cb slack.InteractionCallback, msg string) {
+	err := slack.PostWebhookContext(ctx, cb.ResponseURL, &slack.WebhookMessage{
+		Text:         msg,
+		ResponseType: slack.ResponseTypeEphemeral,
+	})
+	if err != nil {
+		log.Errorw("failed to send ephemeral message", "msg", msg, zap.Error(err))
+	}
+}
+
+// reviewErrorMessage returns a message to show the reviewer if the review couldn't be added
+// because of something the reviewer can act on.
+func reviewErrorMessage(err error) (string, bool) {
+	var invalidStatus accesssvc.InvalidStatusError
+	var apiErr *apio.APIError
+	switch {
+	case err == accesssvc.ErrUserNotAuthorized:
+		return "You are not a reviewer of this request.", true
+	case err == accesssvc.ErrRequestAlreadyReviewed:
+		return "You have already reviewed this request.", true
+	case err == accesssvc.ErrRequestOverlapsExistingGrant:
+		return "This request overlaps an existing grant, so it can't be approved.", true
+	case errors.As(err, &invalidStatus):
+		return fmt.Sprintf("This request can't be reviewed because it is %s.", strings.ToLower(string(invalidStatus.Status))), true
+	case errors.As(err, &apiErr) && len(apiErr.Fields) > 0:
+		// validation errors, such as the duration being outside the time constraints of the access rule.
+		return fmt.Sprintf("This request can't be reviewed: %s", apiErr.Fields[0].Error), true
+	}
+	return "", false
+}
+
+// parseReviewAction returns the review for a block action. If the action isn't
+// one of the review actions, ok is false.
+func parseReviewAction(ba *slack.BlockAction) (a reviewAction, ok bool, err error) {
+	switch ba.ActionID {
+	case ActionApprove:
+		return reviewAction{RequestID: ba.Value, Decision: access.DecisionApproved}, true, nil
+	case ActionDecline:
+		return reviewAction{RequestID: ba.Value, Decision: access.DecisionDECLINED}, true, nil
+	case ActionApproveDuration:
+		requestID, seconds, found := strings.Cut(ba.SelectedOption.Value, ":")
+		if !found {
+			return reviewAction{}, false, fmt.Errorf("invalid %s value: %s", ActionApproveDuration, ba.SelectedOption.Value)
+		}
+		s, err := strconv.Atoi(seconds)
+		if err != nil {
+			return reviewAction{}, false, errors.Wrapf(err, "parsing %s duration", ActionApproveDuration)
+		}
+		d := time.Duration(s) * time.Second
+		return reviewAction{RequestID: requestID, Decision: access.DecisionApproved, Duration: &d}, true, nil
+	}
+	return reviewAction{}, false, nil
+}
+
+// shorterDurationOptions returns the options for approving a request for less than the requested duration.
+// Durations shorter than the minimum duration of the access rule are not offered.
+func shorterDurationOptions(req access.Request, rule rule.AccessRule) []*slack.OptionBlockObject {
+	var min time.Duration
+	if rule.TimeConstraints.MinDurationSeconds != nil {
+		min = time.Duration(*rule.TimeConstraints.MinDurationSeconds) * time.Second
+	}
+	var options []*slack.OptionBlockObject
+	for _, d := range approveDurations {
+		if d >= req.RequestedTiming.Duration || d < min {
+			continue
+		}
+		value := fmt.Sprintf("%s:%d", req.ID, int(d.Seconds()))
+		text := &slack.TextBlockObject{Type: slack.PlainTextType, Text: "Approve for " + shortDuration(d)}
+		options = append(options, slack.NewOptionBlockObject(value, text, nil))
+	}
+	return options
+}
+
+// shortDuration formats a duration without trailing zero units, such as "30m" or "2h".
+func shortDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+	return s
+}
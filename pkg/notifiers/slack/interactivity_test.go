@@ -0,0 +1,181 @@
+package slacknotifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/accesssvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type testReviewer struct {
+	got *accesssvc.AddReviewOpts
+	err error
+}
+
+func (r *testReviewer) AddReviewAndGrantAccess(ctx context.Context, opts accesssvc.AddReviewOpts) (*accesssvc.AddReviewResult, error) {
+	r.got = &opts
+	if r.err != nil {
+		return nil, r.err
+	}
+	req := opts.Request
+	req.Status = access.APPROVED
+	return &accesssvc.AddReviewResult{Request: req}, nil
+}
+
+// testSlackAPI is a fake Slack API which records the methods called.
+type testSlackAPI struct {
+	mu      sync.Mutex
+	methods []string
+}
+
+func (s *testSlackAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.methods = append(s.methods, r.URL.Path)
+	s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"ok":      true,
+		"user":    map[string]interface{}{"id": "U1", "profile": map[string]string{"email": "reviewer@acme.com"}},
+		"channel": map[string]string{"id": "D1"},
+	})
+}
+
+func TestHandleInteraction(t *testing.T) {
+	type testcase struct {
+		name           string
+		action         slack.BlockAction
+		userErr        error
+		reviewErr      error
+		wantDecision   access.Decision
+		wantOverride   *access.Timing
+		wantReviewed   bool
+		wantSlackCalls []string
+	}
+
+	reviewMessageID := "1234.5678"
+	req := access.Request{
+		ID:              "req_123",
+		RequestedBy:     "requestor",
+		Rule:            "rul_123",
+		Status:          access.PENDING,
+		RequestedTiming: access.Timing{Duration: 2 * time.Hour},
+	}
+
+	testcases := []testcase{
+		{
+			name:           "approve",
+			action:         slack.BlockAction{ActionID: ActionApprove, Value: req.ID},
+			wantDecision:   access.DecisionApproved,
+			wantReviewed:   true,
+			wantSlackCalls: []string{"/users.info", "/users.lookupByEmail", "/users.lookupByEmail", "/conversations.open", "/chat.update"},
+		},
+		{
+			name:           "decline",
+			action:         slack.BlockAction{ActionID: ActionDecline, Value: req.ID},
+			wantDecision:   access.DecisionDECLINED,
+			wantReviewed:   true,
+			wantSlackCalls: []string{"/users.info", "/users.lookupByEmail", "/users.lookupByEmail", "/conversations.open", "/chat.update"},
+		},
+		{
+			name:           "approve with shorter duration",
+			action:         slack.BlockAction{ActionID: ActionApproveDuration, SelectedOption: slack.OptionBlockObject{Value: req.ID + ":1800"}},
+			wantDecision:   access.DecisionApproved,
+			wantOverride:   &access.Timing{Duration: 30 * time.Minute},
+			wantReviewed:   true,
+			wantSlackCalls: []string{"/users.info", "/users.lookupByEmail", "/users.lookupByEmail", "/conversations.open", "/chat.update"},
+		},
+		{
+			name:           "already reviewed",
+			action:         slack.BlockAction{ActionID: ActionApprove, Value: req.ID},
+			reviewErr:      accesssvc.ErrRequestAlreadyReviewed,
+			wantDecision:   access.DecisionApproved,
+			wantReviewed:   true,
+			wantSlackCalls: []string{"/users.info", "/response"},
+		},
+		{
+			name:           "user not found",
+			action:         slack.BlockAction{ActionID: ActionApprove, Value: req.ID},
+			userErr:        ddb.ErrNoItems,
+			wantSlackCalls: []string{"/users.info", "/response"},
+		},
+		{
+			name:   "view button is ignored",
+			action: slack.BlockAction{ActionID: "view"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := &testSlackAPI{}
+			ts := httptest.NewServer(api)
+			defer ts.Close()
+
+			db := ddbmock.New(t)
+			db.MockQueryWithErr(&storage.GetUserByEmail{Result: &identity.User{ID: "reviewer", Email: "reviewer@acme.com"}}, tc.userErr)
+			db.MockQuery(&storage.GetUser{Result: &identity.User{ID: "requestor", Email: "requestor@acme.com"}})
+			db.MockQuery(&storage.GetRequest{Result: &req})
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: &rule.AccessRule{ID: "rul_123", TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 7200}}})
+			db.MockQuery(&storage.ListRequestReviewers{Result: []access.Reviewer{
+				{ReviewerID: "reviewer", Notifications: access.Notifications{SlackMessageID: &reviewMessageID}},
+				{ReviewerID: "other"},
+			}})
+			db.MockQuery(&storage.ListCachedProviderOptions{})
+
+			n := SlackNotifier{DB: db, FrontendURL: "https://example.com", client: slack.New("token", slack.OptionAPIURL(ts.URL+"/"))}
+			reviewer := &testReviewer{err: tc.reviewErr}
+			a := tc.action
+			err := n.HandleInteraction(context.Background(), zap.S(), HandleInteractionOpts{
+				Reviewer: reviewer,
+				Callback: slack.InteractionCallback{
+					Type:           slack.InteractionTypeBlockActions,
+					User:           slack.User{ID: "U1"},
+					ResponseURL:    ts.URL + "/response",
+					ActionCallback: slack.ActionCallbacks{BlockActions: []*slack.BlockAction{&a}},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantReviewed, reviewer.got != nil)
+			if reviewer.got != nil {
+				assert.Equal(t, "reviewer", reviewer.got.ReviewerID)
+				assert.Equal(t, tc.wantDecision, reviewer.got.Decision)
+				assert.Equal(t, tc.wantOverride, reviewer.got.OverrideTiming)
+			}
+			assert.Equal(t, tc.wantSlackCalls, api.methods)
+		})
+	}
+}
+
+func TestShorterDurationOptions(t *testing.T) {
+	min := 1800
+	req := access.Request{ID: "req_123", RequestedTiming: access.Timing{Duration: 3 * time.Hour}}
+	r := rule.AccessRule{TimeConstraints: types.TimeConstraints{MinDurationSeconds: &min}}
+
+	var got []string
+	for _, o := range shorterDurationOptions(req, r) {
+		got = append(got, o.Value+" "+o.Text.Text)
+	}
+	want := []string{
+		"req_123:1800 Approve for 30m",
+		"req_123:3600 Approve for 1h",
+		"req_123:7200 Approve for 2h",
+	}
+	assert.Equal(t, want, got)
+}
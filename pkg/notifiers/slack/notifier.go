@@ -4,9 +4,9 @@ import (
 	"context"
 	"strings"
 
-	"github.com/aws/aws-lambda-go/events"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/pkg/errors"
 	"github.com/slack-go/slack"
 	"go.uber.org/zap"
@@ -16,10 +16,11 @@ const NotificationsTypeSlack = "slack"
 
 // Notifier provides handler methods for sending notifications to slack based on events
 type SlackNotifier struct {
-	DB          ddb.Storage
-	FrontendURL string
-	client      *slack.Client
-	apiToken    gconfig.SecretStringValue
+	DB            ddb.Storage
+	FrontendURL   string
+	client        *slack.Client
+	apiToken      gconfig.SecretStringValue
+	signingSecret gconfig.SecretStringValue
 }
 
 func (s *SlackNotifier) Config() gconfig.Config {
@@ -28,6 +29,20 @@ func (s *SlackNotifier) Config() gconfig.Config {
 	}
 }
 
+// InteractivityConfig is the configuration required to handle interactive messages, such as
+// reviewers approving requests from Slack. It is separate to Config so that deployments
+// which were configured before interactivity was supported continue to send notifications.
+func (s *SlackNotifier) InteractivityConfig() gconfig.Config {
+	return gconfig.Config{
+		gconfig.SecretStringField("signingSecret", &s.signingSecret, "the Slack app signing secret", gconfig.WithNoArgs("/granted/secrets/notifications/slack/signingSecret")),
+	}
+}
+
+// SigningSecret returns the secret used to verify requests sent by Slack.
+func (s *SlackNotifier) SigningSecret() string {
+	return s.signingSecret.Get()
+}
+
 func (s *SlackNotifier) Init(ctx context.Context) error {
 	s.client = slack.New(s.apiToken.Get())
 	return nil
@@ -41,17 +56,17 @@ func (s *SlackNotifier) TestConfig(ctx context.Context) error {
 	return nil
 }
 
-func (n *SlackNotifier) HandleEvent(ctx context.Context, event events.CloudWatchEvent) (err error) {
+func (n *SlackNotifier) HandleEvent(ctx context.Context, event gevent.Event) (err error) {
 	log := zap.S()
 
 	log.Infow("received event", "event", event)
 
-	if strings.HasPrefix(event.DetailType, "grant") {
+	if strings.HasPrefix(event.Type, "grant") {
 		err = n.HandleGrantEvent(ctx, log, event)
 		if err != nil {
 			return err
 		}
-	} else if strings.HasPrefix(event.DetailType, "request") {
+	} else if strings.HasPrefix(event.Type, "request") {
 		err = n.HandleRequestEvent(ctx, log, event)
 		if err != nil {
 			return err
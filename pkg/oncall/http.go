@@ -0,0 +1,40 @@
+package oncall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// getJSON makes a GET request to an on-call source and decodes the JSON response into dest.
+func getJSON(ctx context.Context, client *http.Client, url string, header http.Header, dest interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header = header
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		// include the start of the body, which usually contains the error message from the API.
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("request to %s failed with status %d: %s", req.URL.Path, res.StatusCode, string(b))
+	}
+	return json.NewDecoder(res.Body).Decode(dest)
+}
+
+// appendUnique appends s to set if it isn't already present.
+func appendUnique(set []string, s string) []string {
+	for _, v := range set {
+		if v == s {
+			return set
+		}
+	}
+	return append(set, s)
+}
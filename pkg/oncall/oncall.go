@@ -0,0 +1,74 @@
+// Package oncall looks up the users who are currently on call in scheduling tools like PagerDuty and Opsgenie.
+package oncall
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+)
+
+// Source is an on-call scheduling tool.
+type Source interface {
+	// OnCallEmails returns the email addresses of the users who are currently on call for a schedule.
+	OnCallEmails(ctx context.Context, scheduleID string) ([]string, error)
+	gconfig.Configer
+	gconfig.Initer
+}
+
+// defaultTimeout is used for requests to on-call sources, which are made while an access request is being created.
+const defaultTimeout = 10 * time.Second
+
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: defaultTimeout}
+}
+
+// SourceNotConfiguredError is returned when looking up a schedule for a source
+// which isn't included in the on-call configuration of the deployment.
+type SourceNotConfiguredError struct {
+	Source string
+}
+
+func (e SourceNotConfiguredError) Error() string {
+	return fmt.Sprintf("on-call source %s is not configured", e.Source)
+}
+
+// Resolver looks up on-call users in the sources which are configured for the deployment.
+type Resolver struct {
+	sources map[string]Source
+}
+
+// NewResolver loads and initialises each source in the on-call configuration.
+// The configuration is keyed by the source type, such as "pagerduty".
+func NewResolver(ctx context.Context, config deploy.FeatureMap) (*Resolver, error) {
+	r := Resolver{sources: make(map[string]Source)}
+	for sourceType, values := range config {
+		registered, err := Registry().Lookup(sourceType)
+		if err != nil {
+			return nil, err
+		}
+		s := registered.Source
+		err = s.Config().Load(ctx, &gconfig.MapLoader{Values: values})
+		if err != nil {
+			return nil, err
+		}
+		err = s.Init(ctx)
+		if err != nil {
+			return nil, err
+		}
+		r.sources[sourceType] = s
+	}
+	return &r, nil
+}
+
+// OnCallEmails returns the email addresses of the users who are currently on call for a schedule in a source.
+func (r *Resolver) OnCallEmails(ctx context.Context, source string, scheduleID string) ([]string, error) {
+	s, ok := r.sources[source]
+	if !ok {
+		return nil, SourceNotConfiguredError{Source: source}
+	}
+	return s.OnCallEmails(ctx, scheduleID)
+}
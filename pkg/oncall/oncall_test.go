@@ -0,0 +1,78 @@
+package oncall
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagerDutyOnCallEmails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oncalls", r.URL.Path)
+		assert.Equal(t, "Token token=secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "P123", r.URL.Query().Get("schedule_ids[]"))
+		assert.Equal(t, "users", r.URL.Query().Get("include[]"))
+		_, _ = w.Write([]byte(`{"oncalls":[
+			{"user":{"id":"U1","email":"alice@acme.com"},"escalation_level":1},
+			{"user":{"id":"U1","email":"alice@acme.com"},"escalation_level":2},
+			{"user":{"id":"U2","email":"bob@acme.com"},"escalation_level":2}
+		]}`))
+	}))
+	defer srv.Close()
+
+	r, err := NewResolver(context.Background(), deploy.FeatureMap{
+		SourceTypePagerDuty: {"apiToken": "secret", "apiUrl": srv.URL},
+	})
+	assert.NoError(t, err)
+
+	got, err := r.OnCallEmails(context.Background(), SourceTypePagerDuty, "P123")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice@acme.com", "bob@acme.com"}, got)
+}
+
+func TestOpsgenieOnCallEmails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/schedules/sched-1/on-calls", r.URL.Path)
+		assert.Equal(t, "GenieKey secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "true", r.URL.Query().Get("flat"))
+		_, _ = w.Write([]byte(`{"data":{"_parent":{"id":"sched-1"},"onCallRecipients":["alice@acme.com"]}}`))
+	}))
+	defer srv.Close()
+
+	r, err := NewResolver(context.Background(), deploy.FeatureMap{
+		SourceTypeOpsgenie: {"apiKey": "secret", "apiUrl": srv.URL + "/"},
+	})
+	assert.NoError(t, err)
+
+	got, err := r.OnCallEmails(context.Background(), SourceTypeOpsgenie, "sched-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice@acme.com"}, got)
+}
+
+func TestOnCallEmailsErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":{"message":"Unauthorized"}}`))
+	}))
+	defer srv.Close()
+
+	r, err := NewResolver(context.Background(), deploy.FeatureMap{
+		SourceTypePagerDuty: {"apiToken": "wrong", "apiUrl": srv.URL},
+	})
+	assert.NoError(t, err)
+
+	_, err = r.OnCallEmails(context.Background(), SourceTypePagerDuty, "P123")
+	assert.ErrorContains(t, err, "401")
+
+	_, err = r.OnCallEmails(context.Background(), SourceTypeOpsgenie, "sched-1")
+	assert.Equal(t, SourceNotConfiguredError{Source: SourceTypeOpsgenie}, err)
+}
+
+func TestNewResolverUnknownSource(t *testing.T) {
+	_, err := NewResolver(context.Background(), deploy.FeatureMap{"victorops": {}})
+	assert.Error(t, err)
+}
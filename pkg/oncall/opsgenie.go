@@ -0,0 +1,82 @@
+package oncall
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/pkg/errors"
+)
+
+const OpsgenieBaseURL = "https://api.opsgenie.com"
+
+type Opsgenie struct {
+	apiKey gconfig.SecretStringValue
+	// apiURL is used to override the Opsgenie API, for example to use the EU instance at https://api.eu.opsgenie.com.
+	apiURL gconfig.OptionalStringValue
+	client *http.Client
+}
+
+func (s *Opsgenie) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.SecretStringField("apiKey", &s.apiKey, "the Opsgenie API key", gconfig.WithNoArgs("/granted/secrets/oncall/opsgenie/key")),
+		gconfig.OptionalStringField("apiUrl", &s.apiURL, "the Opsgenie API URL", gconfig.WithDefaultFunc(func() string { return OpsgenieBaseURL })),
+	}
+}
+
+func (s *Opsgenie) Init(ctx context.Context) error {
+	s.client = newHTTPClient()
+	return nil
+}
+
+func (s *Opsgenie) TestConfig(ctx context.Context) error {
+	var res struct{}
+	err := getJSON(ctx, s.client, s.baseURL()+"/v2/schedules", s.header(), &res)
+	if err != nil {
+		return errors.Wrap(err, "failed to list schedules while testing opsgenie configuration")
+	}
+	return nil
+}
+
+type opsgenieOnCallsResponse struct {
+	Data struct {
+		OnCallRecipients []string `json:"onCallRecipients"`
+	} `json:"data"`
+}
+
+// OnCallEmails returns the email addresses of the users who are on call for the schedule right now.
+// Opsgenie usernames are the email addresses of users.
+//
+// https://docs.opsgenie.com/docs/who-is-on-call-api#get-on-calls
+func (s *Opsgenie) OnCallEmails(ctx context.Context, scheduleID string) ([]string, error) {
+	q := url.Values{}
+	q.Set("scheduleIdentifierType", "id")
+	// flattening the response returns the usernames of the on-call users, rather than the escalation tree.
+	q.Set("flat", "true")
+
+	var res opsgenieOnCallsResponse
+	err := getJSON(ctx, s.client, s.baseURL()+"/v2/schedules/"+url.PathEscape(scheduleID)+"/on-calls?"+q.Encode(), s.header(), &res)
+	if err != nil {
+		return nil, err
+	}
+	emails := []string{}
+	for _, r := range res.Data.OnCallRecipients {
+		emails = appendUnique(emails, r)
+	}
+	return emails, nil
+}
+
+func (s *Opsgenie) baseURL() string {
+	if s.apiURL.Get() != "" {
+		return strings.TrimSuffix(s.apiURL.Get(), "/")
+	}
+	return OpsgenieBaseURL
+}
+
+func (s *Opsgenie) header() http.Header {
+	h := http.Header{}
+	h.Set("Authorization", "GenieKey "+s.apiKey.Get())
+	return h
+}
@@ -0,0 +1,88 @@
+package oncall
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/pkg/errors"
+)
+
+const PagerDutyBaseURL = "https://api.pagerduty.com"
+
+type PagerDuty struct {
+	apiToken gconfig.SecretStringValue
+	// apiURL is used to override the PagerDuty API, for example to use the EU service region.
+	apiURL gconfig.OptionalStringValue
+	client *http.Client
+}
+
+func (s *PagerDuty) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.SecretStringField("apiToken", &s.apiToken, "the PagerDuty REST API key", gconfig.WithNoArgs("/granted/secrets/oncall/pagerduty/token")),
+		gconfig.OptionalStringField("apiUrl", &s.apiURL, "the PagerDuty API URL", gconfig.WithDefaultFunc(func() string { return PagerDutyBaseURL })),
+	}
+}
+
+func (s *PagerDuty) Init(ctx context.Context) error {
+	s.client = newHTTPClient()
+	return nil
+}
+
+func (s *PagerDuty) TestConfig(ctx context.Context) error {
+	var res struct{}
+	err := getJSON(ctx, s.client, s.baseURL()+"/schedules?limit=1", s.header(), &res)
+	if err != nil {
+		return errors.Wrap(err, "failed to list schedules while testing pagerduty configuration")
+	}
+	return nil
+}
+
+type pagerDutyOnCallsResponse struct {
+	OnCalls []struct {
+		User struct {
+			ID    string `json:"id"`
+			Email string `json:"email"`
+		} `json:"user"`
+	} `json:"oncalls"`
+}
+
+// OnCallEmails returns the email addresses of the users who are on call for the schedule right now.
+//
+// https://developer.pagerduty.com/api-reference/3a6b910f11050-list-all-of-the-on-calls
+func (s *PagerDuty) OnCallEmails(ctx context.Context, scheduleID string) ([]string, error) {
+	q := url.Values{}
+	q.Set("schedule_ids[]", scheduleID)
+	q.Set("include[]", "users")
+	q.Set("earliest", "true")
+	q.Set("limit", "100")
+
+	var res pagerDutyOnCallsResponse
+	err := getJSON(ctx, s.client, s.baseURL()+"/oncalls?"+q.Encode(), s.header(), &res)
+	if err != nil {
+		return nil, err
+	}
+	emails := []string{}
+	for _, oc := range res.OnCalls {
+		if oc.User.Email != "" {
+			emails = appendUnique(emails, oc.User.Email)
+		}
+	}
+	return emails, nil
+}
+
+func (s *PagerDuty) baseURL() string {
+	if s.apiURL.Get() != "" {
+		return strings.TrimSuffix(s.apiURL.Get(), "/")
+	}
+	return PagerDutyBaseURL
+}
+
+func (s *PagerDuty) header() http.Header {
+	h := http.Header{}
+	h.Set("Authorization", "Token token="+s.apiToken.Get())
+	h.Set("Accept", "application/vnd.pagerduty+json;version=2")
+	return h
+}
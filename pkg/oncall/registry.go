@@ -0,0 +1,79 @@
+package oncall
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/fatih/color"
+)
+
+const (
+	SourceTypePagerDuty = "pagerduty"
+	SourceTypeOpsgenie  = "opsgenie"
+)
+
+type RegisteredSource struct {
+	Source      Source
+	Description string
+	DocsID      string
+}
+
+type SourceRegistry struct {
+	Sources map[string]RegisteredSource
+}
+
+func Registry() SourceRegistry {
+	return SourceRegistry{
+		Sources: map[string]RegisteredSource{
+			SourceTypePagerDuty: {
+				Source:      &PagerDuty{},
+				Description: "PagerDuty",
+				DocsID:      "pagerduty",
+			},
+			SourceTypeOpsgenie: {
+				Source:      &Opsgenie{},
+				Description: "Opsgenie",
+				DocsID:      "opsgenie",
+			},
+		},
+	}
+}
+
+// Lookup a source by its type.
+func (r SourceRegistry) Lookup(sourceType string) (*RegisteredSource, error) {
+	s, ok := r.Sources[sourceType]
+	if !ok {
+		return nil, fmt.Errorf("could not find on-call source %s", sourceType)
+	}
+	return &s, nil
+}
+
+func (r SourceRegistry) CLIOptions() []string {
+	var opts []string
+	for k, v := range r.Sources {
+		grey := color.New(color.FgHiBlack).SprintFunc()
+		id := "(" + k + ")"
+		opt := fmt.Sprintf("%s %s", v.Description, grey(id))
+		opts = append(opts, opt)
+	}
+	sort.Strings(opts)
+	return opts
+}
+
+func (r SourceRegistry) FromCLIOption(opt string) (key string, s RegisteredSource, err error) {
+	re, err := regexp.Compile(`[\w ]+\((.*)\)`)
+	if err != nil {
+		return "", RegisteredSource{}, err
+	}
+	got := re.FindStringSubmatch(opt)
+	if got == nil {
+		return "", RegisteredSource{}, fmt.Errorf("couldn't extract on-call source key: %s", opt)
+	}
+	key = got[1]
+	s, ok := r.Sources[key]
+	if !ok {
+		return "", RegisteredSource{}, fmt.Errorf("couldn't find on-call source with key: %s", key)
+	}
+	return key, s, nil
+}
@@ -0,0 +1,175 @@
+// Package policy evaluates the approval policy of an access rule.
+//
+// A policy is a CEL expression (https://github.com/google/cel-spec) which decides
+// whether a request is approved automatically, requires review, or is denied.
+// The expression must evaluate to one of the strings "approve", "review" or "deny".
+//
+// The following variables are available to the expression:
+//
+//	user     map: id, email, firstName, lastName and groups of the requesting user
+//	with     map: the selected arguments of the request, keyed by argument ID
+//	timing   map: durationSeconds, and startTime which is the time the request is made for ASAP requests
+//	now      timestamp: the time that the request is made
+//	history  map: requests, approved, declined and cancelled counts of the user's recent requests for the rule
+//
+// For example:
+//
+//	"admins" in user.groups && timing.durationSeconds <= 3600 ? "approve" : "review"
+package policy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/google/cel-go/cel"
+)
+
+type Decision string
+
+const (
+	// Approve approves the request automatically.
+	Approve Decision = "approve"
+	// Review requires the request to be reviewed by the approvers of the rule.
+	Review Decision = "review"
+	// Deny rejects the request before it is created.
+	Deny Decision = "deny"
+)
+
+// HistoryWindow is how far back the requests included in History go.
+const HistoryWindow = 30 * 24 * time.Hour
+
+// History summarises the recent requests that a user has made for an access rule.
+type History struct {
+	Requests  int
+	Approved  int
+	Declined  int
+	Cancelled int
+}
+
+// HistoryFromRequests counts the requests by their status.
+func HistoryFromRequests(reqs []access.Request) History {
+	h := History{Requests: len(reqs)}
+	for _, r := range reqs {
+		switch r.Status {
+		case access.APPROVED:
+			h.Approved++
+		case access.DECLINED:
+			h.Declined++
+		case access.CANCELLED:
+			h.Cancelled++
+		}
+	}
+	return h
+}
+
+// Input is the request which a policy is evaluated against.
+type Input struct {
+	User    identity.User
+	With    map[string]string
+	Timing  access.Timing
+	Now     time.Time
+	History History
+}
+
+// InvalidPolicyError is returned if a policy can't be compiled or doesn't evaluate to a decision.
+type InvalidPolicyError struct {
+	Err error
+}
+
+func (e InvalidPolicyError) Error() string {
+	return fmt.Sprintf("invalid policy: %s", e.Err)
+}
+
+func newEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("user", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("with", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("timing", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("now", cel.TimestampType),
+		cel.Variable("history", cel.MapType(cel.StringType, cel.IntType)),
+	)
+}
+
+// Validate checks that a policy compiles and returns a string.
+func Validate(expr string) error {
+	_, err := compile(expr)
+	return err
+}
+
+func compile(expr string) (cel.Program, error) {
+	env, err := newEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, InvalidPolicyError{Err: iss.Err()}
+	}
+	if ast.OutputType() != cel.StringType && ast.OutputType() != cel.DynType {
+		return nil, InvalidPolicyError{Err: fmt.Errorf("policy must evaluate to a string but evaluates to %s", ast.OutputType())}
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, InvalidPolicyError{Err: err}
+	}
+	return prg, nil
+}
+
+// Evaluate evaluates a policy against a request.
+func Evaluate(expr string, in Input) (Decision, error) {
+	prg, err := compile(expr)
+	if err != nil {
+		return "", err
+	}
+
+	with := in.With
+	if with == nil {
+		with = map[string]string{}
+	}
+	groups := in.User.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+	start := in.Now
+	if in.Timing.StartTime != nil {
+		start = *in.Timing.StartTime
+	}
+
+	out, _, err := prg.Eval(map[string]interface{}{
+		"user": map[string]interface{}{
+			"id":        in.User.ID,
+			"email":     in.User.Email,
+			"firstName": in.User.FirstName,
+			"lastName":  in.User.LastName,
+			"groups":    groups,
+		},
+		"with": with,
+		"timing": map[string]interface{}{
+			"durationSeconds": int64(in.Timing.Duration.Seconds()),
+			"startTime":       start,
+		},
+		"now": in.Now,
+		"history": map[string]int64{
+			"requests":  int64(in.History.Requests),
+			"approved":  int64(in.History.Approved),
+			"declined":  int64(in.History.Declined),
+			"cancelled": int64(in.History.Cancelled),
+		},
+	})
+	if err != nil {
+		return "", InvalidPolicyError{Err: err}
+	}
+
+	s, ok := out.Value().(string)
+	if !ok {
+		return "", InvalidPolicyError{Err: fmt.Errorf("policy must evaluate to a string but returned %v", out.Value())}
+	}
+	d := Decision(s)
+	switch d {
+	case Approve, Review, Deny:
+		return d, nil
+	}
+	return "", InvalidPolicyError{Err: fmt.Errorf("policy returned %q, expected one of %q, %q or %q", s, Approve, Review, Deny)}
+}
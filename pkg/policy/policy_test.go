@@ -0,0 +1,63 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluate(t *testing.T) {
+	type testcase struct {
+		name    string
+		expr    string
+		want    Decision
+		wantErr bool
+	}
+
+	now := time.Date(2022, 10, 10, 9, 30, 0, 0, time.UTC)
+	in := Input{
+		User:    identity.User{ID: "usr_1", Email: "alice@acme.com", Groups: []string{"developers"}},
+		With:    map[string]string{"accountId": "123456789012"},
+		Timing:  access.Timing{Duration: 30 * time.Minute},
+		Now:     now,
+		History: History{Requests: 3, Approved: 2, Declined: 1},
+	}
+
+	testcases := []testcase{
+		{name: "constant", expr: `"approve"`, want: Approve},
+		{name: "groups", expr: `"developers" in user.groups ? "approve" : "review"`, want: Approve},
+		{name: "with", expr: `with.accountId == "123456789012" ? "deny" : "review"`, want: Deny},
+		{name: "timing", expr: `timing.durationSeconds > 3600 ? "review" : "approve"`, want: Approve},
+		{name: "asap start time is now", expr: `timing.startTime == now ? "approve" : "deny"`, want: Approve},
+		{name: "business hours", expr: `now.getHours("UTC") >= 9 && now.getHours("UTC") < 17 ? "approve" : "review"`, want: Approve},
+		{name: "history", expr: `history.declined > 0 ? "review" : "approve"`, want: Review},
+		{name: "syntax error", expr: `"approve`, wantErr: true},
+		{name: "not a string", expr: `1 + 1`, wantErr: true},
+		{name: "unknown decision", expr: `"maybe"`, wantErr: true},
+		{name: "missing with argument", expr: `with.other == "x" ? "approve" : "review"`, wantErr: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Evaluate(tc.expr, in)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestHistoryFromRequests(t *testing.T) {
+	got := HistoryFromRequests([]access.Request{
+		{Status: access.APPROVED},
+		{Status: access.DECLINED},
+		{Status: access.PENDING},
+	})
+	assert.Equal(t, History{Requests: 3, Approved: 1, Declined: 1}, got)
+}
@@ -0,0 +1,244 @@
+// Package reconciler detects drift between the status of grants
+// recorded in Granted and the actual state of access in the providers.
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/ddb"
+	ahconfig "github.com/common-fate/granted-approvals/accesshandler/pkg/config"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	ahtypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+)
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/eventputter.go -package=mocks . EventPutter
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
+}
+
+// Reconciler compares ACTIVE, EXPIRED and REVOKED grants with the state of the
+// provider and emits a grant.drifted event for any grant which doesn't match.
+type Reconciler struct {
+	DB          ddb.Storage
+	Clock       clock.Clock
+	EventPutter EventPutter
+	// Providers are the configured Access Providers, keyed by provider ID.
+	Providers map[string]ahconfig.Provider
+	// Remediate causes the reconciler to re-grant or re-revoke access
+	// when drift is detected, rather than only reporting it.
+	Remediate bool
+	// Window bounds how far back EXPIRED and REVOKED grants are checked.
+	// Grants which ended before the window are skipped. Defaults to DefaultWindow.
+	Window time.Duration
+}
+
+// DefaultWindow is the window used if Reconciler.Window is not set.
+const DefaultWindow = 24 * time.Hour
+
+// Reconcile checks the grants of approved requests which ended within the window against the provider.
+// Errors checking an individual grant are logged and the reconciler moves on to
+// the next grant, so that a single misbehaving provider doesn't block the others.
+func (r *Reconciler) Reconcile(ctx context.Context) error {
+	log := logger.Get(ctx)
+	log.Info("starting grant reconciliation")
+
+	requests, err := r.listRequests(ctx)
+	if err != nil {
+		return err
+	}
+
+	// access which has ended for one grant may still be provided by another
+	// active grant for the same subject and arguments, so it isn't drift.
+	covered := make(map[string]bool)
+	for _, req := range requests {
+		if req.Grant == nil {
+			continue
+		}
+		if wantActive, ok := r.expectedState(*req.Grant); ok && wantActive {
+			covered[accessKey(*req.Grant)] = true
+		}
+	}
+
+	for _, req := range requests {
+		err := r.reconcileRequest(ctx, req, covered)
+		if err != nil {
+			log.Errorw("failed to reconcile grant", "request.id", req.ID, "error", err)
+		}
+	}
+
+	log.Info("completed grant reconciliation")
+	return nil
+}
+
+// listRequests returns the approved requests of every user which ended within the window, or haven't ended yet.
+// The requests are looked up through the request end time index, so that requests which ended before the window
+// aren't read. Requests with a revoked grant are indexed by the time the request was created, so they're
+// only returned if the request was created within the window.
+func (r *Reconciler) listRequests(ctx context.Context) ([]access.Request, error) {
+	since := r.Clock.Now().Add(-r.window())
+
+	var users []identity.User
+	var next string
+	for {
+		q := storage.ListUsers{}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		res, err := r.DB.Query(ctx, &q, opts...)
+		if err != nil && err != ddb.ErrNoItems {
+			return nil, err
+		}
+		users = append(users, q.Result...)
+		if res == nil || res.NextPage == "" {
+			break
+		}
+		next = res.NextPage
+	}
+
+	var requests []access.Request
+	for _, u := range users {
+		next = ""
+		for {
+			q := storage.ListRequestsForUserAndRequestend{
+				UserID:               u.ID,
+				RequestEndComparator: storage.GreaterThanEqual,
+				CompareTo:            since,
+			}
+			var opts []func(*ddb.QueryOpts)
+			if next != "" {
+				opts = append(opts, ddb.Page(next))
+			}
+			res, err := r.DB.Query(ctx, &q, opts...)
+			if err != nil && err != ddb.ErrNoItems {
+				return nil, err
+			}
+			for _, req := range q.Result {
+				if req.Status == access.APPROVED {
+					requests = append(requests, req)
+				}
+			}
+			if res == nil || res.NextPage == "" {
+				break
+			}
+			next = res.NextPage
+		}
+	}
+	return requests, nil
+}
+
+// reconcileRequest checks the grant for a single request.
+// covered contains the access keys of grants which should currently be active.
+func (r *Reconciler) reconcileRequest(ctx context.Context, req access.Request, covered map[string]bool) error {
+	if req.Grant == nil {
+		return nil
+	}
+	wantActive, ok := r.expectedState(*req.Grant)
+	if !ok {
+		return nil
+	}
+
+	log := logger.Get(ctx).With("request.id", req.ID, "grant.status", req.Grant.Status)
+
+	if !wantActive && covered[accessKey(*req.Grant)] {
+		log.Debugw("access is still provided by another active grant, skipping")
+		return nil
+	}
+
+	prov, ok := r.Providers[req.Grant.Provider]
+	if !ok {
+		return &providers.ProviderNotFoundError{Provider: req.Grant.Provider}
+	}
+	checker, ok := prov.Provider.(providers.ActiveChecker)
+	if !ok {
+		log.Debugw("provider does not support checking whether access is active, skipping", "provider", prov.ID)
+		return nil
+	}
+
+	args, err := json.Marshal(req.Grant.With)
+	if err != nil {
+		return err
+	}
+	subject := req.Grant.Subject
+
+	// the request ID is used as the grant ID when creating grants in the access handler.
+	isActive, err := checker.IsActive(ctx, subject, args, req.ID)
+	if err != nil {
+		return err
+	}
+	if isActive == wantActive {
+		return nil
+	}
+
+	log.Infow("detected grant drift", "providerActive", isActive)
+
+	remediated := false
+	if r.Remediate {
+		if wantActive {
+			err = prov.Provider.Grant(ctx, subject, args, req.ID)
+		} else {
+			err = prov.Provider.Revoke(ctx, subject, args, req.ID)
+		}
+		if err != nil {
+			log.Errorw("failed to remediate grant drift", "error", err)
+		} else {
+			remediated = true
+		}
+	}
+
+	return r.EventPutter.Put(ctx, gevent.GrantDrifted{
+		Grant:          req.Grant.ToAHGrant(req.ID),
+		ProviderActive: isActive,
+		Remediated:     remediated,
+	})
+}
+
+// expectedState returns whether the access for a grant should currently be
+// provisioned in the provider. The second return value is false if the grant
+// is not in a state which can be reconciled.
+//
+// ACTIVE grants are only checked between their start and end times, as the
+// grant status is updated asynchronously when access is provisioned or removed.
+// EXPIRED and REVOKED grants are only checked if they ended within the window.
+func (r *Reconciler) expectedState(g access.Grant) (wantActive bool, ok bool) {
+	now := r.Clock.Now()
+	switch g.Status {
+	case ahtypes.GrantStatusACTIVE:
+		if now.Before(g.Start) || !now.Before(g.End) {
+			return false, false
+		}
+		return true, true
+	case ahtypes.GrantStatusEXPIRED, ahtypes.GrantStatusREVOKED:
+		if g.End.Before(now.Add(-r.window())) {
+			return false, false
+		}
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// window returns the window, using DefaultWindow if it isn't set.
+func (r *Reconciler) window() time.Duration {
+	if r.Window == 0 {
+		return DefaultWindow
+	}
+	return r.Window
+}
+
+// accessKey identifies the access provided by a grant, so that grants
+// for the same subject, provider and arguments share a key.
+func accessKey(g access.Grant) string {
+	// json.Marshal sorts map keys, so the arguments are encoded consistently.
+	args, _ := json.Marshal(g.With)
+	return g.Provider + "|" + strings.ToLower(g.Subject) + "|" + string(args)
+}
@@ -0,0 +1,268 @@
+package reconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	ahconfig "github.com/common-fate/granted-approvals/accesshandler/pkg/config"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	ahtypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/reconciler/mocks"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeProvider records calls to Grant and Revoke and reports
+// a fixed active state.
+type fakeProvider struct {
+	active  bool
+	granted bool
+	revoked bool
+}
+
+func (p *fakeProvider) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	p.granted = true
+	return nil
+}
+
+func (p *fakeProvider) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	p.revoked = true
+	return nil
+}
+
+func (p *fakeProvider) IsActive(ctx context.Context, subject string, args []byte, grantID string) (bool, error) {
+	return p.active, nil
+}
+
+// accessorOnly doesn't implement ActiveChecker.
+type accessorOnly struct{}
+
+func (accessorOnly) Grant(ctx context.Context, subject string, args []byte, grantID string) error {
+	return nil
+}
+
+func (accessorOnly) Revoke(ctx context.Context, subject string, args []byte, grantID string) error {
+	return nil
+}
+
+func TestReconcile(t *testing.T) {
+	type testcase struct {
+		name        string
+		grantStatus ahtypes.GrantStatus
+		grantStart  time.Duration
+		provider    providers.Accessor
+		remediate   bool
+		wantEvent   *gevent.GrantDrifted
+		wantGranted bool
+		wantRevoked bool
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+
+	testcases := []testcase{
+		{
+			name:        "active grant in sync",
+			grantStatus: ahtypes.GrantStatusACTIVE,
+			grantStart:  -time.Minute,
+			provider:    &fakeProvider{active: true},
+		},
+		{
+			name:        "active grant removed from provider",
+			grantStatus: ahtypes.GrantStatusACTIVE,
+			grantStart:  -time.Minute,
+			provider:    &fakeProvider{active: false},
+			wantEvent:   &gevent.GrantDrifted{ProviderActive: false},
+		},
+		{
+			name:        "active grant removed from provider with remediation",
+			grantStatus: ahtypes.GrantStatusACTIVE,
+			grantStart:  -time.Minute,
+			provider:    &fakeProvider{active: false},
+			remediate:   true,
+			wantEvent:   &gevent.GrantDrifted{ProviderActive: false, Remediated: true},
+			wantGranted: true,
+		},
+		{
+			name:        "expired grant left behind",
+			grantStatus: ahtypes.GrantStatusEXPIRED,
+			grantStart:  -time.Hour,
+			provider:    &fakeProvider{active: true},
+			wantEvent:   &gevent.GrantDrifted{ProviderActive: true},
+		},
+		{
+			name:        "revoked grant left behind with remediation",
+			grantStatus: ahtypes.GrantStatusREVOKED,
+			grantStart:  -time.Minute,
+			provider:    &fakeProvider{active: true},
+			remediate:   true,
+			wantEvent:   &gevent.GrantDrifted{ProviderActive: true, Remediated: true},
+			wantRevoked: true,
+		},
+		{
+			name:        "active grant outside of window is skipped",
+			grantStatus: ahtypes.GrantStatusACTIVE,
+			grantStart:  time.Minute,
+			provider:    &fakeProvider{active: false},
+		},
+		{
+			name:        "expired grant outside of window is skipped",
+			grantStatus: ahtypes.GrantStatusEXPIRED,
+			grantStart:  -48 * time.Hour,
+			provider:    &fakeProvider{active: true},
+		},
+		{
+			name:        "pending grant is skipped",
+			grantStatus: ahtypes.GrantStatusPENDING,
+			grantStart:  -time.Minute,
+			provider:    &fakeProvider{active: true},
+		},
+		{
+			name:        "provider without active checker is skipped",
+			grantStatus: ahtypes.GrantStatusACTIVE,
+			grantStart:  -time.Minute,
+			provider:    accessorOnly{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			start := now.Add(tc.grantStart)
+			req := access.Request{
+				ID:     "req_1",
+				Status: access.APPROVED,
+				Grant: &access.Grant{
+					Provider: "test",
+					Subject:  "test@acme.com",
+					Start:    start,
+					End:      start.Add(30 * time.Minute),
+					Status:   tc.grantStatus,
+				},
+			}
+
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListUsers{Result: []identity.User{{ID: "usr_1"}}})
+			db.MockQuery(&storage.ListRequestsForUserAndRequestend{Result: []access.Request{req}})
+
+			ctrl := gomock.NewController(t)
+			ep := mocks.NewMockEventPutter(ctrl)
+			if tc.wantEvent != nil {
+				want := *tc.wantEvent
+				want.Grant = req.Grant.ToAHGrant(req.ID)
+				ep.EXPECT().Put(gomock.Any(), want).Return(nil)
+			}
+
+			r := Reconciler{
+				DB:          db,
+				Clock:       clk,
+				EventPutter: ep,
+				Providers: map[string]ahconfig.Provider{
+					"test": {ID: "test", Provider: tc.provider},
+				},
+				Remediate: tc.remediate,
+			}
+
+			err := r.Reconcile(context.Background())
+			assert.NoError(t, err)
+
+			if fp, ok := tc.provider.(*fakeProvider); ok {
+				assert.Equal(t, tc.wantGranted, fp.granted)
+				assert.Equal(t, tc.wantRevoked, fp.revoked)
+			}
+		})
+	}
+}
+
+func TestReconcileSkipsAccessCoveredByAnotherGrant(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	grant := func(status ahtypes.GrantStatus) *access.Grant {
+		return &access.Grant{
+			Provider: "test",
+			Subject:  "test@acme.com",
+			With:     ahtypes.Grant_With{AdditionalProperties: map[string]string{"group": "admins"}},
+			Start:    now.Add(-time.Minute),
+			End:      now.Add(time.Hour),
+			Status:   status,
+		}
+	}
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.ListUsers{Result: []identity.User{{ID: "usr_1"}}})
+	db.MockQuery(&storage.ListRequestsForUserAndRequestend{Result: []access.Request{
+		{ID: "req_revoked", Status: access.APPROVED, Grant: grant(ahtypes.GrantStatusREVOKED)},
+		{ID: "req_active", Status: access.APPROVED, Grant: grant(ahtypes.GrantStatusACTIVE)},
+	}})
+
+	// no events are expected: the revoked grant's access is still provided by the active grant.
+	ctrl := gomock.NewController(t)
+	ep := mocks.NewMockEventPutter(ctrl)
+	fp := &fakeProvider{active: true}
+
+	r := Reconciler{
+		DB:          db,
+		Clock:       clk,
+		EventPutter: ep,
+		Providers: map[string]ahconfig.Provider{
+			"test": {ID: "test", Provider: fp},
+		},
+		Remediate: true,
+	}
+
+	err := r.Reconcile(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, fp.revoked)
+}
+
+// recordingDB records the request end time queries made through it.
+type recordingDB struct {
+	*ddbmock.Client
+	queries []storage.ListRequestsForUserAndRequestend
+}
+
+func (d *recordingDB) Query(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) (*ddb.QueryResult, error) {
+	if q, ok := qb.(*storage.ListRequestsForUserAndRequestend); ok {
+		d.queries = append(d.queries, *q)
+	}
+	return d.Client.Query(ctx, qb, opts...)
+}
+
+func TestReconcileListsRequestsWhichEndedWithinWindow(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	mock := ddbmock.New(t)
+	mock.MockQuery(&storage.ListUsers{Result: []identity.User{{ID: "usr_1"}, {ID: "usr_2"}}})
+	// the end time index includes requests with other statuses, which aren't reconciled.
+	mock.MockQuery(&storage.ListRequestsForUserAndRequestend{Result: []access.Request{
+		{ID: "req_pending", Status: access.PENDING, Grant: &access.Grant{Provider: "test", Status: ahtypes.GrantStatusACTIVE, Start: now.Add(-time.Minute), End: now.Add(time.Hour)}},
+	}})
+	db := &recordingDB{Client: mock}
+
+	fp := &fakeProvider{active: false}
+	r := Reconciler{
+		DB:          db,
+		Clock:       clk,
+		EventPutter: mocks.NewMockEventPutter(gomock.NewController(t)),
+		Providers: map[string]ahconfig.Provider{
+			"test": {ID: "test", Provider: fp},
+		},
+		Window: time.Hour,
+	}
+
+	err := r.Reconcile(context.Background())
+	assert.NoError(t, err)
+
+	want := []storage.ListRequestsForUserAndRequestend{
+		{UserID: "usr_1", RequestEndComparator: storage.GreaterThanEqual, CompareTo: now.Add(-time.Hour)},
+		{UserID: "usr_2", RequestEndComparator: storage.GreaterThanEqual, CompareTo: now.Add(-time.Hour)},
+	}
+	assert.Equal(t, want, db.queries)
+	assert.False(t, fp.granted)
+}
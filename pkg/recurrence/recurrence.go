@@ -0,0 +1,206 @@
+// Package recurrence parses the subset of iCalendar recurrence rules (RFC 5545 RRULE)
+// which is used to repeat scheduled access, such as "FREQ=WEEKLY;BYDAY=TU;COUNT=8".
+//
+// The supported rule parts are FREQ (DAILY or WEEKLY), INTERVAL, BYDAY, COUNT and UNTIL.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily  Frequency = "DAILY"
+	Weekly Frequency = "WEEKLY"
+)
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Rule is a parsed recurrence rule.
+type Rule struct {
+	Freq Frequency
+	// Interval is the number of days or weeks between occurrences. It is 1 if not specified.
+	Interval int
+	// ByDay limits occurrences to these days of the week.
+	ByDay []time.Weekday
+	// Count is the total number of occurrences, or 0 if the rule isn't limited by a count.
+	Count int
+	// Until is the latest time an occurrence may start, or nil if the rule isn't limited by a date.
+	Until *time.Time
+}
+
+// Parse parses a recurrence rule. The "RRULE:" prefix is optional.
+func Parse(s string) (Rule, error) {
+	r := Rule{Interval: 1}
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return Rule{}, errors.New("recurrence rule is empty")
+	}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return Rule{}, fmt.Errorf("invalid recurrence rule part: %s", part)
+		}
+		key = strings.ToUpper(key)
+		if seen[key] {
+			return Rule{}, fmt.Errorf("recurrence rule part %s is repeated", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			switch Frequency(strings.ToUpper(value)) {
+			case Daily, Weekly:
+				r.Freq = Frequency(strings.ToUpper(value))
+			default:
+				return Rule{}, fmt.Errorf("unsupported recurrence frequency: %s", value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return Rule{}, fmt.Errorf("recurrence interval must be a positive integer: %s", value)
+			}
+			r.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return Rule{}, fmt.Errorf("recurrence count must be a positive integer: %s", value)
+			}
+			r.Count = n
+		case "UNTIL":
+			t, err := parseUntil(value)
+			if err != nil {
+				return Rule{}, err
+			}
+			r.Until = &t
+		case "BYDAY":
+			for _, d := range strings.Split(value, ",") {
+				wd, ok := weekdays[strings.ToUpper(d)]
+				if !ok {
+					return Rule{}, fmt.Errorf("unsupported recurrence day: %s", d)
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		default:
+			return Rule{}, fmt.Errorf("unsupported recurrence rule part: %s", key)
+		}
+	}
+	if r.Freq == "" {
+		return Rule{}, errors.New("recurrence rule must include FREQ")
+	}
+	if r.Count > 0 && r.Until != nil {
+		return Rule{}, errors.New("recurrence rule can't include both COUNT and UNTIL")
+	}
+	return r, nil
+}
+
+// parseUntil parses an UNTIL value, which is either a UTC date-time like 20221231T235959Z or a date like 20221231.
+// A date includes occurrences on that day.
+func parseUntil(v string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", v); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102", v); err == nil {
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid recurrence UNTIL value: %s", v)
+}
+
+// IsBounded returns true if the rule ends after a number of occurrences or at a date.
+func (r Rule) IsBounded() bool {
+	return r.Count > 0 || r.Until != nil
+}
+
+// ErrTooManyOccurrences is returned if a rule has more occurrences than the maximum allowed.
+var ErrTooManyOccurrences = errors.New("recurrence rule has too many occurrences")
+
+// Occurrences returns the start time of each occurrence of the rule, beginning at dtstart.
+// Occurrences which start after horizon aren't included; truncated is true if the rule has any such occurrences.
+// ErrTooManyOccurrences is returned if more than max occurrences start before the horizon.
+//
+// If the rule has BYDAY, dtstart must fall on one of the days.
+func (r Rule) Occurrences(dtstart time.Time, horizon time.Time, max int) (occurrences []time.Time, truncated bool, err error) {
+	days := r.ByDay
+	if len(days) == 0 && r.Freq == Weekly {
+		days = []time.Weekday{dtstart.Weekday()}
+	}
+	if len(days) == 0 {
+		days = []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+	}
+	if !containsDay(days, dtstart.Weekday()) {
+		return nil, false, fmt.Errorf("the start time is on a %s, but the recurrence rule only includes %s", dtstart.Weekday(), dayNames(days))
+	}
+
+	// the candidate days are walked one at a time. For weekly rules, only weeks which are a multiple of the
+	// interval after the week of dtstart are included. Weeks start on Monday.
+	weekStart := dtstart.AddDate(0, 0, -((int(dtstart.Weekday()) + 6) % 7))
+	for day := 0; ; day++ {
+		t := dtstart.AddDate(0, 0, day)
+		if r.Until != nil && t.After(*r.Until) {
+			return occurrences, false, nil
+		}
+		if t.After(horizon) {
+			return occurrences, true, nil
+		}
+		if !r.includes(t, dtstart, weekStart, days) {
+			continue
+		}
+		if len(occurrences) == max {
+			return nil, false, ErrTooManyOccurrences
+		}
+		occurrences = append(occurrences, t)
+		if r.Count > 0 && len(occurrences) == r.Count {
+			return occurrences, false, nil
+		}
+	}
+}
+
+func (r Rule) includes(t, dtstart, weekStart time.Time, days []time.Weekday) bool {
+	if !containsDay(days, t.Weekday()) {
+		return false
+	}
+	switch r.Freq {
+	case Weekly:
+		return daysBetween(weekStart, t)/7%r.Interval == 0
+	default:
+		return daysBetween(dtstart, t)%r.Interval == 0
+	}
+}
+
+// daysBetween returns the number of calendar days from a to b, which isn't affected by daylight saving changes.
+func daysBetween(a, b time.Time) int {
+	da := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	db := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(db.Sub(da).Hours() / 24)
+}
+
+func containsDay(days []time.Weekday, d time.Weekday) bool {
+	for _, v := range days {
+		if v == d {
+			return true
+		}
+	}
+	return false
+}
+
+func dayNames(days []time.Weekday) string {
+	names := make([]string, len(days))
+	for i, d := range days {
+		names[i] = d.String()
+	}
+	return strings.Join(names, ", ")
+}
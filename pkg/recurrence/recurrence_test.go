@@ -0,0 +1,116 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	until := time.Date(2022, 12, 31, 23, 59, 59, 0, time.UTC)
+	type testcase struct {
+		give    string
+		want    Rule
+		wantErr string
+	}
+	testcases := []testcase{
+		{give: "FREQ=WEEKLY;BYDAY=TU;COUNT=8", want: Rule{Freq: Weekly, Interval: 1, ByDay: []time.Weekday{time.Tuesday}, Count: 8}},
+		{give: "RRULE:FREQ=DAILY;INTERVAL=2;UNTIL=20221231", want: Rule{Freq: Daily, Interval: 2, Until: &until}},
+		{give: "freq=weekly;byday=mo,we;until=20221231T235959Z", want: Rule{Freq: Weekly, Interval: 1, ByDay: []time.Weekday{time.Monday, time.Wednesday}, Until: &until}},
+		{give: "", wantErr: "recurrence rule is empty"},
+		{give: "COUNT=2", wantErr: "recurrence rule must include FREQ"},
+		{give: "FREQ=MONTHLY", wantErr: "unsupported recurrence frequency: MONTHLY"},
+		{give: "FREQ=DAILY;BYHOUR=2", wantErr: "unsupported recurrence rule part: BYHOUR"},
+		{give: "FREQ=DAILY;INTERVAL=0", wantErr: "recurrence interval must be a positive integer: 0"},
+		{give: "FREQ=DAILY;COUNT=2;COUNT=3", wantErr: "recurrence rule part COUNT is repeated"},
+		{give: "FREQ=DAILY;COUNT=2;UNTIL=20221231", wantErr: "recurrence rule can't include both COUNT and UNTIL"},
+		{give: "FREQ=WEEKLY;BYDAY=1TU", wantErr: "unsupported recurrence day: 1TU"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.give, func(t *testing.T) {
+			got, err := Parse(tc.give)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	// a Tuesday
+	start := time.Date(2022, 11, 1, 2, 0, 0, 0, time.UTC)
+	horizon := start.AddDate(0, 3, 0)
+	week := func(n int) time.Time { return start.AddDate(0, 0, 7*n) }
+
+	type testcase struct {
+		name          string
+		rule          string
+		start         time.Time
+		max           int
+		want          []time.Time
+		wantTruncated bool
+		wantErr       error
+	}
+	testcases := []testcase{
+		{
+			name: "every tuesday for 4 weeks",
+			rule: "FREQ=WEEKLY;BYDAY=TU;COUNT=4",
+			want: []time.Time{week(0), week(1), week(2), week(3)},
+		},
+		{
+			name: "every other week",
+			rule: "FREQ=WEEKLY;INTERVAL=2;COUNT=3",
+			want: []time.Time{week(0), week(2), week(4)},
+		},
+		{
+			name: "tuesdays and thursdays until a date",
+			rule: "FREQ=WEEKLY;BYDAY=TU,TH;UNTIL=20221110",
+			want: []time.Time{start, start.AddDate(0, 0, 2), week(1), week(1).AddDate(0, 0, 2)},
+		},
+		{
+			name: "every third day",
+			rule: "FREQ=DAILY;INTERVAL=3;COUNT=3",
+			want: []time.Time{start, start.AddDate(0, 0, 3), start.AddDate(0, 0, 6)},
+		},
+		{
+			name: "weekdays",
+			rule: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;COUNT=5",
+			want: []time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2), start.AddDate(0, 0, 3), start.AddDate(0, 0, 6)},
+		},
+		{
+			name:          "unbounded rules stop at the horizon",
+			rule:          "FREQ=WEEKLY",
+			want:          []time.Time{week(0), week(1), week(2), week(3), week(4), week(5), week(6), week(7), week(8), week(9), week(10), week(11), week(12), week(13)},
+			wantTruncated: true,
+		},
+		{
+			name:    "too many occurrences",
+			rule:    "FREQ=DAILY;COUNT=10",
+			max:     5,
+			wantErr: ErrTooManyOccurrences,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := Parse(tc.rule)
+			assert.NoError(t, err)
+			max := tc.max
+			if max == 0 {
+				max = 100
+			}
+			got, truncated, err := r.Occurrences(start, horizon, max)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantTruncated, truncated)
+		})
+	}
+
+	// the start time must be on one of the days of the rule.
+	r, _ := Parse("FREQ=WEEKLY;BYDAY=WE")
+	_, _, err := r.Occurrences(start, horizon, 100)
+	assert.EqualError(t, err, "the start time is on a Tuesday, but the recurrence rule only includes Wednesday")
+}
@@ -33,6 +33,24 @@ type AccessRule struct {
 	Name            string                `json:"name" dynamodbav:"name"`
 	Target          Target                `json:"target" dynamodbav:"target"`
 	TimeConstraints types.TimeConstraints `json:"timeConstraints" dynamodbav:"timeConstraints"`
+	// BreakGlass allows eligible users to bypass approval during an incident.
+	// Break-glass requests are approved automatically and must be reviewed by an approver afterwards.
+	BreakGlass *types.BreakGlassConfig `json:"breakGlass,omitempty" dynamodbav:"breakGlass,omitempty"`
+	// Limits restrict how many requests a user can make for the rule.
+	Limits *types.RequestLimits `json:"limits,omitempty" dynamodbav:"limits,omitempty"`
+	// ReviewSLA controls reminders, escalation and expiry for pending requests which aren't reviewed.
+	ReviewSLA *types.ReviewSLA `json:"reviewSla,omitempty" dynamodbav:"reviewSla,omitempty"`
+	// Policy is a CEL expression which decides whether requests are approved automatically,
+	// require review, or are denied. See the policy package for the variables available to the expression.
+	Policy *string `json:"policy,omitempty" dynamodbav:"policy,omitempty"`
+	// OnCall approves requests from, or routes requests to, the users on call for a schedule.
+	OnCall *types.OnCallConfig `json:"onCall,omitempty" dynamodbav:"onCall,omitempty"`
+	// FormFields are filled in by users when they request access, such as a ticket ID or change type.
+	FormFields []types.FormField `json:"formFields,omitempty" dynamodbav:"formFields,omitempty"`
+	// Ticket requires requests to link a ticket, which is verified against a ticketing system.
+	Ticket *types.TicketConfig `json:"ticket,omitempty" dynamodbav:"ticket,omitempty"`
+	// Recurrence allows recurring requests, which are reviewed once and granted for each occurrence up to a horizon.
+	Recurrence *types.RecurrenceConfig `json:"recurrence,omitempty" dynamodbav:"recurrence,omitempty"`
 }
 
 // ised for admin apis, this contains the access rule target in a format for updating the access rule provider target
@@ -41,16 +59,7 @@ func (a AccessRule) ToAPIDetail() types.AccessRuleDetail {
 	if a.Status == ARCHIVED {
 		status = types.AccessRuleStatusARCHIVED
 	}
-	approval := types.ApproverConfig{
-		Groups: []string{},
-		Users:  []string{},
-	}
-	if a.Approval.Groups != nil {
-		approval.Groups = a.Approval.Groups
-	}
-	if a.Approval.Users != nil {
-		approval.Users = a.Approval.Users
-	}
+	approval := a.Approval.ToAPI()
 	return types.AccessRuleDetail{
 		ID:          a.ID,
 		Description: a.Description,
@@ -62,11 +71,17 @@ func (a AccessRule) ToAPIDetail() types.AccessRuleDetail {
 			CreatedBy:     a.Metadata.CreatedBy,
 			UpdatedBy:     a.Metadata.UpdatedBy,
 		},
-		Groups: a.Groups,
-		TimeConstraints: types.TimeConstraints{
-			MaxDurationSeconds: a.TimeConstraints.MaxDurationSeconds,
-		},
-		Approval: approval,
+		Groups:          a.Groups,
+		TimeConstraints: a.TimeConstraints,
+		Approval:        approval,
+		BreakGlass:      a.BreakGlass,
+		Limits:          a.Limits,
+		ReviewSla:       a.ReviewSLA,
+		Policy:          a.Policy,
+		OnCall:          a.OnCall,
+		FormFields:      formFieldsToAPI(a.FormFields),
+		Ticket:          a.Ticket,
+		Recurrence:      a.Recurrence,
 
 		Target: a.Target.ToAPIDetail(),
 
@@ -80,13 +95,11 @@ func (a AccessRule) ToAPIDetail() types.AccessRuleDetail {
 func (a AccessRule) ToAPI() types.AccessRule {
 
 	return types.AccessRule{
-		ID:          a.ID,
-		Version:     a.Version,
-		Description: a.Description,
-		Name:        a.Name,
-		TimeConstraints: types.TimeConstraints{
-			MaxDurationSeconds: a.TimeConstraints.MaxDurationSeconds,
-		},
+		ID:              a.ID,
+		Version:         a.Version,
+		Description:     a.Description,
+		Name:            a.Name,
+		TimeConstraints: a.TimeConstraints,
 
 		Target:    a.Target.ToAPI(),
 		IsCurrent: a.Current,
@@ -108,9 +121,33 @@ func (a AccessRule) ToRequestAccessRuleAPI(requestArguments map[string]types.Req
 			},
 		},
 		TimeConstraints: a.TimeConstraints,
+		BreakGlass:      a.BreakGlass,
+		FormFields:      formFieldsToAPI(a.FormFields),
+		Ticket:          a.Ticket,
+		Recurrence:      a.Recurrence,
 	}
 }
 
+// CanBreakGlass returns true if break-glass is enabled on the rule and the user
+// is in one of the groups which may use it. If no break-glass groups are configured,
+// any user who can request the rule may use break-glass access.
+func (a AccessRule) CanBreakGlass(userGroups []string) bool {
+	if a.BreakGlass == nil || !a.BreakGlass.Enabled {
+		return false
+	}
+	if a.BreakGlass.Groups == nil || len(*a.BreakGlass.Groups) == 0 {
+		return true
+	}
+	for _, g := range *a.BreakGlass.Groups {
+		for _, ug := range userGroups {
+			if g == ug {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // AccessRuleMetadata defines model for AccessRuleMetadata.
 type AccessRuleMetadata struct {
 	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
@@ -130,10 +167,149 @@ type Approval struct {
 	//List of users ids represents the individual users who may approve requests for this rule.
 	// This does not represent members of the approval groups
 	Users []string `json:"users" dynamodbav:"users"`
+	// MinApprovals is the number of distinct approvers who must approve the request.
+	// It is only used if Stages are not set. If not set, a single approval is required.
+	MinApprovals int `json:"minApprovals,omitempty" dynamodbav:"minApprovals,omitempty"`
+	// DeclineVeto controls whether a single DECLINED review declines the request.
+	// If it is false, the request is only declined once there are not enough remaining reviewers to reach the required number of approvals.
+	// If not set, a single DECLINED review vetoes the request.
+	DeclineVeto *bool `json:"declineVeto,omitempty" dynamodbav:"declineVeto,omitempty"`
+	// Stages are the ordered stages of a multi-stage approval workflow.
+	// When Stages are set, Groups and Users are ignored and a request is only approved
+	// once every stage has been approved, in order.
+	Stages []ApprovalStage `json:"stages,omitempty" dynamodbav:"stages,omitempty"`
+}
+
+// ApprovalStage is a single stage in a multi-stage approval workflow.
+type ApprovalStage struct {
+	Name   string   `json:"name" dynamodbav:"name"`
+	Groups []string `json:"groups" dynamodbav:"groups"`
+	Users  []string `json:"users" dynamodbav:"users"`
+	// MinApprovals is the number of distinct approvers who must approve the request
+	// before the stage is complete. If not set, a single approval is required.
+	MinApprovals int `json:"minApprovals" dynamodbav:"minApprovals"`
+}
+
+// RequiredApprovals returns the number of approvals needed to complete the stage.
+func (s ApprovalStage) RequiredApprovals() int {
+	if s.MinApprovals < 1 {
+		return 1
+	}
+	return s.MinApprovals
+}
+
+// HasApprovers is true if the stage has any users or groups assigned as approvers.
+func (s ApprovalStage) HasApprovers() bool {
+	return len(s.Users) > 0 || len(s.Groups) > 0
+}
+
+// GetStages returns the approval stages for the rule.
+// Rules without explicit stages have a single stage made up of the top-level Groups and Users.
+func (a *Approval) GetStages() []ApprovalStage {
+	if len(a.Stages) > 0 {
+		return a.Stages
+	}
+	return []ApprovalStage{{Groups: a.Groups, Users: a.Users, MinApprovals: a.MinApprovals}}
+}
+
+// IsDeclineVeto returns true if a single DECLINED review declines the request.
+func (a *Approval) IsDeclineVeto() bool {
+	return a.DeclineVeto == nil || *a.DeclineVeto
+}
+
+// AllUsers returns the individual approvers across every stage.
+func (a *Approval) AllUsers() []string {
+	var users []string
+	for _, s := range a.GetStages() {
+		users = append(users, s.Users...)
+	}
+	return users
+}
+
+// AllGroups returns the approver groups across every stage.
+func (a *Approval) AllGroups() []string {
+	var groups []string
+	for _, s := range a.GetStages() {
+		groups = append(groups, s.Groups...)
+	}
+	return groups
 }
 
 func (a *Approval) IsRequired() bool {
-	return len(a.Users) > 0 || len(a.Groups) > 0
+	for _, s := range a.GetStages() {
+		if s.HasApprovers() {
+			return true
+		}
+	}
+	return false
+}
+
+// ApprovalFromAPI converts the API approver config to an Approval.
+func ApprovalFromAPI(in types.ApproverConfig) Approval {
+	a := Approval{
+		Groups:      in.Groups,
+		Users:       in.Users,
+		DeclineVeto: in.DeclineVeto,
+	}
+	if in.MinApprovals != nil {
+		a.MinApprovals = *in.MinApprovals
+	}
+	if in.Stages != nil {
+		for _, s := range *in.Stages {
+			stage := ApprovalStage{
+				Groups: s.Groups,
+				Users:  s.Users,
+			}
+			if s.Name != nil {
+				stage.Name = *s.Name
+			}
+			if s.MinApprovals != nil {
+				stage.MinApprovals = *s.MinApprovals
+			}
+			a.Stages = append(a.Stages, stage)
+		}
+	}
+	return a
+}
+
+func (a Approval) ToAPI() types.ApproverConfig {
+	// slices are initialised so that they are serialised as empty arrays rather than null
+	approval := types.ApproverConfig{
+		Groups:      []string{},
+		Users:       []string{},
+		DeclineVeto: a.DeclineVeto,
+	}
+	if a.MinApprovals > 0 {
+		minApprovals := a.MinApprovals
+		approval.MinApprovals = &minApprovals
+	}
+	if a.Groups != nil {
+		approval.Groups = a.Groups
+	}
+	if a.Users != nil {
+		approval.Users = a.Users
+	}
+	if len(a.Stages) > 0 {
+		stages := make([]types.ApprovalStage, len(a.Stages))
+		for i, s := range a.Stages {
+			name := s.Name
+			minApprovals := s.RequiredApprovals()
+			stages[i] = types.ApprovalStage{
+				Name:         &name,
+				Groups:       []string{},
+				Users:        []string{},
+				MinApprovals: &minApprovals,
+			}
+			if s.Groups != nil {
+				stages[i].Groups = s.Groups
+			}
+			if s.Users != nil {
+				stages[i].Users = s.Users
+			}
+		}
+		approval.Stages = &stages
+	}
+	return approval
 }
 
 // Provider defines model for Provider.
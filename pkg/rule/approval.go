@@ -0,0 +1,38 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ValidateApproval checks that every stage of a multi-stage approval has approvers,
+// and that the number of approvals required isn't negative or more than the approvers assigned
+// as individual users, if the stage doesn't have any approver groups.
+//
+// Stages with approver groups are checked against the members of the groups by rulesvc.
+func ValidateApproval(a Approval) error {
+	for i, s := range a.GetStages() {
+		name := stageName(i, s)
+		if s.MinApprovals < 0 {
+			return fmt.Errorf("%s: min approvals can't be negative", name)
+		}
+		if len(a.Stages) > 0 && !s.HasApprovers() {
+			return fmt.Errorf("%s must have at least one approver user or group", name)
+		}
+		if s.MinApprovals > 1 && !s.HasApprovers() {
+			return errors.New("min approvals can only be set if there are approvers")
+		}
+		if len(s.Groups) == 0 && s.RequiredApprovals() > len(s.Users) && s.HasApprovers() {
+			return fmt.Errorf("%s requires %d approvals but only has %d approvers", name, s.RequiredApprovals(), len(s.Users))
+		}
+	}
+	return nil
+}
+
+// stageName describes an approval stage in validation errors.
+func stageName(i int, s ApprovalStage) string {
+	if s.Name != "" {
+		return fmt.Sprintf("approval stage %q", s.Name)
+	}
+	return fmt.Sprintf("approval stage %d", i+1)
+}
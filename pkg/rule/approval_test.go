@@ -0,0 +1,62 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateApproval(t *testing.T) {
+	type testcase struct {
+		name    string
+		give    Approval
+		wantErr string
+	}
+
+	testcases := []testcase{
+		{name: "no approval", give: Approval{}},
+		{name: "single approver", give: Approval{Users: []string{"a"}}},
+		{name: "quorum of users", give: Approval{Users: []string{"a", "b"}, MinApprovals: 2}},
+		{name: "quorum with groups", give: Approval{Groups: []string{"grp_1"}, MinApprovals: 3}},
+		{
+			name: "stages",
+			give: Approval{Stages: []ApprovalStage{{Name: "lead", Users: []string{"a"}}, {Name: "security", Groups: []string{"grp_1"}}}},
+		},
+		{
+			name:    "stage without approvers",
+			give:    Approval{Stages: []ApprovalStage{{Name: "lead", Users: []string{"a"}}, {Name: "security"}}},
+			wantErr: `approval stage "security" must have at least one approver user or group`,
+		},
+		{
+			name:    "unnamed stage without approvers",
+			give:    Approval{Stages: []ApprovalStage{{Users: []string{"a"}}, {}}},
+			wantErr: "approval stage 2 must have at least one approver user or group",
+		},
+		{
+			name:    "more approvals than users",
+			give:    Approval{Users: []string{"a", "b"}, MinApprovals: 3},
+			wantErr: "approval stage 1 requires 3 approvals but only has 2 approvers",
+		},
+		{
+			name:    "min approvals without approvers",
+			give:    Approval{MinApprovals: 2},
+			wantErr: "min approvals can only be set if there are approvers",
+		},
+		{
+			name:    "negative min approvals",
+			give:    Approval{Users: []string{"a"}, MinApprovals: -1},
+			wantErr: "approval stage 1: min approvals can't be negative",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateApproval(tc.give)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
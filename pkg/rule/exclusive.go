@@ -0,0 +1,60 @@
+package rule
+
+import (
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// ExclusiveRuleSet is a separation-of-duties constraint between Access Rules.
+// A user can't hold grants from more than one of the Access Rules in the set at the same time,
+// for example "deploy to prod" and "approve prod change".
+type ExclusiveRuleSet struct {
+	ID          string `json:"id" dynamodbav:"id"`
+	Name        string `json:"name" dynamodbav:"name"`
+	Description string `json:"description" dynamodbav:"description"`
+	// RuleIDs are the IDs of the mutually exclusive Access Rules.
+	RuleIDs   []string  `json:"ruleIds" dynamodbav:"ruleIds"`
+	CreatedBy string    `json:"createdBy" dynamodbav:"createdBy"`
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+// ConflictsWith returns the other Access Rules in the set if the rule is part of the set,
+// or nil if the rule isn't part of the set.
+func (s ExclusiveRuleSet) ConflictsWith(ruleID string) []string {
+	var others []string
+	found := false
+	for _, id := range s.RuleIDs {
+		if id == ruleID {
+			found = true
+			continue
+		}
+		others = append(others, id)
+	}
+	if !found {
+		return nil
+	}
+	return others
+}
+
+// DDBKeys provides the keys for storing the object in DynamoDB
+func (s *ExclusiveRuleSet) DDBKeys() (ddb.Keys, error) {
+	k := ddb.Keys{
+		PK: keys.ExclusiveRuleSet.PK1,
+		SK: keys.ExclusiveRuleSet.SK1(s.ID),
+	}
+	return k, nil
+}
+
+func (s ExclusiveRuleSet) ToAPI() types.ExclusiveRuleSet {
+	return types.ExclusiveRuleSet{
+		Id:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		RuleIds:     s.RuleIDs,
+		CreatedBy:   s.CreatedBy,
+		CreatedAt:   s.CreatedAt,
+	}
+}
@@ -0,0 +1,61 @@
+package rule
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// ValidateFormFields checks that the form fields of an access rule have unique IDs,
+// that patterns are only used on text fields and compile, and that select fields have options.
+func ValidateFormFields(fields []types.FormField) error {
+	ids := make(map[string]bool)
+	for _, f := range fields {
+		if ids[f.Id] {
+			return fmt.Errorf("form field %s is defined more than once", f.Id)
+		}
+		ids[f.Id] = true
+
+		switch f.Type {
+		case types.Text, types.Select, types.Number, types.Boolean:
+		default:
+			return fmt.Errorf("form field %s has an unsupported type: %s", f.Id, f.Type)
+		}
+		if f.Pattern != nil {
+			if f.Type != types.Text {
+				return fmt.Errorf("form field %s: patterns can only be used on text fields", f.Id)
+			}
+			_, err := FormFieldPattern(*f.Pattern)
+			if err != nil {
+				return fmt.Errorf("form field %s has an invalid pattern: %w", f.Id, err)
+			}
+		}
+		hasOptions := f.Options != nil && len(*f.Options) > 0
+		if f.Type == types.Select && !hasOptions {
+			return fmt.Errorf("form field %s: select fields must have at least one option", f.Id)
+		}
+		if f.Type != types.Select && hasOptions {
+			return fmt.Errorf("form field %s: options can only be used on select fields", f.Id)
+		}
+	}
+	return nil
+}
+
+// FormFieldPattern compiles the pattern of a text field.
+// The pattern must match the whole value, so it is anchored at both ends.
+func FormFieldPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// FormFieldRequired returns true if the field must be filled in when requesting access.
+func FormFieldRequired(f types.FormField) bool {
+	return f.Required != nil && *f.Required
+}
+
+func formFieldsToAPI(fields []types.FormField) *[]types.FormField {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &fields
+}
@@ -0,0 +1,30 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFormFields(t *testing.T) {
+	pattern := `[A-Z]+-\d+`
+	badPattern := `[A-Z`
+	changeTypes := []string{"standard", "normal", "emergency"}
+
+	assert.NoError(t, ValidateFormFields([]types.FormField{
+		{Id: "ticket", Label: "Ticket ID", Type: types.Text, Pattern: &pattern},
+		{Id: "changeType", Label: "Change type", Type: types.Select, Options: &changeTypes},
+		{Id: "customer", Label: "Customer ID", Type: types.Number},
+		{Id: "outage", Label: "Customer outage", Type: types.Boolean},
+	}))
+	assert.Error(t, ValidateFormFields([]types.FormField{
+		{Id: "ticket", Label: "Ticket ID", Type: types.Text},
+		{Id: "ticket", Label: "Ticket", Type: types.Text},
+	}))
+	assert.Error(t, ValidateFormFields([]types.FormField{{Id: "ticket", Label: "Ticket ID", Type: types.Text, Pattern: &badPattern}}))
+	assert.Error(t, ValidateFormFields([]types.FormField{{Id: "customer", Label: "Customer ID", Type: types.Number, Pattern: &pattern}}))
+	assert.Error(t, ValidateFormFields([]types.FormField{{Id: "changeType", Label: "Change type", Type: types.Select}}))
+	assert.Error(t, ValidateFormFields([]types.FormField{{Id: "ticket", Label: "Ticket ID", Type: types.Text, Options: &changeTypes}}))
+	assert.Error(t, ValidateFormFields([]types.FormField{{Id: "date", Label: "Date", Type: "date"}}))
+}
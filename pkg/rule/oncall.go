@@ -0,0 +1,35 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// ValidateOnCall checks that the on-call configuration of an access rule refers to a schedule
+// and that the schedule is used to either approve or route requests.
+func ValidateOnCall(oc types.OnCallConfig) error {
+	switch oc.Source {
+	case types.Pagerduty, types.Opsgenie:
+	default:
+		return fmt.Errorf("unsupported on-call source: %s", oc.Source)
+	}
+	if oc.ScheduleId == "" {
+		return errors.New("on-call schedule ID must be provided")
+	}
+	if !OnCallAutoApproves(oc) && !OnCallRoutesReview(oc) {
+		return errors.New("on-call configuration must enable auto-approval or review routing")
+	}
+	return nil
+}
+
+// OnCallAutoApproves returns true if requests from users who are on call are approved automatically.
+func OnCallAutoApproves(oc types.OnCallConfig) bool {
+	return oc.AutoApprove != nil && *oc.AutoApprove
+}
+
+// OnCallRoutesReview returns true if the users who are on call are added as reviewers of requests.
+func OnCallRoutesReview(oc types.OnCallConfig) bool {
+	return oc.RouteReview != nil && *oc.RouteReview
+}
@@ -0,0 +1,17 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOnCall(t *testing.T) {
+	yes := true
+	assert.NoError(t, ValidateOnCall(types.OnCallConfig{Source: types.Pagerduty, ScheduleId: "P1", AutoApprove: &yes}))
+	assert.NoError(t, ValidateOnCall(types.OnCallConfig{Source: types.Opsgenie, ScheduleId: "ops", RouteReview: &yes}))
+	assert.Error(t, ValidateOnCall(types.OnCallConfig{Source: types.Pagerduty, ScheduleId: "P1"}))
+	assert.Error(t, ValidateOnCall(types.OnCallConfig{Source: types.Pagerduty, AutoApprove: &yes}))
+	assert.Error(t, ValidateOnCall(types.OnCallConfig{Source: "victorops", ScheduleId: "P1", AutoApprove: &yes}))
+}
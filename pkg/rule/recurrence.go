@@ -0,0 +1,30 @@
+package rule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// MaxRecurrenceOccurrences is the most occurrences a recurring request may have.
+const MaxRecurrenceOccurrences = 100
+
+const (
+	minRecurrenceHorizon = time.Hour
+	maxRecurrenceHorizon = 365 * 24 * time.Hour
+)
+
+// ValidateRecurrence checks that the recurrence configuration of an access rule has a horizon between an hour and a year.
+func ValidateRecurrence(rc types.RecurrenceConfig) error {
+	horizon := RecurrenceHorizon(rc)
+	if horizon < minRecurrenceHorizon || horizon > maxRecurrenceHorizon {
+		return fmt.Errorf("recurrence horizon must be between %s and %s", minRecurrenceHorizon, maxRecurrenceHorizon)
+	}
+	return nil
+}
+
+// RecurrenceHorizon returns how far ahead of the time a request is made its occurrences may be scheduled.
+func RecurrenceHorizon(rc types.RecurrenceConfig) time.Duration {
+	return time.Duration(rc.MaxHorizonSeconds) * time.Second
+}
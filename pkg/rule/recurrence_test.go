@@ -0,0 +1,15 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRecurrence(t *testing.T) {
+	assert.NoError(t, ValidateRecurrence(types.RecurrenceConfig{MaxHorizonSeconds: 3600}))
+	assert.NoError(t, ValidateRecurrence(types.RecurrenceConfig{MaxHorizonSeconds: 31536000}))
+	assert.EqualError(t, ValidateRecurrence(types.RecurrenceConfig{MaxHorizonSeconds: 60}), "recurrence horizon must be between 1h0m0s and 8760h0m0s")
+	assert.Error(t, ValidateRecurrence(types.RecurrenceConfig{MaxHorizonSeconds: 31536001}))
+}
@@ -0,0 +1,51 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// ValidateReviewSLA checks that the review SLA of an access rule is consistent,
+// for example that requests are escalated before they expire.
+func ValidateReviewSLA(sla types.ReviewSLA) error {
+	reminder := secondsOrZero(sla.ReminderAfterSeconds)
+	escalate := secondsOrZero(sla.EscalateAfterSeconds)
+	expire := secondsOrZero(sla.ExpireAfterSeconds)
+
+	if sla.EscalateAfterSeconds != nil {
+		if (sla.EscalationUsers == nil || len(*sla.EscalationUsers) == 0) && (sla.EscalationGroups == nil || len(*sla.EscalationGroups) == 0) {
+			return errors.New("escalation requires at least one escalation user or group")
+		}
+		if sla.ReminderAfterSeconds != nil && reminder >= escalate {
+			return fmt.Errorf("reminder after seconds: %d must be less than escalate after seconds: %d", reminder, escalate)
+		}
+	}
+	if sla.ExpireAfterSeconds != nil {
+		if sla.ReminderAfterSeconds != nil && reminder >= expire {
+			return fmt.Errorf("reminder after seconds: %d must be less than expire after seconds: %d", reminder, expire)
+		}
+		if sla.EscalateAfterSeconds != nil && escalate >= expire {
+			return fmt.Errorf("escalate after seconds: %d must be less than expire after seconds: %d", escalate, expire)
+		}
+	}
+	return nil
+}
+
+// SLAThreshold returns the duration for an optional number of seconds in a review SLA.
+// ok is false if the threshold isn't set.
+func SLAThreshold(seconds *int) (d time.Duration, ok bool) {
+	if seconds == nil {
+		return 0, false
+	}
+	return time.Duration(*seconds) * time.Second, true
+}
+
+func secondsOrZero(seconds *int) int {
+	if seconds == nil {
+		return 0
+	}
+	return *seconds
+}
@@ -0,0 +1,16 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateReviewSLA(t *testing.T) {
+	ten, twenty := 600, 1200
+	assert.NoError(t, ValidateReviewSLA(types.ReviewSLA{ReminderAfterSeconds: &ten, ExpireAfterSeconds: &twenty}))
+	assert.Error(t, ValidateReviewSLA(types.ReviewSLA{ReminderAfterSeconds: &twenty, ExpireAfterSeconds: &ten}))
+	assert.Error(t, ValidateReviewSLA(types.ReviewSLA{EscalateAfterSeconds: &ten}))
+	assert.NoError(t, ValidateReviewSLA(types.ReviewSLA{EscalateAfterSeconds: &ten, EscalationUsers: &[]string{"backup"}}))
+}
@@ -0,0 +1,38 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// ValidateTicket checks that the ticket configuration of an access rule refers to a supported ticketing system.
+func ValidateTicket(tc types.TicketConfig) error {
+	switch tc.System {
+	case types.Jira, types.Linear:
+	default:
+		return fmt.Errorf("unsupported ticketing system: %s", tc.System)
+	}
+	for _, s := range TicketAllowedStatuses(tc) {
+		if strings.TrimSpace(s) == "" {
+			return errors.New("allowed ticket statuses must not be empty")
+		}
+	}
+	return nil
+}
+
+// TicketAllowedStatuses returns the statuses which linked tickets must be in.
+// If it is empty, tickets in any status are allowed.
+func TicketAllowedStatuses(tc types.TicketConfig) []string {
+	if tc.AllowedStatuses == nil {
+		return nil
+	}
+	return *tc.AllowedStatuses
+}
+
+// TicketRequiresAssignee returns true if linked tickets must be assigned to the requesting user.
+func TicketRequiresAssignee(tc types.TicketConfig) bool {
+	return tc.RequireAssignee != nil && *tc.RequireAssignee
+}
@@ -0,0 +1,17 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTicket(t *testing.T) {
+	statuses := []string{"In Progress"}
+	empty := []string{" "}
+	assert.NoError(t, ValidateTicket(types.TicketConfig{System: types.Jira}))
+	assert.NoError(t, ValidateTicket(types.TicketConfig{System: types.Linear, AllowedStatuses: &statuses}))
+	assert.Error(t, ValidateTicket(types.TicketConfig{System: types.Jira, AllowedStatuses: &empty}))
+	assert.Error(t, ValidateTicket(types.TicketConfig{System: "servicenow"}))
+}
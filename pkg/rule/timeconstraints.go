@@ -0,0 +1,119 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+var weekdays = map[types.Weekday]time.Weekday{
+	types.MONDAY:    time.Monday,
+	types.TUESDAY:   time.Tuesday,
+	types.WEDNESDAY: time.Wednesday,
+	types.THURSDAY:  time.Thursday,
+	types.FRIDAY:    time.Friday,
+	types.SATURDAY:  time.Saturday,
+	types.SUNDAY:    time.Sunday,
+}
+
+// ValidateTimeConstraints checks that the time constraints of an access rule are consistent,
+// for example that the minimum duration isn't greater than the maximum duration.
+func ValidateTimeConstraints(tc types.TimeConstraints) error {
+	if tc.MinDurationSeconds != nil && *tc.MinDurationSeconds > tc.MaxDurationSeconds {
+		return fmt.Errorf("minimum duration seconds: %d exceeds the maximum duration seconds: %d", *tc.MinDurationSeconds, tc.MaxDurationSeconds)
+	}
+	if tc.DefaultDurationSeconds != nil {
+		d := *tc.DefaultDurationSeconds
+		if d > tc.MaxDurationSeconds || (tc.MinDurationSeconds != nil && d < *tc.MinDurationSeconds) {
+			return fmt.Errorf("default duration seconds: %d must be between the minimum and maximum duration", d)
+		}
+	}
+	if tc.AllowedWindows != nil {
+		_, err := windowLocation(*tc.AllowedWindows)
+		if err != nil {
+			return err
+		}
+		if len(tc.AllowedWindows.Windows) == 0 {
+			return errors.New("allowed windows must contain at least one window")
+		}
+		for _, w := range tc.AllowedWindows.Windows {
+			if len(w.Days) == 0 {
+				return errors.New("allowed windows must have at least one day")
+			}
+			for _, d := range w.Days {
+				if _, ok := weekdays[d]; !ok {
+					return fmt.Errorf("invalid day: %s", d)
+				}
+			}
+			if w.StartHour < 0 || w.EndHour > 24 || w.StartHour >= w.EndHour {
+				return fmt.Errorf("invalid window hours: %d to %d", w.StartHour, w.EndHour)
+			}
+		}
+	}
+	return nil
+}
+
+// AllowedWindowsContain returns true if the interval from start to end is within the allowed windows.
+// Adjacent windows are treated as a single window, so access can span midnight if both days are allowed.
+func AllowedWindowsContain(aw types.AllowedWindows, start, end time.Time) (bool, error) {
+	loc, err := windowLocation(aw)
+	if err != nil {
+		return false, err
+	}
+	t := start.In(loc)
+	for {
+		windowEnd, ok := windowEndAt(aw.Windows, t)
+		if !ok {
+			return false, nil
+		}
+		if !end.After(windowEnd) {
+			return true, nil
+		}
+		// windowEnd is always after t, so this loop always progresses towards the end of the interval.
+		t = windowEnd
+	}
+}
+
+// windowEndAt returns the latest end of the windows which contain t.
+func windowEndAt(windows []types.TimeWindow, t time.Time) (time.Time, bool) {
+	y, m, d := t.Date()
+	var end time.Time
+	found := false
+	for _, w := range windows {
+		if !containsWeekday(w.Days, t.Weekday()) {
+			continue
+		}
+		ws := time.Date(y, m, d, w.StartHour, 0, 0, 0, t.Location())
+		we := time.Date(y, m, d, w.EndHour, 0, 0, 0, t.Location())
+		if t.Before(ws) || !t.Before(we) {
+			continue
+		}
+		if !found || we.After(end) {
+			end = we
+			found = true
+		}
+	}
+	return end, found
+}
+
+func containsWeekday(days []types.Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if weekdays[d] == day {
+			return true
+		}
+	}
+	return false
+}
+
+func windowLocation(aw types.AllowedWindows) (*time.Location, error) {
+	if aw.Timezone == nil || *aw.Timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(*aw.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %s", *aw.Timezone)
+	}
+	return loc, nil
+}
@@ -2,13 +2,16 @@ package accesssvc
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
 	"github.com/common-fate/granted-approvals/pkg/storage"
 	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
 	"github.com/common-fate/granted-approvals/pkg/types"
@@ -32,21 +35,57 @@ type AddReviewResult struct {
 	Request access.Request
 }
 
+// maxReviewAttempts is the number of times a review is applied to a request which
+// is being updated by concurrent reviews before giving up.
+const maxReviewAttempts = 5
+
 // AddReviewAndGrantAccess reviews a Request. It updates the status of the Request depending on the review decision.
 // If the review approves access, access is granted.
+//
+// Reviews can be made at the same time by different reviewers. The request is only updated if it
+// hasn't changed since it was read, and otherwise the reviews are counted again and the update is retried.
 func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpts) (*AddReviewResult, error) {
 	request := opts.Request
+	// break-glass requests are approved when they are created, and are reviewed afterwards.
+	if request.IsBreakGlassReviewPending() {
+		return s.addBreakGlassReview(ctx, opts)
+	}
 	if request.Status != access.PENDING {
 		return nil, InvalidStatusError{Status: request.Status}
 	}
 
-	originalStatus := request.Status
-
 	isAllowed := canReview(opts)
 	if !isAllowed {
 		return nil, ErrUserNotAuthorized
 	}
 
+	onBehalfOf, err := s.reviewDelegators(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// approvers can override the timing of the request, but only within the time constraints of the access rule.
+	if opts.OverrideTiming != nil && opts.Decision == access.DecisionApproved {
+		err := validateTiming(opts.AccessRule.TimeConstraints, *opts.OverrideTiming, s.Clock.Now(), "overrideTiming")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// load the existing reviews, as requests which require multiple approvals
+	// accumulate approving reviews until the required number has been reached.
+	reviewsq := storage.ListReviewsForRequest{RequestID: request.ID}
+	_, err = s.DB.Query(ctx, &reviewsq)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	reviews := reviewsq.Result
+
+	// a reviewer can only approve a single stage of the request, and can only review each stage once.
+	if reviews.HasApproved(opts.ReviewerID) || reviews.HasReviewedStage(opts.ReviewerID, request.ApprovalStage) {
+		return nil, ErrRequestAlreadyReviewed
+	}
+
 	r := access.Review{
 		ID:              types.NewRequestReviewID(),
 		RequestID:       request.ID,
@@ -54,86 +93,289 @@ func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpt
 		Decision:        opts.Decision,
 		Comment:         opts.Comment,
 		OverrideTimings: opts.OverrideTiming,
+		ApprovalStage:   request.ApprovalStage,
+		OnBehalfOf:      onBehalfOf,
+	}
+	// the review is saved before the request is updated, so that reviews made at the
+	// same time count each other when the update conflicts and is retried.
+	err = s.DB.Put(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+	reviews = append(reviews, r)
+
+	for attempt := 1; ; attempt++ {
+		res, saved, err := s.applyReview(ctx, opts, reviews, r)
+		if err == nil {
+			return res, nil
+		}
+		if saved {
+			return nil, err
+		}
+		if err != storage.ErrVersionConflict || attempt == maxReviewAttempts {
+			// the request wasn't updated, so the review is removed to allow it to be made again.
+			if derr := s.DB.Delete(ctx, &r); derr != nil {
+				logger.Get(ctx).Errorw("error removing review", "review.id", r.ID, "error", derr)
+			}
+			return nil, err
+		}
+
+		logger.Get(ctx).Infow("request was updated by a concurrent review, retrying", "request.id", request.ID, "attempt", attempt)
+		opts, reviews, err = s.reloadReview(ctx, opts, r)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Request.Status != access.PENDING || opts.Request.ApprovalStage != r.ApprovalStage {
+			// a concurrent review completed the stage which this review was made for.
+			// The review is recorded, but there is nothing left for it to update.
+			err = s.EventPutter.Put(ctx, gevent.RequestReviewed{Request: opts.Request, ReviewerID: r.ReviewerID})
+			if err != nil {
+				return nil, err
+			}
+			return &AddReviewResult{Request: opts.Request}, nil
+		}
+	}
+}
+
+// reloadReview loads the latest version of the request, its reviewers and its reviews
+// after the request was updated by a concurrent review.
+func (s *Service) reloadReview(ctx context.Context, opts AddReviewOpts, r access.Review) (AddReviewOpts, access.Reviews, error) {
+	rq := storage.GetRequest{ID: opts.Request.ID}
+	_, err := s.DB.Query(ctx, &rq)
+	if err != nil {
+		return opts, nil, err
+	}
+	opts.Request = *rq.Result
+
+	reviewersq := storage.ListRequestReviewers{RequestID: opts.Request.ID}
+	_, err = s.DB.Query(ctx, &reviewersq)
+	if err != nil && err != ddb.ErrNoItems {
+		return opts, nil, err
+	}
+	opts.Reviewers = reviewersq.Result
+
+	reviewsq := storage.ListReviewsForRequest{RequestID: opts.Request.ID}
+	_, err = s.DB.Query(ctx, &reviewsq)
+	if err != nil && err != ddb.ErrNoItems {
+		return opts, nil, err
+	}
+	reviews := reviewsq.Result
+	// reviews are listed from an index which is eventually consistent, so the review may not be listed yet.
+	found := false
+	for _, rv := range reviews {
+		if rv.ID == r.ID {
+			found = true
+		}
 	}
+	if !found {
+		reviews = append(reviews, r)
+	}
+	return opts, reviews, nil
+}
+
+// applyReview updates the request based on the reviews of it, and grants access if the request is approved.
+// saved is true if the request was updated, in which case the update isn't retried if an error is returned.
+// storage.ErrVersionConflict is returned if the request was updated by a concurrent review.
+func (s *Service) applyReview(ctx context.Context, opts AddReviewOpts, reviews access.Reviews, r access.Review) (res *AddReviewResult, saved bool, err error) {
+	request := opts.Request
+	originalStatus := request.Status
+	reviewers := opts.Reviewers
+	// stageAdvanced is true if the review completed a stage of a multi-stage approval
+	// and the request is now waiting on the next stage.
+	stageAdvanced := false
+	originalStage := request.ApprovalStage
+	stage, isFinal := currentStage(request, opts.AccessRule)
+	approvals := reviews.Count(request.ApprovalStage, access.DecisionApproved)
 
 	// update the request status, based on the review decision
 	switch r.Decision {
 	case access.DecisionApproved:
+		if approvals < stage.RequiredApprovals() {
+			// the stage needs more approvals, so the request remains pending.
+			request.ApprovalProgress = approvalProgress(stage, approvals)
+			break
+		}
+		if !isFinal {
+			request.ApprovalStage++
+			next := opts.AccessRule.Approval.GetStages()[request.ApprovalStage]
+			request.ApprovalProgress = approvalProgress(next, 0)
+			reviewers, err = s.addStageReviewers(ctx, request, reviewers, reviews, next)
+			if err != nil {
+				return nil, false, err
+			}
+			stageAdvanced = true
+			break
+		}
+
+		// every stage has been approved, so access can be granted.
 		request.Status = access.APPROVED
+		request.ApprovalProgress = approvalProgress(stage, approvals)
 		request.OverrideTiming = opts.OverrideTiming
-		start, end := request.GetInterval(access.WithNow(s.Clock.Now()))
-		// this request must not overlap an existing grant for the user and rule
-		// This fetches all grants which end in the future, these may or may not have a grant associated yet.
-		rq := storage.ListRequestsForUserAndRuleAndRequestend{
-			UserID:               request.RequestedBy,
-			RuleID:               request.Rule,
-			RequestEndComparator: storage.GreaterThanEqual,
-			CompareTo:            end,
-		}
-		_, err := s.DB.Query(ctx, &rq)
-		if err != nil && err != ddb.ErrNoItems {
-			return nil, err
-		}
-		// This will check against the requests which do have grants already
-		overlaps := overlapsExistingGrant(start, end, rq.Result)
-		if overlaps {
-			return nil, ErrRequestOverlapsExistingGrant
+
+		if request.ExtensionOf != nil {
+			// extension requests extend the existing grant rather than creating a new grant.
+			break
 		}
 
-		// if the request is approved, attempt to create the grant.
-		updatedRequest, err := s.Granter.CreateGrant(ctx, grantsvc.CreateGrantOpts{Request: request, AccessRule: opts.AccessRule})
+		// this request must not overlap an existing grant for the user and rule
+		err = s.checkOverlappingGrants(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, false, err
+		}
+		// the user can't hold grants from mutually exclusive access rules at the same time.
+		// Every occurrence of a recurring request is checked.
+		for _, timing := range request.Timings() {
+			start, end := timing.GetInterval(access.WithNow(s.Clock.Now()))
+			err = s.checkExclusiveRules(ctx, request.RequestedBy, request.Rule, start, end)
+			if err != nil {
+				return nil, false, err
+			}
 		}
-		reviewed := types.REVIEWED
-		request.ApprovalMethod = &reviewed
-		request = *updatedRequest
 
 	case access.DecisionDECLINED:
-		request.Status = access.DECLINED
+		if opts.AccessRule.Approval.IsDeclineVeto() {
+			request.Status = access.DECLINED
+			break
+		}
+		// without a veto, the request is only declined once there aren't enough reviewers
+		// remaining who could approve the stage.
+		declines := reviews.Count(request.ApprovalStage, access.DecisionDECLINED)
+		if stageReviewerCount(request, reviewers)-declines < stage.RequiredApprovals() {
+			request.Status = access.DECLINED
+		}
 	}
 	request.UpdatedAt = s.Clock.Now()
 
-	// we need to save the Review, the updated Request in the database.
-	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request, dbupdate.WithReviewers(opts.Reviewers))
+	// the request is updated before access is granted, so that a concurrent review
+	// which also completes the approval fails rather than granting access twice.
+	err = s.putRequest(ctx, &request)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	items = append(items, &r)
 
+	if request.Status == access.APPROVED {
+		if request.ExtensionOf != nil {
+			_, err = s.extendGrant(ctx, request, opts.AccessRule, &opts.ReviewerID)
+		} else {
+			// if the request is approved, attempt to create the grant.
+			var updatedRequest *access.Request
+			updatedRequest, err = s.Granter.CreateGrant(ctx, grantsvc.CreateGrantOpts{Request: request, AccessRule: opts.AccessRule})
+			if err == nil {
+				version := request.Version
+				request = *updatedRequest
+				request.Version = version
+			}
+		}
+		if err != nil {
+			// access wasn't granted, so the request is restored to allow it to be reviewed again.
+			restored := opts.Request
+			restored.Version = request.Version
+			if rerr := s.putRequest(ctx, &restored); rerr != nil {
+				logger.Get(ctx).Errorw("error restoring request after failing to grant access", "request.id", request.ID, "error", rerr)
+			}
+			return nil, false, err
+		}
+	}
+
+	var items []ddb.Keyer
+
+	if len(r.OnBehalfOf) > 0 {
+		// audit log event
+		delegateEvent := access.NewRecordedEvent(request.ID, &opts.ReviewerID, request.UpdatedAt, map[string]string{
+			"event":      gevent.RequestReviewedType,
+			"decision":   string(r.Decision),
+			"onBehalfOf": strings.Join(r.OnBehalfOf, ","),
+		})
+		items = append(items, &delegateEvent)
+	}
 	if request.OverrideTiming != nil {
 		// audit log event
 		reqEvent := access.NewTimingChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, request.RequestedTiming, *request.OverrideTiming)
 		items = append(items, &reqEvent)
 	}
-	// audit log event
-	reqEvent := access.NewStatusChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, originalStatus, request.Status)
+	if stageAdvanced {
+		// audit log event
+		stageEvent := access.NewApprovalStageChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, originalStage, request.ApprovalStage)
+		items = append(items, &stageEvent)
+	}
+	if request.Status != originalStatus {
+		// audit log event
+		reqEvent := access.NewStatusChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, originalStatus, request.Status)
+		items = append(items, &reqEvent)
+	}
 
-	items = append(items, &reqEvent)
 	// store the updated items in the database
-	err = s.DB.PutBatch(ctx, items...)
+	if request.Status == access.APPROVED {
+		// the grant is saved on the request, only if the request hasn't changed since
+		// it was saved above, such as by being cancelled while access was granted.
+		err = dbupdate.UpdateRequest(ctx, s.DB, s.Requests, &request, items, dbupdate.WithReviewers(reviewers))
+	} else {
+		// the request has already been saved, so only the copies of it held by the reviewers are written.
+		var updateItems []ddb.Keyer
+		updateItems, err = dbupdate.GetUpdateRequestItems(ctx, s.DB, request, dbupdate.WithReviewers(reviewers))
+		if err == nil {
+			err = s.DB.PutBatch(ctx, append(updateItems[1:], items...)...)
+		}
+	}
 	if err != nil {
-		return nil, err
+		return nil, true, err
 	}
 
-	switch r.Decision {
-	case access.DecisionApproved:
+	switch {
+	case request.Status == access.APPROVED:
 		err = s.EventPutter.Put(ctx, gevent.RequestApproved{Request: request, ReviewerID: r.ReviewerID})
-	case access.DecisionDECLINED:
+	case request.Status == access.DECLINED:
 		err = s.EventPutter.Put(ctx, gevent.RequestDeclined{Request: request, ReviewerID: r.ReviewerID})
+	case stageAdvanced:
+		err = s.EventPutter.Put(ctx, gevent.RequestStageAdvanced{Request: request, ReviewerID: r.ReviewerID})
+	default:
+		// the review was recorded, but the request is still waiting on more reviews.
+		err = s.EventPutter.Put(ctx, gevent.RequestReviewed{Request: request, ReviewerID: r.ReviewerID})
 	}
 
 	// In a future PR we will shift these events out to be triggered by dynamo db streams
 	// This will currently put the app in a strange state if this fails
 	if err != nil {
-		return nil, err
+		return nil, true, err
 	}
 
-	res := AddReviewResult{
-		Request: request,
+	return &AddReviewResult{Request: request}, true, nil
+}
+
+// putRequest stores a request which has been updated by a review, incrementing its version.
+func (s *Service) putRequest(ctx context.Context, r *access.Request) error {
+	if s.Requests == nil {
+		r.Version++
+		return s.DB.Put(ctx, r)
 	}
+	return s.Requests.PutRequest(ctx, r)
+}
 
-	return &res, nil
+// checkOverlappingGrants returns ErrRequestOverlapsExistingGrant if the request, or any occurrence
+// of a recurring request, overlaps an existing grant of the user for the same access rule.
+func (s *Service) checkOverlappingGrants(ctx context.Context, request access.Request) error {
+	now := s.Clock.Now()
+	timings := request.Timings()
+	// This fetches all grants which end in the future, these may or may not have a grant associated yet.
+	_, firstEnd := timings[0].GetInterval(access.WithNow(now))
+	rq := storage.ListRequestsForUserAndRuleAndRequestend{
+		UserID:               request.RequestedBy,
+		RuleID:               request.Rule,
+		RequestEndComparator: storage.GreaterThanEqual,
+		CompareTo:            firstEnd,
+	}
+	_, err := s.DB.Query(ctx, &rq)
+	if err != nil && err != ddb.ErrNoItems {
+		return err
+	}
+	// This will check against the requests which do have grants already
+	for _, timing := range timings {
+		start, end := timing.GetInterval(access.WithNow(now))
+		if overlapsExistingGrant(start, end, rq.Result) {
+			return ErrRequestOverlapsExistingGrant
+		}
+	}
+	return nil
 }
 
 func overlapsExistingGrant(start, end time.Time, upcomingRequests []access.Request) bool {
@@ -152,17 +394,153 @@ func overlapsExistingGrant(start, end time.Time, upcomingRequests []access.Reque
 	return false
 }
 
+// currentStage returns the approval stage which the request is waiting on, and whether it is the final stage.
+// If the access rule has been updated to have fewer stages since the request was made, the final stage is used.
+func currentStage(request access.Request, accessRule rule.AccessRule) (stage rule.ApprovalStage, isFinal bool) {
+	stages := accessRule.Approval.GetStages()
+	if request.ApprovalStage >= len(stages)-1 {
+		return stages[len(stages)-1], true
+	}
+	return stages[request.ApprovalStage], false
+}
+
+// addStageReviewers adds Reviewers for the approvers of the next stage of a multi-stage approval.
+// Existing Reviewers are moved to the next stage if they are an approver for it.
+// Users who have already approved the request are not added, as a reviewer can only approve a single stage.
+// The requestor and the user who submitted the request on their behalf are never added.
+// Delegates of the approvers for the stage are added too, and review the stage on behalf of the approvers.
+func (s *Service) addStageReviewers(ctx context.Context, request access.Request, reviewers []access.Reviewer, reviews access.Reviews, stage rule.ApprovalStage) ([]access.Reviewer, error) {
+	approvers, err := rulesvc.GetStageApprovers(ctx, s.DB, stage)
+	if err != nil {
+		return nil, err
+	}
+	if reviewers == nil {
+		q := storage.ListRequestReviewers{RequestID: request.ID}
+		_, err = s.DB.Query(ctx, &q)
+		if err != nil && err != ddb.ErrNoItems {
+			return nil, err
+		}
+		reviewers = q.Result
+	}
+
+	approvers, delegatedBy, err := rulesvc.WithDelegates(ctx, s.DB, request.RequestedBy, approvers, s.Clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]access.Reviewer, len(reviewers))
+	copy(res, reviewers)
+
+	for _, u := range approvers {
+		if request.IsRequestor(u) || reviews.HasApproved(u) {
+			continue
+		}
+		found := false
+		for i := range res {
+			if res[i].ReviewerID == u {
+				res[i].ApprovalStage = request.ApprovalStage
+				res[i].DelegatedBy = delegatedBy[u]
+				found = true
+			}
+		}
+		if !found {
+			res = append(res, access.Reviewer{ReviewerID: u, Request: request, ApprovalStage: request.ApprovalStage, DelegatedBy: delegatedBy[u]})
+		}
+	}
+	if len(approvers) == 0 {
+		logger.Get(ctx).Warnw("approval stage has no approvers, only an administrator can review the request", "request.id", request.ID, "stage", request.ApprovalStage)
+	}
+	return res, nil
+}
+
+// approvalProgress returns the progress of an approval stage,
+// or nil if the stage only requires a single approval.
+func approvalProgress(stage rule.ApprovalStage, approvals int) *access.ApprovalProgress {
+	if stage.RequiredApprovals() < 2 {
+		return nil
+	}
+	return &access.ApprovalProgress{Approvals: approvals, Required: stage.RequiredApprovals()}
+}
+
+// stageReviewerCount returns the number of reviewers who can review the stage which the request is waiting on.
+// Like Reviews.Count, each approver is only counted once, so delegates of an approver who is already
+// counted don't add to the count.
+func stageReviewerCount(request access.Request, reviewers []access.Reviewer) int {
+	counted := make(map[string]bool)
+	count := 0
+	for _, r := range reviewers {
+		if request.IsRequestor(r.ReviewerID) || r.ApprovalStage != request.ApprovalStage {
+			continue
+		}
+		approvers := r.DelegatedBy
+		if len(approvers) == 0 {
+			approvers = []string{r.ReviewerID}
+		}
+		isNew := false
+		for _, a := range approvers {
+			if !counted[a] {
+				counted[a] = true
+				isNew = true
+			}
+		}
+		if isNew {
+			count++
+		}
+	}
+	return count
+}
+
+// delegatedBy returns the approvers who the reviewer is reviewing the current stage of the request on behalf of.
+func delegatedBy(opts AddReviewOpts) []string {
+	for _, r := range opts.Reviewers {
+		if opts.ReviewerID == r.ReviewerID && r.ApprovalStage == opts.Request.ApprovalStage {
+			return r.DelegatedBy
+		}
+	}
+	return nil
+}
+
+// reviewDelegators returns the approvers who the reviewer is reviewing the request on behalf of.
+// Delegations may have ended or been deleted since the reviewer was added to the request,
+// so ErrUserNotAuthorized is returned if the reviewer was only added as a delegate and none of their delegations are still active,
+// unless they are an administrator.
+func (s *Service) reviewDelegators(ctx context.Context, opts AddReviewOpts) ([]string, error) {
+	onBehalfOf, err := s.activeDelegators(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(delegatedBy(opts)) > 0 && len(onBehalfOf) == 0 && !opts.ReviewerIsAdmin {
+		return nil, ErrUserNotAuthorized
+	}
+	return onBehalfOf, nil
+}
+
+// activeDelegators returns the approvers who the reviewer is reviewing the current stage of the request on behalf of,
+// whose delegations to the reviewer are still active.
+func (s *Service) activeDelegators(ctx context.Context, opts AddReviewOpts) ([]string, error) {
+	recorded := delegatedBy(opts)
+	if len(recorded) == 0 {
+		return nil, nil
+	}
+	_, active, err := rulesvc.WithDelegates(ctx, s.DB, opts.Request.RequestedBy, recorded, s.Clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	return active[opts.ReviewerID], nil
+}
+
 // users can review requests if they are a Granted administrator,
-// or if they are a Reviewer on the request.
+// or if they are a Reviewer on the request for the stage which the request is waiting on.
+// The user who requested access, and the user who submitted the request on their behalf, can't review it.
 func canReview(opts AddReviewOpts) bool {
-	if opts.ReviewerID == opts.Request.RequestedBy {
+	if opts.Request.IsRequestor(opts.ReviewerID) {
 		return false
 	}
 	if opts.ReviewerIsAdmin {
 		return true
 	}
 	for _, r := range opts.Reviewers {
-		if opts.ReviewerID == r.ReviewerID {
+		if opts.ReviewerID == r.ReviewerID && r.ApprovalStage == opts.Request.ApprovalStage {
 			return true
 		}
 	}
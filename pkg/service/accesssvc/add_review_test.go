@@ -2,12 +2,17 @@ package accesssvc
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
 
 	"github.com/common-fate/ddb/ddbmock"
 	"github.com/common-fate/granted-approvals/pkg/service/accesssvc/mocks"
@@ -83,6 +88,8 @@ func TestAddReview(t *testing.T) {
 		wantErr                 error
 		withCreateGrantResponse createGrantResponse
 		wantCreateGrantOpts     grantsvc.CreateGrantOpts
+		withReviews             access.Reviews
+		withDelegations         []access.Delegation
 	}
 
 	clk := clock.NewMock()
@@ -92,11 +99,39 @@ func TestAddReview(t *testing.T) {
 		Duration:  time.Minute,
 		StartTime: &now,
 	}
+	multiStageRule := rule.AccessRule{
+		Approval: rule.Approval{
+			Stages: []rule.ApprovalStage{
+				{Name: "team lead", Users: []string{"a", "b"}},
+				{Name: "security", Users: []string{"c"}},
+			},
+		},
+	}
+	noVeto := false
+	quorumRule := rule.AccessRule{
+		Approval: rule.Approval{Users: []string{"a", "b", "c"}, MinApprovals: 2},
+	}
+	quorumNoVetoRule := rule.AccessRule{
+		Approval: rule.Approval{Users: []string{"a", "b", "c"}, MinApprovals: 2, DeclineVeto: &noVeto},
+	}
+	quorumStageRule := rule.AccessRule{
+		Approval: rule.Approval{
+			Stages: []rule.ApprovalStage{
+				{Name: "team lead", Users: []string{"a", "b"}, MinApprovals: 2},
+				{Name: "security", Users: []string{"c"}},
+			},
+		},
+	}
+	lead := "lead"
+	timeConstrainedRule := rule.AccessRule{
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600},
+	}
 	requestWithOverride := access.Request{
 		Status:         access.APPROVED,
 		Grant:          &access.Grant{},
 		OverrideTiming: overrideTiming,
 		UpdatedAt:      clk.Now(),
+		Version:        2,
 	}
 	testcases := []testcase{
 		{
@@ -124,7 +159,9 @@ func TestAddReview(t *testing.T) {
 			},
 			wantCreateGrantOpts: grantsvc.CreateGrantOpts{
 				Request: access.Request{
-					Status: access.APPROVED,
+					UpdatedAt: clk.Now(),
+					Version:   1,
+					Status:    access.APPROVED,
 				},
 			},
 			withCreateGrantResponse: createGrantResponse{
@@ -137,6 +174,7 @@ func TestAddReview(t *testing.T) {
 			},
 			want: &AddReviewResult{
 				Request: access.Request{
+					Version:   2,
 					Status:    access.APPROVED, // request should be approved
 					UpdatedAt: clk.Now(),
 					Grant:     &access.Grant{},
@@ -166,12 +204,16 @@ func TestAddReview(t *testing.T) {
 					Status: access.PENDING,
 				},
 				OverrideTiming: overrideTiming,
+				AccessRule:     timeConstrainedRule,
 			},
 			wantCreateGrantOpts: grantsvc.CreateGrantOpts{
 				Request: access.Request{
+					UpdatedAt:      clk.Now(),
+					Version:        1,
 					Status:         access.APPROVED,
 					OverrideTiming: overrideTiming,
 				},
+				AccessRule: timeConstrainedRule,
 			},
 			withCreateGrantResponse: createGrantResponse{
 
@@ -181,6 +223,36 @@ func TestAddReview(t *testing.T) {
 				Request: requestWithOverride,
 			},
 		},
+		{
+			name: "override timing exceeds max duration",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "a",
+						Request: access.Request{
+							Status: access.PENDING,
+						},
+					},
+				},
+				Request: access.Request{
+					Status: access.PENDING,
+				},
+				OverrideTiming: &access.Timing{Duration: 2 * time.Hour},
+				AccessRule:     timeConstrainedRule,
+			},
+			wantErr: &apio.APIError{
+				Err:    errors.New("request validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{
+					{
+						Field: "overrideTiming.durationSeconds",
+						Error: "durationSeconds: 7200 exceeds the maximum duration seconds: 3600",
+					},
+				},
+			},
+		},
 		{
 			name: "cannot review own request",
 			give: AddReviewOpts{
@@ -228,6 +300,8 @@ func TestAddReview(t *testing.T) {
 			},
 			wantCreateGrantOpts: grantsvc.CreateGrantOpts{
 				Request: access.Request{
+					UpdatedAt:   clk.Now(),
+					Version:     1,
 					Status:      access.APPROVED,
 					RequestedBy: "b",
 				},
@@ -243,6 +317,7 @@ func TestAddReview(t *testing.T) {
 			},
 			want: &AddReviewResult{
 				Request: access.Request{
+					Version:     2,
 					Status:      access.APPROVED, // request should be approved
 					RequestedBy: "b",
 					UpdatedAt:   clk.Now(),
@@ -250,6 +325,279 @@ func TestAddReview(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "first stage approval advances to the next stage",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a", Request: access.Request{Status: access.PENDING}},
+					{ReviewerID: "b", Request: access.Request{Status: access.PENDING}},
+				},
+				Request:    access.Request{Status: access.PENDING},
+				AccessRule: multiStageRule,
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Version:       1,
+					Status:        access.PENDING,
+					ApprovalStage: 1,
+					UpdatedAt:     clk.Now(),
+				},
+			},
+		},
+		{
+			name: "stage waits for the minimum number of approvals",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a", Request: access.Request{Status: access.PENDING}},
+					{ReviewerID: "b", Request: access.Request{Status: access.PENDING}},
+				},
+				Request:    access.Request{Status: access.PENDING},
+				AccessRule: quorumStageRule,
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Version:          1,
+					Status:           access.PENDING,
+					ApprovalProgress: &access.ApprovalProgress{Approvals: 1, Required: 2},
+					UpdatedAt:        clk.Now(),
+				},
+			},
+		},
+		{
+			name: "quorum is met by the second approval",
+			give: AddReviewOpts{
+				ReviewerID: "b",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a", Request: access.Request{Status: access.PENDING}},
+					{ReviewerID: "b", Request: access.Request{Status: access.PENDING}},
+				},
+				Request:    access.Request{Status: access.PENDING},
+				AccessRule: quorumRule,
+			},
+			withReviews: access.Reviews{{ReviewerID: "a", Decision: access.DecisionApproved}},
+			wantCreateGrantOpts: grantsvc.CreateGrantOpts{
+				Request: access.Request{
+					UpdatedAt:        clk.Now(),
+					Version:          1,
+					Status:           access.APPROVED,
+					ApprovalProgress: &access.ApprovalProgress{Approvals: 2, Required: 2},
+				},
+				AccessRule: quorumRule,
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{
+					Status:           access.APPROVED,
+					ApprovalProgress: &access.ApprovalProgress{Approvals: 2, Required: 2},
+					Grant:            &access.Grant{},
+					UpdatedAt:        clk.Now(),
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Version:          2,
+					Status:           access.APPROVED,
+					ApprovalProgress: &access.ApprovalProgress{Approvals: 2, Required: 2},
+					UpdatedAt:        clk.Now(),
+					Grant:            &access.Grant{},
+				},
+			},
+		},
+		{
+			name: "decline vetoes the request by default",
+			give: AddReviewOpts{
+				ReviewerID: "b",
+				Decision:   access.DecisionDECLINED,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a", Request: access.Request{Status: access.PENDING}},
+					{ReviewerID: "b", Request: access.Request{Status: access.PENDING}},
+					{ReviewerID: "c", Request: access.Request{Status: access.PENDING}},
+				},
+				Request:    access.Request{Status: access.PENDING},
+				AccessRule: quorumRule,
+			},
+			withReviews: access.Reviews{{ReviewerID: "a", Decision: access.DecisionApproved}},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Version:   1,
+					Status:    access.DECLINED,
+					UpdatedAt: clk.Now(),
+				},
+			},
+		},
+		{
+			name: "decline without veto leaves the request pending while quorum is reachable",
+			give: AddReviewOpts{
+				ReviewerID: "b",
+				Decision:   access.DecisionDECLINED,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a", Request: access.Request{Status: access.PENDING}},
+					{ReviewerID: "b", Request: access.Request{Status: access.PENDING}},
+					{ReviewerID: "c", Request: access.Request{Status: access.PENDING}},
+				},
+				Request:    access.Request{Status: access.PENDING},
+				AccessRule: quorumNoVetoRule,
+			},
+			withReviews: access.Reviews{{ReviewerID: "a", Decision: access.DecisionApproved}},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Version:   1,
+					Status:    access.PENDING,
+					UpdatedAt: clk.Now(),
+				},
+			},
+		},
+		{
+			name: "decline without veto declines the request once quorum is unreachable",
+			give: AddReviewOpts{
+				ReviewerID: "c",
+				Decision:   access.DecisionDECLINED,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a", Request: access.Request{Status: access.PENDING}},
+					{ReviewerID: "b", Request: access.Request{Status: access.PENDING}},
+					{ReviewerID: "c", Request: access.Request{Status: access.PENDING}},
+				},
+				Request:    access.Request{Status: access.PENDING},
+				AccessRule: quorumNoVetoRule,
+			},
+			withReviews: access.Reviews{{ReviewerID: "b", Decision: access.DecisionDECLINED}},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Version:   1,
+					Status:    access.DECLINED,
+					UpdatedAt: clk.Now(),
+				},
+			},
+		},
+		{
+			name: "final stage approval grants access",
+			give: AddReviewOpts{
+				ReviewerID: "c",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a", Request: access.Request{Status: access.PENDING}},
+					{ReviewerID: "c", Request: access.Request{Status: access.PENDING}, ApprovalStage: 1},
+				},
+				Request: access.Request{
+					Status:        access.PENDING,
+					ApprovalStage: 1,
+				},
+				AccessRule: multiStageRule,
+			},
+			withReviews: access.Reviews{{ReviewerID: "a", Decision: access.DecisionApproved}},
+			wantCreateGrantOpts: grantsvc.CreateGrantOpts{
+				Request: access.Request{
+					UpdatedAt:     clk.Now(),
+					Version:       1,
+					Status:        access.APPROVED,
+					ApprovalStage: 1,
+				},
+				AccessRule: multiStageRule,
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{
+					Status:        access.APPROVED,
+					ApprovalStage: 1,
+					Grant:         &access.Grant{},
+					UpdatedAt:     clk.Now(),
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Version:       2,
+					Status:        access.APPROVED,
+					ApprovalStage: 1,
+					UpdatedAt:     clk.Now(),
+					Grant:         &access.Grant{},
+				},
+			},
+		},
+		{
+			name: "reviewer for an earlier stage cannot review",
+			give: AddReviewOpts{
+				ReviewerID: "b",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "b", Request: access.Request{Status: access.PENDING}},
+				},
+				Request: access.Request{
+					Status:        access.PENDING,
+					ApprovalStage: 1,
+				},
+				AccessRule: multiStageRule,
+			},
+			wantErr: ErrUserNotAuthorized,
+		},
+		{
+			name: "reviewer cannot approve twice",
+			give: AddReviewOpts{
+				ReviewerID:      "a",
+				ReviewerIsAdmin: true,
+				Decision:        access.DecisionApproved,
+				Request: access.Request{
+					Status:        access.PENDING,
+					ApprovalStage: 1,
+				},
+				AccessRule: multiStageRule,
+			},
+			withReviews: access.Reviews{{ReviewerID: "a", Decision: access.DecisionApproved}},
+			wantErr:     ErrRequestAlreadyReviewed,
+		},
+		{
+			name: "delegate cannot review after the delegation has ended",
+			give: AddReviewOpts{
+				ReviewerID: "d",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "d", DelegatedBy: []string{"a"}, Request: access.Request{Status: access.PENDING}},
+				},
+				Request:    access.Request{Status: access.PENDING},
+				AccessRule: quorumRule,
+			},
+			withDelegations: []access.Delegation{{DelegatorID: "a", DelegateID: "d", StartTime: now.Add(-2 * time.Hour), EndTime: now.Add(-time.Hour)}},
+			wantErr:         ErrUserNotAuthorized,
+		},
+		{
+			name: "delegates of the same approver count as a single approval",
+			give: AddReviewOpts{
+				ReviewerID: "d",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "d", DelegatedBy: []string{"a"}, Request: access.Request{Status: access.PENDING}},
+					{ReviewerID: "e", DelegatedBy: []string{"a"}, Request: access.Request{Status: access.PENDING}},
+				},
+				Request:    access.Request{Status: access.PENDING},
+				AccessRule: quorumRule,
+			},
+			withReviews:     access.Reviews{{ReviewerID: "e", OnBehalfOf: []string{"a"}, Decision: access.DecisionApproved}},
+			withDelegations: []access.Delegation{{DelegatorID: "a", DelegateID: "d", StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)}},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Version:          1,
+					Status:           access.PENDING,
+					ApprovalProgress: &access.ApprovalProgress{Approvals: 1, Required: 2},
+					UpdatedAt:        clk.Now(),
+				},
+			},
+		},
+		{
+			name: "admin who submitted the request on behalf of another user cannot review",
+			give: AddReviewOpts{
+				ReviewerID:      "lead",
+				ReviewerIsAdmin: true,
+				Decision:        access.DecisionApproved,
+				Request: access.Request{
+					Status:      access.PENDING,
+					RequestedBy: "starter",
+					SubmittedBy: &lead,
+				},
+			},
+			wantErr: ErrUserNotAuthorized,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -264,10 +612,13 @@ func TestAddReview(t *testing.T) {
 			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 			c := ddbmock.New(t)
+			c.MockQuery(&storage.ListReviewsForRequest{Result: tc.withReviews})
 			c.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{})
 
 			// called by dbupdate.GetUpdateRequestItems
 			c.MockQuery(&storage.ListRequestReviewers{})
+			c.MockQuery(&storage.ListDelegationsForDelegator{Result: tc.withDelegations})
+			c.MockQuery(&storage.ListExclusiveRuleSets{})
 
 			s := Service{
 				Clock:       clk,
@@ -287,3 +638,110 @@ func TestAddReview(t *testing.T) {
 	}
 
 }
+
+func TestAddStageReviewers(t *testing.T) {
+	lead := "lead"
+	request := access.Request{
+		ID:            "req_1",
+		RequestedBy:   "starter",
+		SubmittedBy:   &lead,
+		ApprovalStage: 1,
+	}
+	reviewers := []access.Reviewer{{ReviewerID: "a", Request: request}}
+
+	c := ddbmock.New(t)
+	c.MockQuery(&storage.ListDelegationsForDelegator{})
+	s := Service{Clock: clock.NewMock(), DB: c}
+
+	got, err := s.addStageReviewers(context.Background(), request, reviewers, nil, rule.ApprovalStage{Users: []string{"starter", "lead", "b"}})
+	assert.NoError(t, err)
+
+	var ids []string
+	for _, r := range got {
+		ids = append(ids, r.ReviewerID)
+	}
+	// the requestor and the user who submitted the request aren't added as reviewers for the next stage.
+	assert.Equal(t, []string{"a", "b"}, ids)
+}
+
+func TestAddReviewRetriesConcurrentReviews(t *testing.T) {
+	type testcase struct {
+		name string
+		// reloaded is the request after it was updated by the concurrent review.
+		reloaded        access.Request
+		wantCreateGrant bool
+		want            access.Status
+	}
+
+	quorumRule := rule.AccessRule{
+		Approval: rule.Approval{Users: []string{"a", "b", "c"}, MinApprovals: 2},
+	}
+	testcases := []testcase{
+		{
+			name:            "approvals are counted again",
+			reloaded:        access.Request{ID: "req_1", Status: access.PENDING, Version: 1},
+			wantCreateGrant: true,
+			want:            access.APPROVED,
+		},
+		{
+			name:     "request was completed by the concurrent review",
+			reloaded: access.Request{ID: "req_1", Status: access.DECLINED, Version: 1},
+			want:     access.DECLINED,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			clk := clock.NewMock()
+			ctrl := gomock.NewController(t)
+
+			rw := mocks.NewMockRequestWriter(ctrl)
+			gomock.InOrder(
+				rw.EXPECT().PutRequest(gomock.Any(), gomock.Any()).Return(storage.ErrVersionConflict),
+				rw.EXPECT().PutRequest(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, r *access.Request) error {
+					// the retry updates the version of the request which was reloaded.
+					assert.Equal(t, 1, r.Version)
+					r.Version++
+					return nil
+				}).MaxTimes(1),
+			)
+			if tc.wantCreateGrant {
+				// the grant is saved on the request after access is granted.
+				rw.EXPECT().PutRequest(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, r *access.Request) error {
+					assert.Equal(t, 2, r.Version)
+					r.Version++
+					return nil
+				})
+			}
+
+			g := mocks.NewMockGranter(ctrl)
+			if tc.wantCreateGrant {
+				g.EXPECT().CreateGrant(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, opts grantsvc.CreateGrantOpts) (*access.Request, error) {
+					return &opts.Request, nil
+				})
+			}
+			ep := mocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+
+			c := ddbmock.New(t)
+			// the concurrent approval by "a" is listed when the reviews are loaded.
+			c.MockQuery(&storage.ListReviewsForRequest{Result: access.Reviews{{ID: "rev_a", ReviewerID: "a", Decision: access.DecisionApproved}}})
+			c.MockQuery(&storage.GetRequest{Result: &tc.reloaded})
+			c.MockQuery(&storage.ListRequestReviewers{})
+			c.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{})
+			c.MockQuery(&storage.ListExclusiveRuleSets{})
+
+			s := Service{Clock: clk, DB: c, Granter: g, EventPutter: ep, Requests: rw}
+			got, err := s.AddReviewAndGrantAccess(context.Background(), AddReviewOpts{
+				ReviewerID: "b",
+				Decision:   access.DecisionApproved,
+				Reviewers:  []access.Reviewer{{ReviewerID: "b"}},
+				// the request was loaded before "a" approved it.
+				Request:    access.Request{ID: "req_1", Status: access.PENDING},
+				AccessRule: quorumRule,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got.Request.Status)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package accesssvc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/common-fate/ddb"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// validateBreakGlass checks that the user may use break-glass access on the rule,
+// and that they have justified and acknowledged the request.
+func validateBreakGlass(in types.CreateRequestBreakGlass, accessRule rule.AccessRule, user *identity.User) error {
+	if !accessRule.CanBreakGlass(user.Groups) {
+		return ErrBreakGlassNotAllowed
+	}
+	if strings.TrimSpace(in.Justification) == "" {
+		return ErrBreakGlassJustificationRequired
+	}
+	if !in.Acknowledged {
+		return ErrBreakGlassNotAcknowledged
+	}
+	return nil
+}
+
+// addBreakGlassReview records the after-the-fact review of a break-glass request.
+// The request has already been approved, so an approving review only clears the review flag.
+// A declining review also revokes the grant if it is still pending or active.
+func (s *Service) addBreakGlassReview(ctx context.Context, opts AddReviewOpts) (*AddReviewResult, error) {
+	request := opts.Request
+
+	isAllowed := canReview(opts)
+	if !isAllowed {
+		return nil, ErrUserNotAuthorized
+	}
+
+	onBehalfOf, err := s.reviewDelegators(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	r := access.Review{
+		ID:            types.NewRequestReviewID(),
+		RequestID:     request.ID,
+		ReviewerID:    opts.ReviewerID,
+		Decision:      opts.Decision,
+		Comment:       opts.Comment,
+		ApprovalStage: request.ApprovalStage,
+		OnBehalfOf:    onBehalfOf,
+	}
+
+	if r.Decision == access.DecisionDECLINED && request.Grant != nil &&
+		(request.Grant.Status == ahTypes.GrantStatusACTIVE || request.Grant.Status == ahTypes.GrantStatusPENDING) {
+		// the grant is revoked before the request is updated, so that the
+		// review can be retried if revoking the grant fails.
+		revoked, err := s.Granter.RevokeGrant(ctx, grantsvc.RevokeGrantOpts{Request: request, RevokerID: opts.ReviewerID})
+		if err != nil {
+			return nil, err
+		}
+		request = *revoked
+	}
+
+	bg := *request.BreakGlass
+	bg.ReviewRequired = false
+	request.BreakGlass = &bg
+	request.UpdatedAt = s.Clock.Now()
+
+	// audit log event
+	fields := map[string]string{
+		"event":    "request.breakglass.reviewed",
+		"decision": string(r.Decision),
+	}
+	if len(r.OnBehalfOf) > 0 {
+		fields["onBehalfOf"] = strings.Join(r.OnBehalfOf, ",")
+	}
+	reqEvent := access.NewRecordedEvent(request.ID, &opts.ReviewerID, request.UpdatedAt, fields)
+	// the request is only updated if it hasn't been reviewed concurrently.
+	err = dbupdate.UpdateRequest(ctx, s.DB, s.Requests, &request, []ddb.Keyer{&r, &reqEvent}, dbupdate.WithReviewers(opts.Reviewers))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.EventPutter.Put(ctx, gevent.RequestReviewed{Request: request, ReviewerID: r.ReviewerID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddReviewResult{Request: request}, nil
+}
@@ -0,0 +1,290 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb/ddbmock"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	accessMocks "github.com/common-fate/granted-approvals/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateBreakGlassRequest(t *testing.T) {
+	type testcase struct {
+		name           string
+		giveUser       identity.User
+		giveBreakGlass types.CreateRequestBreakGlass
+		rule           *rule.AccessRule
+		wantReviewers  []string
+		wantErr        error
+	}
+
+	oncall := []string{"oncall"}
+	testcases := []testcase{
+		{
+			name:           "ok",
+			giveUser:       identity.User{ID: "a", Groups: []string{"a"}},
+			giveBreakGlass: types.CreateRequestBreakGlass{Justification: "incident 123", Acknowledged: true},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{Stages: []rule.ApprovalStage{
+					{Users: []string{"b"}},
+					{Users: []string{"c"}},
+				}},
+				BreakGlass: &types.BreakGlassConfig{Enabled: true},
+			},
+			// every approver is paged, not just the approvers for the first stage.
+			wantReviewers: []string{"b", "c"},
+		},
+		{
+			name:           "break-glass not enabled",
+			giveUser:       identity.User{ID: "a", Groups: []string{"a"}},
+			giveBreakGlass: types.CreateRequestBreakGlass{Justification: "incident 123", Acknowledged: true},
+			rule: &rule.AccessRule{
+				Groups:   []string{"a"},
+				Approval: rule.Approval{Users: []string{"b"}},
+			},
+			wantErr: ErrBreakGlassNotAllowed,
+		},
+		{
+			name:           "user not in break-glass group",
+			giveUser:       identity.User{ID: "a", Groups: []string{"a"}},
+			giveBreakGlass: types.CreateRequestBreakGlass{Justification: "incident 123", Acknowledged: true},
+			rule: &rule.AccessRule{
+				Groups:     []string{"a"},
+				Approval:   rule.Approval{Users: []string{"b"}},
+				BreakGlass: &types.BreakGlassConfig{Enabled: true, Groups: &oncall},
+			},
+			wantErr: ErrBreakGlassNotAllowed,
+		},
+		{
+			name:           "no justification",
+			giveUser:       identity.User{ID: "a", Groups: []string{"a"}},
+			giveBreakGlass: types.CreateRequestBreakGlass{Justification: "  ", Acknowledged: true},
+			rule: &rule.AccessRule{
+				Groups:     []string{"a"},
+				Approval:   rule.Approval{Users: []string{"b"}},
+				BreakGlass: &types.BreakGlassConfig{Enabled: true},
+			},
+			wantErr: ErrBreakGlassJustificationRequired,
+		},
+		{
+			name:           "not acknowledged",
+			giveUser:       identity.User{ID: "a", Groups: []string{"a"}},
+			giveBreakGlass: types.CreateRequestBreakGlass{Justification: "incident 123"},
+			rule: &rule.AccessRule{
+				Groups:     []string{"a"},
+				Approval:   rule.Approval{Users: []string{"b"}},
+				BreakGlass: &types.BreakGlassConfig{Enabled: true},
+			},
+			wantErr: ErrBreakGlassNotAcknowledged,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: tc.rule})
+			db.MockQuery(&storage.ListRequestReviewers{})
+			db.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{})
+			db.MockQuery(&storage.ListDelegationsForDelegator{})
+			db.MockQuery(&storage.ListExclusiveRuleSets{})
+
+			ctrl := gomock.NewController(t)
+			var events []string
+			ep := accessMocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, e gevent.EventTyper) error {
+				events = append(events, e.EventType())
+				return nil
+			}).AnyTimes()
+
+			g := accessMocks.NewMockGranter(ctrl)
+			g.EXPECT().ValidateGrant(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			g.EXPECT().CreateGrant(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, opts grantsvc.CreateGrantOpts) (*access.Request, error) {
+				opts.Request.Grant = &access.Grant{Status: ahTypes.GrantStatusPENDING}
+				return &opts.Request, nil
+			}).AnyTimes()
+
+			rs := accessMocks.NewMockAccessRuleService(ctrl)
+			rs.EXPECT().RequestArguments(gomock.Any(), gomock.Any()).Return(map[string]types.RequestArgument{}, nil).AnyTimes()
+
+			s := Service{
+				Clock:       clock.NewMock(),
+				DB:          db,
+				Granter:     g,
+				EventPutter: ep,
+				Rules:       rs,
+			}
+			got, err := s.CreateRequest(context.Background(), &tc.giveUser, types.CreateRequestRequest{BreakGlass: &tc.giveBreakGlass})
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, access.APPROVED, got.Request.Status)
+			assert.NotNil(t, got.Request.Grant)
+			assert.True(t, got.Request.IsBreakGlassReviewPending())
+			var reviewers []string
+			for _, r := range got.Reviewers {
+				reviewers = append(reviewers, r.ReviewerID)
+			}
+			assert.Equal(t, tc.wantReviewers, reviewers)
+			assert.Equal(t, []string{gevent.RequestCreatedType, gevent.RequestBreakGlassType}, events)
+		})
+	}
+}
+
+func TestAddBreakGlassReview(t *testing.T) {
+	type testcase struct {
+		name         string
+		giveDecision access.Decision
+		giveReviewer string
+		// giveDelegatedBy is set if the reviewer was added to the request as a delegate of these approvers.
+		giveDelegatedBy []string
+		withDelegations []access.Delegation
+		wantRevoke      bool
+		wantErr         error
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	testcases := []testcase{
+		{
+			name:         "approving clears the review flag",
+			giveDecision: access.DecisionApproved,
+			giveReviewer: "b",
+		},
+		{
+			name:         "declining revokes the grant",
+			giveDecision: access.DecisionDECLINED,
+			giveReviewer: "b",
+			wantRevoke:   true,
+		},
+		{
+			name:         "user is not a reviewer",
+			giveDecision: access.DecisionApproved,
+			giveReviewer: "c",
+			wantErr:      ErrUserNotAuthorized,
+		},
+		{
+			name:            "delegate reviews on behalf of an approver",
+			giveDecision:    access.DecisionApproved,
+			giveReviewer:    "d",
+			giveDelegatedBy: []string{"b"},
+			withDelegations: []access.Delegation{{DelegatorID: "b", DelegateID: "d", StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)}},
+		},
+		{
+			name:            "delegate cannot review after the delegation has ended",
+			giveDecision:    access.DecisionApproved,
+			giveReviewer:    "d",
+			giveDelegatedBy: []string{"b"},
+			withDelegations: []access.Delegation{{DelegatorID: "b", DelegateID: "d", StartTime: now.Add(-2 * time.Hour), EndTime: now.Add(-time.Hour)}},
+			wantErr:         ErrUserNotAuthorized,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := access.Request{
+				ID:          "req_123",
+				RequestedBy: "a",
+				Status:      access.APPROVED,
+				Grant:       &access.Grant{Status: ahTypes.GrantStatusACTIVE},
+				BreakGlass:  &access.BreakGlass{Justification: "incident 123", ReviewRequired: true},
+			}
+			reviewers := []access.Reviewer{{ReviewerID: "b", Request: request}}
+			if tc.giveDelegatedBy != nil {
+				reviewers = append(reviewers, access.Reviewer{ReviewerID: tc.giveReviewer, DelegatedBy: tc.giveDelegatedBy, Request: request})
+			}
+
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListDelegationsForDelegator{Result: tc.withDelegations})
+			ctrl := gomock.NewController(t)
+			ep := accessMocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			g := accessMocks.NewMockGranter(ctrl)
+			if tc.wantRevoke {
+				revoked := request
+				revoked.Grant = &access.Grant{Status: ahTypes.GrantStatusREVOKED}
+				g.EXPECT().RevokeGrant(gomock.Any(), grantsvc.RevokeGrantOpts{Request: request, RevokerID: tc.giveReviewer}).Return(&revoked, nil)
+			}
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				Granter:     g,
+				EventPutter: ep,
+			}
+			got, err := s.AddReviewAndGrantAccess(context.Background(), AddReviewOpts{
+				ReviewerID: tc.giveReviewer,
+				Reviewers:  reviewers,
+				Decision:   tc.giveDecision,
+				Request:    request,
+				AccessRule: rule.AccessRule{Approval: rule.Approval{Users: []string{"b"}}},
+			})
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, access.APPROVED, got.Request.Status)
+			assert.False(t, got.Request.IsBreakGlassReviewPending())
+			if tc.wantRevoke {
+				assert.Equal(t, ahTypes.GrantStatusREVOKED, got.Request.Grant.Status)
+			}
+		})
+	}
+}
+
+func TestBreakGlassBypassesLimits(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	one := 1
+	user := identity.User{ID: "a", Groups: []string{"a"}}
+	accessRule := rule.AccessRule{
+		ID:         "rul_123",
+		Groups:     []string{"a"},
+		Approval:   rule.Approval{Users: []string{"b"}},
+		BreakGlass: &types.BreakGlassConfig{Enabled: true},
+		Limits:     &types.RequestLimits{MaxActiveGrants: &one},
+	}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{Result: []access.Request{
+		// a scheduled grant, which counts towards the limit but doesn't overlap the break-glass request.
+		{ID: "req_scheduled", Rule: "rul_123", Status: access.APPROVED, Grant: &access.Grant{Start: now.Add(2 * time.Hour), End: now.Add(3 * time.Hour), Status: ahTypes.GrantStatusPENDING}},
+	}})
+	db.MockQuery(&storage.ListDelegationsForDelegator{})
+	db.MockQuery(&storage.ListExclusiveRuleSets{})
+
+	g := accessMocks.NewMockGranter(gomock.NewController(t))
+	g.EXPECT().ValidateGrant(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	s := Service{Clock: clk, DB: db, Granter: g}
+	in := types.CreateRequestRequest{BreakGlass: &types.CreateRequestBreakGlass{Justification: "incident 123", Acknowledged: true}}
+
+	// the request would exceed the limits of the rule if it wasn't a break-glass request.
+	_, err := s.prepareRequest(context.Background(), prepareRequestOpts{Actor: &user, Subject: &user, Rule: &accessRule, Input: types.CreateRequestRequest{}, Now: now})
+	assert.Error(t, err)
+
+	got, err := s.prepareRequest(context.Background(), prepareRequestOpts{Actor: &user, Subject: &user, Rule: &accessRule, Input: in, Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bypassed []string
+	for _, item := range got.items {
+		if e, ok := item.(*access.RequestEvent); ok && e.RecordedEvent != nil && (*e.RecordedEvent)["event"] == gevent.RequestBreakGlassType {
+			bypassed = append(bypassed, (*e.RecordedEvent)["limitBypassed"])
+		}
+	}
+	assert.Equal(t, []string{"you have 1 active grants for this access rule, the maximum is 1"}, bypassed)
+}
@@ -3,6 +3,7 @@ package accesssvc
 import (
 	"context"
 
+	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/storage"
@@ -23,35 +24,32 @@ func (s *Service) CancelRequest(ctx context.Context, opts CancelRequestOpts) err
 		return err
 	}
 	req := q.Result
-	originalStatus := req.Status
 	isAllowed := canCancel(opts, *req)
 	if !isAllowed {
 		return ErrUserNotAuthorized
 	}
-	canBeCancelled := isCancellable(*req)
-	if !canBeCancelled {
-		return ErrRequestCannotBeCancelled
-	}
 
-	req.Status = access.CANCELLED
-	req.UpdatedAt = s.Clock.Now()
-	// we need to save the Review, the updated Request in the database.
-	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, *req)
+	// the request is read again and checked if it was updated concurrently, such as by a review.
+	var reqEvent access.RequestEvent
+	err = dbupdate.RetryUpdateRequest(ctx, s.DB, s.Requests, req, func(r *access.Request) ([]ddb.Keyer, error) {
+		canBeCancelled := isCancellable(*r)
+		if !canBeCancelled {
+			return nil, ErrRequestCannotBeCancelled
+		}
+		originalStatus := r.Status
+		r.Status = access.CANCELLED
+		r.UpdatedAt = s.Clock.Now()
+		// audit log event
+		reqEvent = access.NewStatusChangeEvent(r.ID, r.UpdatedAt, &opts.CancellerID, originalStatus, r.Status)
+		return []ddb.Keyer{&reqEvent}, nil
+	})
 	if err != nil {
 		return err
 	}
-	// audit log event
-	reqEvent := access.NewStatusChangeEvent(req.ID, req.UpdatedAt, &opts.CancellerID, originalStatus, req.Status)
 
-	err = s.EventPutter.Put(ctx, gevent.RequestCancelled{Request: *req})
 	// In a future PR we will shift these events out to be triggered by dynamo db streams
 	// This will currently put the app in a strange state if this fails
-	if err != nil {
-		return err
-	}
-
-	items = append(items, &reqEvent)
-	return s.DB.PutBatch(ctx, items...)
+	return s.EventPutter.Put(ctx, gevent.RequestCancelled{Request: *req})
 }
 
 // users can cancel their own requests.
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
@@ -12,6 +14,7 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/policy"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
 	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
@@ -23,10 +26,18 @@ import (
 type CreateRequestResult struct {
 	Request   access.Request
 	Reviewers []access.Reviewer
+	// Others are the results for the other users, if the request was made on behalf of a group.
+	// A request is created for each user, so that each of them receives their own grant.
+	Others []CreateRequestResult
 }
 
 // CreateRequest creates a new request and saves it in the database.
 // Returns an error if the request is invalid.
+//
+// If the request is made on behalf of other users, a request is created for each of them.
+// Every request is validated before any are saved, so a request which is invalid for one of the users
+// prevents any of them from being created. Saving isn't transactional though: if saving fails partway
+// through, the requests saved before the failure (and their grants) remain, and are returned alongside the error.
 func (s *Service) CreateRequest(ctx context.Context, user *identity.User, in types.CreateRequestRequest) (*CreateRequestResult, error) {
 	log := logger.Get(ctx).With("user.id", user.ID)
 	q := storage.GetAccessRuleCurrent{ID: in.AccessRuleId}
@@ -52,17 +63,203 @@ func (s *Service) CreateRequest(ctx context.Context, user *identity.User, in typ
 	if err != nil {
 		return nil, err
 	}
-	err = validateRequest(in, rule, requestArguments)
+	// the default duration of the rule is used if the request doesn't specify a duration.
+	if in.Timing.DurationSeconds == 0 && rule.TimeConstraints.DefaultDurationSeconds != nil {
+		in.Timing.DurationSeconds = *rule.TimeConstraints.DefaultDurationSeconds
+	}
+	err = validateRequest(in, rule, requestArguments, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// break-glass requests bypass approval, so the user must be eligible,
+	// and must justify and acknowledge the request.
+	isBreakGlass := in.BreakGlass != nil
+	if isBreakGlass {
+		err = validateBreakGlass(*in.BreakGlass, *rule, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// recurring requests are reviewed once for every occurrence up to the horizon of the rule.
+	rec, err := requestRecurrence(in, *rule, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// the users who will receive access. Unless the request is made on behalf of others, this is the requesting user.
+	subjects := []identity.User{*user}
+	if in.OnBehalfOf != nil {
+		// break-glass access is personal, as the user acknowledges that they will be held accountable for it.
+		if isBreakGlass {
+			return nil, ErrBreakGlassOnBehalfOf
+		}
+		subjects, err = s.requestSubjects(ctx, *in.OnBehalfOf)
+		if err != nil {
+			return nil, err
+		}
+		for _, subject := range subjects {
+			log.Debugw("verifying subject belongs to access rule groups", "subject.id", subject.ID, "subject.groups", subject.Groups)
+			if groupMatches(rule.Groups, subject.Groups) != nil {
+				return nil, SubjectNotEligibleError{UserID: subject.ID}
+			}
+		}
+		err = s.canRequestOnBehalfOf(ctx, user, rule, subjects)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	formData, err := parseFormData(rule.FormFields, in.FormData)
 	if err != nil {
 		return nil, err
 	}
 
+	// the ticket linked to the request is verified against the ticketing system of the rule.
+	// When requesting on behalf of others, the ticket belongs to the requesting user.
+	var tkt *access.Ticket
+	if rule.Ticket != nil {
+		tkt, err = s.checkTicket(ctx, user, *rule.Ticket, in.TicketId, isBreakGlass)
+		if err != nil {
+			return nil, err
+		}
+	} else if in.TicketId != nil && *in.TicketId != "" {
+		return nil, ticketError("tickets can't be linked to requests for this access rule")
+	}
+
+	var prepared []preparedRequest
+	for i := range subjects {
+		p, err := s.prepareRequest(ctx, prepareRequestOpts{
+			Actor:            user,
+			Subject:          &subjects[i],
+			Rule:             rule,
+			RequestArguments: requestArguments,
+			Input:            in,
+			FormData:         formData,
+			Ticket:           tkt,
+			Recurrence:       rec,
+			Now:              now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		prepared = append(prepared, *p)
+	}
+
+	var res *CreateRequestResult
+	var saved []string
+	for _, p := range prepared {
+		r, err := s.saveRequest(ctx, *rule, p)
+		if err != nil {
+			if len(saved) > 0 {
+				log.Errorw("error saving request, some requests were already created", "saved.request.ids", saved, "error", err)
+			}
+			return res, err
+		}
+		saved = append(saved, r.Request.ID)
+		if res == nil {
+			res = r
+		} else {
+			res.Others = append(res.Others, *r)
+		}
+	}
+	return res, nil
+}
+
+type prepareRequestOpts struct {
+	// Actor is the user making the request.
+	Actor *identity.User
+	// Subject is the user who will receive access. It is the same as Actor unless the request is made on behalf of others.
+	Subject          *identity.User
+	Rule             *rule.AccessRule
+	RequestArguments map[string]types.RequestArgument
+	Input            types.CreateRequestRequest
+	FormData         []access.FormFieldValue
+	Ticket           *access.Ticket
+	// Recurrence is set if the request recurs.
+	Recurrence *access.Recurrence
+	Now        time.Time
+}
+
+// preparedRequest is a validated request which is ready to be saved.
+type preparedRequest struct {
+	req          access.Request
+	reviewers    []access.Reviewer
+	items        []ddb.Keyer
+	autoApprove  bool
+	isBreakGlass bool
+}
+
+// prepareRequest validates a request for a single subject against the access rule
+// and builds the request, its reviewers and its audit log events.
+func (s *Service) prepareRequest(ctx context.Context, opts prepareRequestOpts) (*preparedRequest, error) {
+	user := opts.Subject
+	rule := opts.Rule
+	in := opts.Input
+	now := opts.Now
+	log := logger.Get(ctx).With("user.id", user.ID)
+	isBreakGlass := in.BreakGlass != nil
+
+	// break-glass requests are for emergencies, so they aren't blocked by the request limits of the rule.
+	// The limit which would have blocked the request is recorded in the audit log instead.
+	var limitBypassed string
+	err := s.checkLimits(ctx, user.ID, *rule, "", now)
+	var limitErr *apio.APIError
+	if isBreakGlass && errors.As(err, &limitErr) && len(limitErr.Fields) > 0 {
+		limitBypassed = limitErr.Fields[0].Error
+		log.Infow("break-glass request bypassed request limits", "limit", limitBypassed)
+	} else if err != nil {
+		return nil, err
+	}
+
+	// the user can't hold grants from mutually exclusive access rules at the same time.
+	timings := []access.Timing{access.TimingFromRequestTiming(in.Timing)}
+	if opts.Recurrence != nil {
+		timings = opts.Recurrence.Timings(timings[0].Duration)
+	}
+	for _, timing := range timings {
+		start, end := timing.GetInterval(access.WithNow(now))
+		err = s.checkExclusiveRules(ctx, user.ID, rule.ID, start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// break-glass requests bypass approval, so the policy of the rule only applies to other requests.
+	decision := policy.Review
+	if rule.Policy != nil && !isBreakGlass {
+		var with map[string]string
+		if in.With != nil {
+			with = in.With.AdditionalProperties
+		}
+		decision, err = s.evaluatePolicy(ctx, user, *rule, with, access.TimingFromRequestTiming(in.Timing), now)
+		if err != nil {
+			return nil, err
+		}
+		log.Infow("evaluated access rule policy", "decision", decision)
+		if decision == policy.Deny {
+			return nil, ErrRequestDeniedByPolicy
+		}
+	}
+
+	// the on-call schedule of the rule can approve requests from on-call users, or route review to them.
+	var onCall onCallResult
+	if rule.OnCall != nil && !isBreakGlass {
+		onCall, err = s.checkOnCall(ctx, user, *rule.OnCall)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// the request is valid, so create it.
 	req := access.Request{
 		ID:          types.NewRequestID(),
 		RequestedBy: user.ID,
 		Data: access.RequestData{
-			Reason: in.Reason,
+			Reason:   in.Reason,
+			FormData: opts.FormData,
+			Ticket:   opts.Ticket,
 		},
 		CreatedAt:       now,
 		UpdatedAt:       now,
@@ -72,9 +269,17 @@ func (s *Service) CreateRequest(ctx context.Context, user *identity.User, in typ
 		RuleVersion:     rule.Version,
 		SelectedWith:    make(map[string]access.Option),
 	}
+	if opts.Actor.ID != user.ID {
+		req.SubmittedBy = &opts.Actor.ID
+	}
+	if opts.Recurrence != nil {
+		// each subject has their own copy, as the requests created for each occurrence are added to it.
+		rec := *opts.Recurrence
+		req.Recurrence = &rec
+	}
 	if in.With != nil && in.With.AdditionalProperties != nil {
 		for k, v := range in.With.AdditionalProperties {
-			argument := requestArguments[k]
+			argument := opts.RequestArguments[k]
 			found := false
 			for _, option := range argument.Options {
 				// because validation has passed, we can have certainty that the matching value will be found here
@@ -103,18 +308,56 @@ func (s *Service) CreateRequest(ctx context.Context, user *identity.User, in typ
 		return nil, err
 	}
 
-	// If the approval is not required, auto-approve the request
+	if isBreakGlass {
+		// the request must be reviewed afterwards by an approver, if the rule has any.
+		req.BreakGlass = &access.BreakGlass{
+			Justification:  in.BreakGlass.Justification,
+			AcknowledgedAt: now,
+			ReviewRequired: rule.Approval.IsRequired(),
+		}
+	}
+
+	// If the approval is not required, or break-glass access was requested, auto-approve the request.
+	autoApprove := isBreakGlass || isAutoApproved(*rule, decision, onCall)
+	policyApproved := rule.Policy != nil && !isBreakGlass && decision == policy.Approve
 	auto := types.AUTOMATIC
 	revd := types.REVIEWED
 
-	if !rule.Approval.IsRequired() {
+	if autoApprove {
 		req.Status = access.APPROVED
 		req.ApprovalMethod = &auto
 	} else {
 		req.ApprovalMethod = &revd
+		req.ApprovalProgress = approvalProgress(rule.Approval.GetStages()[0], 0)
+	}
+
+	// for multi-stage approvals, only the approvers for the first stage are added as reviewers.
+	// Reviewers for later stages are added as each stage is approved.
+	approvers, err := rulesvc.GetStageApprovers(ctx, s.DB, rule.Approval.GetStages()[0])
+	if err != nil {
+		return nil, err
+	}
+	// requests approved by the policy or the on-call schedule don't need to be reviewed.
+	if policyApproved || onCall.approved {
+		approvers = nil
+	} else {
+		// the on-call users review the request alongside the approvers for the first stage.
+		for _, u := range onCall.approvers {
+			if !contains(approvers, u) {
+				approvers = append(approvers, u)
+			}
+		}
+	}
+	// every approver is notified of break-glass requests, and any of them may review the request afterwards.
+	if isBreakGlass {
+		approvers, err = rulesvc.GetApprovers(ctx, s.DB, *rule)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	approvers, err := rulesvc.GetApprovers(ctx, s.DB, *rule)
+	// approvers who are away may have delegated their reviews to another user.
+	approvers, delegatedBy, err := rulesvc.WithDelegates(ctx, s.DB, req.RequestedBy, approvers, now)
 	if err != nil {
 		return nil, err
 	}
@@ -125,51 +368,104 @@ func (s *Service) CreateRequest(ctx context.Context, user *identity.User, in typ
 	// create Reviewers for each approver in the Access Rule. Reviewers will see the request in the End User portal.
 	var reviewers []access.Reviewer
 	for _, u := range approvers {
-		// users cannot approve their own requests.
+		// users cannot approve their own requests, or requests which they made on behalf of others.
 		// We don't create a Reviewer for them, even if they are an approver on the Access Rule.
-		if u == req.RequestedBy {
+		if u == req.RequestedBy || u == opts.Actor.ID {
 			continue
 		}
 
 		r := access.Reviewer{
-			ReviewerID: u,
-			Request:    req,
+			ReviewerID:  u,
+			Request:     req,
+			DelegatedBy: delegatedBy[u],
 		}
 
 		reviewers = append(reviewers, r)
 		items = append(items, &r)
 	}
 
-	log.Debugw("saving request", "request", req, "reviewers", reviewers)
+	log.Debugw("prepared request", "request", req, "reviewers", reviewers)
 
-	// audit log event
-	reqEvent := access.NewRequestCreatedEvent(req.ID, req.CreatedAt, &req.RequestedBy)
+	// audit log events record the user who made the request, even if it was made on behalf of another user.
+	actor := opts.Actor.ID
+	reqEvent := access.NewRequestCreatedEvent(req.ID, req.CreatedAt, &actor)
 
 	//before saving the request check to see if there already is a active approved rule
-	if !rule.Approval.IsRequired() {
-		start, end := req.GetInterval(access.WithNow(s.Clock.Now()))
+	if autoApprove {
+		err = s.checkOverlappingGrants(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-		rq := storage.ListRequestsForUserAndRuleAndRequestend{
-			UserID:               req.RequestedBy,
-			RuleID:               req.Rule,
-			RequestEndComparator: storage.GreaterThanEqual,
-			CompareTo:            end,
+	items = append(items, &reqEvent)
+	if req.SubmittedBy != nil {
+		// audit log event
+		obEvent := access.NewRecordedEvent(req.ID, &actor, now, map[string]string{
+			"event":      "request.on_behalf_of",
+			"onBehalfOf": req.RequestedBy,
+		})
+		items = append(items, &obEvent)
+	}
+	if req.Recurrence != nil {
+		// audit log event
+		recEvent := access.NewRecordedEvent(req.ID, &actor, now, map[string]string{
+			"event":       "request.recurrence",
+			"rule":        req.Recurrence.Rule,
+			"occurrences": strconv.Itoa(len(req.Recurrence.Occurrences)),
+		})
+		items = append(items, &recEvent)
+	}
+	if len(opts.FormData) > 0 {
+		// audit log event
+		fields := map[string]string{"event": "request.form_submitted"}
+		for _, v := range opts.FormData {
+			fields["field."+v.ID] = v.Value
 		}
-		_, err := s.DB.Query(ctx, &rq)
-		if err != nil && err != ddb.ErrNoItems {
-			return nil, err
+		formEvent := access.NewRecordedEvent(req.ID, &actor, now, fields)
+		items = append(items, &formEvent)
+	}
+	if tkt := opts.Ticket; tkt != nil {
+		// audit log event
+		ticketEvent := access.NewRecordedEvent(req.ID, &actor, now, map[string]string{
+			"event":         "request.ticket_linked",
+			"ticket.system": tkt.System,
+			"ticket.id":     tkt.ID,
+			"ticket.status": tkt.Status,
+			"ticket.url":    tkt.URL,
+		})
+		items = append(items, &ticketEvent)
+	}
+	if isBreakGlass {
+		// audit log event
+		fields := map[string]string{
+			"event":         gevent.RequestBreakGlassType,
+			"justification": req.BreakGlass.Justification,
 		}
-		// This will check against the requests which do have grants already
-		overlaps := overlapsExistingGrant(start, end, rq.Result)
-		if overlaps {
-			return nil, ErrRequestOverlapsExistingGrant
+		if limitBypassed != "" {
+			fields["limitBypassed"] = limitBypassed
 		}
-
+		bgEvent := access.NewRecordedEvent(req.ID, &actor, now, fields)
+		items = append(items, &bgEvent)
 	}
 
-	items = append(items, &reqEvent)
+	return &preparedRequest{
+		req:          req,
+		reviewers:    reviewers,
+		items:        items,
+		autoApprove:  autoApprove,
+		isBreakGlass: isBreakGlass,
+	}, nil
+}
+
+// saveRequest saves a prepared request and its reviewers, and creates the grant if the request was approved automatically.
+func (s *Service) saveRequest(ctx context.Context, rule rule.AccessRule, p preparedRequest) (*CreateRequestResult, error) {
+	log := logger.Get(ctx).With("user.id", p.req.RequestedBy)
+	req := p.req
+
+	log.Debugw("saving request", "request", req, "reviewers", p.reviewers)
 	// save the request.
-	err = s.DB.PutBatch(ctx, items...)
+	err := s.DB.PutBatch(ctx, p.items...)
 	if err != nil {
 		return nil, err
 	}
@@ -181,20 +477,23 @@ func (s *Service) CreateRequest(ctx context.Context, user *identity.User, in typ
 		return nil, err
 	}
 
-	// check to see if it valid for instant approval
-	if !rule.Approval.IsRequired() {
-
-		log.Debugw("auto-approving", "request", req, "reviewers", reviewers)
-		updatedReq, err := s.Granter.CreateGrant(ctx, grantsvc.CreateGrantOpts{Request: req, AccessRule: *rule})
+	if p.isBreakGlass {
+		err = s.EventPutter.Put(ctx, gevent.RequestBreakGlass{Request: req})
 		if err != nil {
 			return nil, err
 		}
-		req = *updatedReq
-		items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, req, dbupdate.WithReviewers(reviewers))
+	}
+
+	// check to see if it valid for instant approval
+	if p.autoApprove {
+
+		log.Debugw("auto-approving", "request", req, "reviewers", p.reviewers)
+		updatedReq, err := s.Granter.CreateGrant(ctx, grantsvc.CreateGrantOpts{Request: req, AccessRule: rule})
 		if err != nil {
 			return nil, err
 		}
-		err = s.DB.PutBatch(ctx, items...)
+		req = *updatedReq
+		err = dbupdate.UpdateRequest(ctx, s.DB, s.Requests, &req, nil, dbupdate.WithReviewers(p.reviewers))
 		if err != nil {
 			return nil, err
 		}
@@ -202,7 +501,7 @@ func (s *Service) CreateRequest(ctx context.Context, user *identity.User, in typ
 
 	res := CreateRequestResult{
 		Request:   req,
-		Reviewers: reviewers,
+		Reviewers: p.reviewers,
 	}
 
 	return &res, nil
@@ -221,18 +520,14 @@ func groupMatches(ruleGroups []string, userGroups []string) error {
 
 // requestIsValid checks that the request meets the constraints of the rule
 // Add additional constraint checks here in this method.
-func validateRequest(request types.CreateRequestRequest, rule *rule.AccessRule, requestArguments map[string]types.RequestArgument) error {
-	if request.Timing.DurationSeconds > rule.TimeConstraints.MaxDurationSeconds {
-		return &apio.APIError{
-			Err:    errors.New("request validation failed"),
-			Status: http.StatusBadRequest,
-			Fields: []apio.FieldError{
-				{
-					Field: "timing.durationSeconds",
-					Error: fmt.Sprintf("durationSeconds: %d exceeds the maximum duration seconds: %d", request.Timing.DurationSeconds, rule.TimeConstraints.MaxDurationSeconds),
-				},
-			},
-		}
+func validateRequest(request types.CreateRequestRequest, rule *rule.AccessRule, requestArguments map[string]types.RequestArgument, now time.Time) error {
+	err := validateTiming(rule.TimeConstraints, access.TimingFromRequestTiming(request.Timing), now, "timing")
+	if err != nil {
+		return err
+	}
+	_, err = parseFormData(rule.FormFields, request.FormData)
+	if err != nil {
+		return err
 	}
 
 	given := make(map[string]string)
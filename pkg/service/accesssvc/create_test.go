@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/ddb/ddbmock"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/identity"
 	"github.com/common-fate/granted-approvals/pkg/rule"
@@ -38,11 +40,26 @@ func TestNewRequest(t *testing.T) {
 		withGetGroupResponse         *storage.GetGroup
 		withRequestArgumentsResponse map[string]types.RequestArgument
 		wantValidationError          error
+		withOnCallEmails             []string
+		withOnCallErr                error
+		withGetUserByEmailResponse   *storage.GetUserByEmail
+		withDelegations              []access.Delegation
+		withExclusiveRuleSets        []rule.ExclusiveRuleSet
+		withUserRequests             []access.Request
+		withGetUserResponse          *storage.GetUser
 	}
 
 	clk := clock.NewMock()
 	autoApproval := types.AUTOMATIC
 	reviewed := types.REVIEWED
+	approvePolicy := `"a" in user.groups ? "approve" : "review"`
+	denyPolicy := `"deny"`
+	reviewPolicy := `"review"`
+	// the request doesn't include the "env" argument, so the policy fails to evaluate.
+	failingPolicy := `with["env"] == "dev" ? "approve" : "deny"`
+	yes := true
+	lead := "lead"
+	starter := "starter"
 	testcases := []testcase{
 		{
 			name: "ok, no approvers so should auto approve",
@@ -59,6 +76,7 @@ func TestNewRequest(t *testing.T) {
 					UpdatedAt:      clk.Now(),
 					Grant:          &access.Grant{},
 					ApprovalMethod: &autoApproval,
+					Version:        1,
 					SelectedWith:   make(map[string]access.Option),
 				},
 			},
@@ -231,6 +249,253 @@ func TestNewRequest(t *testing.T) {
 			},
 			withRequestArgumentsResponse: map[string]types.RequestArgument{},
 		},
+		{
+			name:     "policy approves request on rule with approvers",
+			giveUser: identity.User{Groups: []string{"a"}},
+			rule: &rule.AccessRule{
+				Groups:   []string{"a"},
+				Approval: rule.Approval{Users: []string{"b"}},
+				Policy:   &approvePolicy,
+			},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					Status:         access.APPROVED,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &autoApproval,
+					Version:        1,
+					SelectedWith:   make(map[string]access.Option),
+				},
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{
+					ID:             "-",
+					Status:         access.APPROVED,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &autoApproval,
+					SelectedWith:   make(map[string]access.Option),
+				},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
+		{
+			name:     "policy denies request",
+			giveUser: identity.User{Groups: []string{"a"}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Policy: &denyPolicy,
+			},
+			wantErr:                      ErrRequestDeniedByPolicy,
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
+		{
+			name:     "policy requires review on rule without approvers",
+			giveUser: identity.User{Groups: []string{"a"}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Policy: &reviewPolicy,
+			},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+					SelectedWith:   make(map[string]access.Option),
+				},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
+		{
+			name:     "policy which fails to evaluate falls back to review",
+			giveUser: identity.User{Groups: []string{"a"}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Policy: &failingPolicy,
+			},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+					SelectedWith:   make(map[string]access.Option),
+				},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
+		{
+			name:     "on-call requestor is auto approved",
+			giveUser: identity.User{ID: "a", Email: "a@acme.com", Groups: []string{"a"}},
+			rule: &rule.AccessRule{
+				Groups:   []string{"a"},
+				Approval: rule.Approval{Users: []string{"b"}},
+				OnCall:   &types.OnCallConfig{Source: types.Pagerduty, ScheduleId: "P1", AutoApprove: &yes},
+			},
+			withOnCallEmails: []string{"A@acme.com"},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					RequestedBy:    "a",
+					Status:         access.APPROVED,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &autoApproval,
+					Version:        1,
+					SelectedWith:   make(map[string]access.Option),
+				},
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{
+					ID:             "-",
+					RequestedBy:    "a",
+					Status:         access.APPROVED,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &autoApproval,
+					SelectedWith:   make(map[string]access.Option),
+				},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
+		{
+			name:     "review is routed to on-call users",
+			giveUser: identity.User{ID: "a", Email: "a@acme.com", Groups: []string{"a"}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				OnCall: &types.OnCallConfig{Source: types.Opsgenie, ScheduleId: "ops", RouteReview: &yes},
+			},
+			withOnCallEmails:           []string{"c@acme.com"},
+			withGetUserByEmailResponse: &storage.GetUserByEmail{Result: &identity.User{ID: "c", Status: types.IdpStatusACTIVE}},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					RequestedBy:    "a",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+					SelectedWith:   make(map[string]access.Option),
+				},
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "c",
+						Request: access.Request{
+							ID:             "-",
+							RequestedBy:    "a",
+							Status:         access.PENDING,
+							CreatedAt:      clk.Now(),
+							UpdatedAt:      clk.Now(),
+							ApprovalMethod: &reviewed,
+							SelectedWith:   make(map[string]access.Option),
+						},
+					},
+				},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
+		{
+			name:     "on-call lookup failure requires review",
+			giveUser: identity.User{ID: "a", Email: "a@acme.com", Groups: []string{"a"}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				OnCall: &types.OnCallConfig{Source: types.Pagerduty, ScheduleId: "P1", AutoApprove: &yes, RouteReview: &yes},
+			},
+			withOnCallErr: errors.New("pagerduty is unavailable"),
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					RequestedBy:    "a",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+					SelectedWith:   make(map[string]access.Option),
+				},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
+		{
+			name:     "delegate of an away approver reviews on their behalf",
+			giveUser: identity.User{ID: "a", Groups: []string{"a"}},
+			rule: &rule.AccessRule{
+				Groups:   []string{"a"},
+				Approval: rule.Approval{Users: []string{"b"}},
+			},
+			withDelegations: []access.Delegation{
+				{DelegatorID: "b", DelegateID: "c", StartTime: clk.Now(), EndTime: clk.Now().Add(time.Hour)},
+				// the requester is never added as a delegate.
+				{DelegatorID: "b", DelegateID: "a", StartTime: clk.Now(), EndTime: clk.Now().Add(time.Hour)},
+			},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					RequestedBy:    "a",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+					SelectedWith:   make(map[string]access.Option),
+				},
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "b",
+						Request: access.Request{
+							ID:             "-",
+							RequestedBy:    "a",
+							Status:         access.PENDING,
+							CreatedAt:      clk.Now(),
+							UpdatedAt:      clk.Now(),
+							ApprovalMethod: &reviewed,
+							SelectedWith:   make(map[string]access.Option),
+						},
+					},
+					{
+						ReviewerID: "c",
+						Request: access.Request{
+							ID:             "-",
+							RequestedBy:    "a",
+							Status:         access.PENDING,
+							CreatedAt:      clk.Now(),
+							UpdatedAt:      clk.Now(),
+							ApprovalMethod: &reviewed,
+							SelectedWith:   make(map[string]access.Option),
+						},
+						DelegatedBy: []string{"b"},
+					},
+				},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
+		{
+			name:     "request overlaps a grant from a mutually exclusive rule",
+			giveUser: identity.User{ID: "a", Groups: []string{"a"}},
+			rule: &rule.AccessRule{
+				ID:     "rul_deploy",
+				Groups: []string{"a"},
+			},
+			withExclusiveRuleSets: []rule.ExclusiveRuleSet{
+				{Name: "prod changes", RuleIDs: []string{"rul_deploy", "rul_approve"}},
+			},
+			withUserRequests: []access.Request{
+				{
+					ID:     "req_approve",
+					Rule:   "rul_approve",
+					Status: access.APPROVED,
+					Grant:  &access.Grant{Status: ahTypes.GrantStatusACTIVE, Start: clk.Now().Add(-time.Hour), End: clk.Now().Add(time.Hour)},
+				},
+			},
+			wantErr:                      ExclusiveRuleConflictError{SetName: "prod changes", ConflictingRuleID: "rul_approve", ConflictingRequestID: "req_approve"},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
 		{
 			name: "failed validation should not create request",
 			//just passing the group here, technically a user isnt an approver
@@ -254,6 +519,89 @@ func TestNewRequest(t *testing.T) {
 			wantErr:                      fmt.Errorf("unexpected response while validating grant"),
 			withRequestArgumentsResponse: map[string]types.RequestArgument{},
 		},
+		{
+			name:     "on behalf of another user",
+			giveUser: identity.User{ID: lead, Groups: []string{"a"}},
+			giveInput: types.CreateRequestRequest{
+				OnBehalfOf: &types.CreateRequestOnBehalfOf{UserId: &starter},
+			},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{lead, "b"},
+				},
+			},
+			withGetUserResponse: &storage.GetUser{Result: &identity.User{ID: starter, Groups: []string{"a"}, Status: types.IdpStatusACTIVE}},
+			// the user who made the request on behalf of the starter should not review it.
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					RequestedBy:    starter,
+					SubmittedBy:    &lead,
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+					SelectedWith:   make(map[string]access.Option),
+				},
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "b",
+						Request: access.Request{
+							ID:             "-",
+							RequestedBy:    starter,
+							SubmittedBy:    &lead,
+							Status:         access.PENDING,
+							CreatedAt:      clk.Now(),
+							UpdatedAt:      clk.Now(),
+							ApprovalMethod: &reviewed,
+							SelectedWith:   make(map[string]access.Option),
+						},
+					},
+				},
+			},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
+		{
+			name:     "on behalf of a user who isn't in a matching group",
+			giveUser: identity.User{ID: lead, Groups: []string{"a"}},
+			giveInput: types.CreateRequestRequest{
+				OnBehalfOf: &types.CreateRequestOnBehalfOf{UserId: &starter},
+			},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+			},
+			withGetUserResponse:          &storage.GetUser{Result: &identity.User{ID: starter, Groups: []string{"b"}, Status: types.IdpStatusACTIVE}},
+			wantErr:                      SubjectNotEligibleError{UserID: starter},
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
+		{
+			name:     "on behalf of a user who doesn't share a group of the rule",
+			giveUser: identity.User{ID: lead, Groups: []string{"a"}},
+			giveInput: types.CreateRequestRequest{
+				OnBehalfOf: &types.CreateRequestOnBehalfOf{UserId: &starter},
+			},
+			rule: &rule.AccessRule{
+				Groups: []string{"a", "b"},
+			},
+			withGetUserResponse:          &storage.GetUser{Result: &identity.User{ID: starter, Groups: []string{"b"}, Status: types.IdpStatusACTIVE}},
+			wantErr:                      ErrOnBehalfOfNotAllowed,
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
+		{
+			name:     "break-glass on behalf of another user",
+			giveUser: identity.User{ID: lead, Groups: []string{"a"}},
+			giveInput: types.CreateRequestRequest{
+				OnBehalfOf: &types.CreateRequestOnBehalfOf{UserId: &starter},
+				BreakGlass: &types.CreateRequestBreakGlass{Justification: "outage", Acknowledged: true},
+			},
+			rule: &rule.AccessRule{
+				Groups:     []string{"a"},
+				BreakGlass: &types.BreakGlassConfig{Enabled: true},
+			},
+			wantErr:                      ErrBreakGlassOnBehalfOf,
+			withRequestArgumentsResponse: map[string]types.RequestArgument{},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -263,6 +611,11 @@ func TestNewRequest(t *testing.T) {
 			db.MockQuery(tc.withGetGroupResponse)
 			db.MockQuery(&storage.ListRequestReviewers{})
 			db.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{})
+			db.MockQuery(tc.withGetUserByEmailResponse)
+			db.MockQuery(&storage.ListDelegationsForDelegator{Result: tc.withDelegations})
+			db.MockQuery(&storage.ListExclusiveRuleSets{Result: tc.withExclusiveRuleSets})
+			db.MockQuery(&storage.ListRequestsForUserAndRequestend{Result: tc.withUserRequests})
+			db.MockQuery(tc.withGetUserResponse)
 			ctrl := gomock.NewController(t)
 
 			defer ctrl.Finish()
@@ -281,6 +634,10 @@ func TestNewRequest(t *testing.T) {
 			if tc.withRequestArgumentsResponse != nil {
 				rs.EXPECT().RequestArguments(gomock.Any(), tc.rule.Target).Return(tc.withRequestArgumentsResponse, nil)
 			}
+			oc := accessMocks.NewMockOnCallResolver(ctrl)
+			if tc.rule != nil && tc.rule.OnCall != nil {
+				oc.EXPECT().OnCallEmails(gomock.Any(), string(tc.rule.OnCall.Source), tc.rule.OnCall.ScheduleId).Return(tc.withOnCallEmails, tc.withOnCallErr)
+			}
 			s := Service{
 				Clock:       clk,
 				DB:          db,
@@ -288,6 +645,7 @@ func TestNewRequest(t *testing.T) {
 				EventPutter: ep,
 				Cache:       ca,
 				Rules:       rs,
+				OnCall:      oc,
 			}
 			got, err := s.CreateRequest(context.Background(), &tc.giveUser, tc.giveInput)
 			if got != nil {
@@ -22,8 +22,36 @@ var (
 	// ErrRequestCannotBeCancelled is returned if the request is not in the pending status
 	ErrRequestCannotBeCancelled = errors.New("only pending requests can be cancelled")
 
+	// ErrRequestCannotBeExtended is returned if the request doesn't have a pending or active grant to extend
+	ErrRequestCannotBeExtended = errors.New("only requests with a pending or active grant can be extended")
+
 	// ErrRequestOverlapsExistingGrant is returned if the request overlaps an existing grant
 	ErrRequestOverlapsExistingGrant = errors.New("this request overlaps an existing grant")
+
+	// ErrBreakGlassNotAllowed is returned if the access rule doesn't have break-glass enabled,
+	// or the user isn't in a group which may use break-glass access.
+	ErrBreakGlassNotAllowed = errors.New("break-glass access is not allowed for this user on the access rule")
+
+	// ErrBreakGlassJustificationRequired is returned if a break-glass request doesn't include a justification.
+	ErrBreakGlassJustificationRequired = errors.New("a justification is required for break-glass access")
+
+	// ErrBreakGlassNotAcknowledged is returned if the user hasn't acknowledged that
+	// their break-glass request will be reviewed after the fact.
+	ErrBreakGlassNotAcknowledged = errors.New("break-glass access must be acknowledged")
+
+	// ErrRequestAlreadyReviewed is returned if a reviewer tries to review a request they have already reviewed.
+	// In multi-stage approvals, a reviewer may only approve a single stage.
+	ErrRequestAlreadyReviewed = errors.New("reviewer has already reviewed this request")
+
+	// ErrRequestDeniedByPolicy is returned if the policy of the access rule denies the request.
+	ErrRequestDeniedByPolicy = errors.New("the request was denied by the access rule's policy")
+
+	// ErrOnBehalfOfNotAllowed is returned if a user requests access on behalf of others who they don't share
+	// a group of the access rule with, and they aren't an administrator or an approver of the access rule.
+	ErrOnBehalfOfNotAllowed = errors.New("user is not allowed to request access on behalf of these users")
+
+	// ErrBreakGlassOnBehalfOf is returned if break-glass access is requested on behalf of other users.
+	ErrBreakGlassOnBehalfOf = errors.New("break-glass access can't be requested on behalf of other users")
 )
 
 // InvalidStatusError is returned if a user tries to review a request which wasn't PENDING.
@@ -34,3 +62,28 @@ type InvalidStatusError struct {
 func (e InvalidStatusError) Error() string {
 	return fmt.Sprintf("request has invalid status: %s", e.Status)
 }
+
+// ExclusiveRuleConflictError is returned if a request would give a user access at the same time
+// as a grant from a mutually exclusive Access Rule.
+type ExclusiveRuleConflictError struct {
+	// SetName is the name of the exclusive rule set which the Access Rules belong to.
+	SetName string
+	// ConflictingRuleID is the Access Rule of the existing grant.
+	ConflictingRuleID string
+	// ConflictingRequestID is the request of the existing grant.
+	ConflictingRequestID string
+}
+
+func (e ExclusiveRuleConflictError) Error() string {
+	return fmt.Sprintf("this request overlaps the grant of request %s for access rule %s, which is mutually exclusive with this access rule (%s)", e.ConflictingRequestID, e.ConflictingRuleID, e.SetName)
+}
+
+// SubjectNotEligibleError is returned if a request is made on behalf of a user
+// who isn't in a matching group for the access rule.
+type SubjectNotEligibleError struct {
+	UserID string
+}
+
+func (e SubjectNotEligibleError) Error() string {
+	return fmt.Sprintf("user %s was not in a matching group for the access rule", e.UserID)
+}
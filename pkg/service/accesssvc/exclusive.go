@@ -0,0 +1,103 @@
+package accesssvc
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/ddb"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+)
+
+// checkExclusiveRules returns an ExclusiveRuleConflictError if giving the user access to the rule between start and end
+// would overlap an active or approved grant from a mutually exclusive Access Rule.
+func (s *Service) checkExclusiveRules(ctx context.Context, userID string, ruleID string, start, end time.Time) error {
+	q := storage.ListExclusiveRuleSets{}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return err
+	}
+	var sets []rule.ExclusiveRuleSet
+	for _, set := range q.Result {
+		if len(set.ConflictsWith(ruleID)) > 0 {
+			sets = append(sets, set)
+		}
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	// grants which end after the start of the new grant may overlap it.
+	rq := storage.ListRequestsForUserAndRequestend{
+		UserID:               userID,
+		RequestEndComparator: storage.GreaterThanEqual,
+		CompareTo:            start,
+	}
+	_, err = s.DB.Query(ctx, &rq)
+	if err != nil && err != ddb.ErrNoItems {
+		return err
+	}
+	for _, r := range rq.Result {
+		if !holdsGrant(r) || !grantOverlaps(start, end, *r.Grant) {
+			continue
+		}
+		for _, set := range sets {
+			if contains(set.ConflictsWith(ruleID), r.Rule) {
+				return ExclusiveRuleConflictError{SetName: set.Name, ConflictingRuleID: r.Rule, ConflictingRequestID: r.ID}
+			}
+		}
+	}
+	return nil
+}
+
+// ExclusiveRuleViolation is a user holding overlapping grants from mutually exclusive Access Rules.
+type ExclusiveRuleViolation struct {
+	Set      rule.ExclusiveRuleSet
+	UserID   string
+	Requests []access.Request
+}
+
+// FindExclusiveRuleViolations finds users who hold overlapping grants from mutually exclusive Access Rules.
+// Violations can exist if the grants were approved before the exclusive rule set was created.
+func FindExclusiveRuleViolations(sets []rule.ExclusiveRuleSet, requests []access.Request) []ExclusiveRuleViolation {
+	byUser := make(map[string][]access.Request)
+	var users []string
+	for _, r := range requests {
+		if !holdsGrant(r) {
+			continue
+		}
+		if _, ok := byUser[r.RequestedBy]; !ok {
+			users = append(users, r.RequestedBy)
+		}
+		byUser[r.RequestedBy] = append(byUser[r.RequestedBy], r)
+	}
+
+	var res []ExclusiveRuleViolation
+	for _, set := range sets {
+		for _, u := range users {
+			reqs := byUser[u]
+			for i := range reqs {
+				for j := i + 1; j < len(reqs); j++ {
+					a, b := reqs[i], reqs[j]
+					if !contains(set.ConflictsWith(a.Rule), b.Rule) || !grantOverlaps(a.Grant.Start, a.Grant.End, *b.Grant) {
+						continue
+					}
+					res = append(res, ExclusiveRuleViolation{Set: set, UserID: u, Requests: []access.Request{a, b}})
+				}
+			}
+		}
+	}
+	return res
+}
+
+// holdsGrant returns true if the request has been approved and its grant is pending or active.
+func holdsGrant(r access.Request) bool {
+	return r.Status == access.APPROVED && r.Grant != nil &&
+		(r.Grant.Status == ahTypes.GrantStatusACTIVE || r.Grant.Status == ahTypes.GrantStatusPENDING)
+}
+
+func grantOverlaps(start, end time.Time, g access.Grant) bool {
+	return start.Before(g.End) && end.After(g.Start)
+}
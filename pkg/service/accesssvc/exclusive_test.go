@@ -0,0 +1,71 @@
+package accesssvc
+
+import (
+	"testing"
+	"time"
+
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindExclusiveRuleViolations(t *testing.T) {
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	set := rule.ExclusiveRuleSet{ID: "ers_1", Name: "prod changes", RuleIDs: []string{"rul_deploy", "rul_approve"}}
+
+	grant := func(start, end time.Time, status ahTypes.GrantStatus) *access.Grant {
+		return &access.Grant{Start: start, End: end, Status: status}
+	}
+	deploy := access.Request{ID: "req_1", RequestedBy: "a", Rule: "rul_deploy", Status: access.APPROVED, Grant: grant(now, now.Add(time.Hour), ahTypes.GrantStatusACTIVE)}
+	approve := access.Request{ID: "req_2", RequestedBy: "a", Rule: "rul_approve", Status: access.APPROVED, Grant: grant(now.Add(30*time.Minute), now.Add(2*time.Hour), ahTypes.GrantStatusPENDING)}
+
+	type testcase struct {
+		name     string
+		requests []access.Request
+		want     []ExclusiveRuleViolation
+	}
+
+	testcases := []testcase{
+		{
+			name:     "overlapping grants",
+			requests: []access.Request{deploy, approve},
+			want:     []ExclusiveRuleViolation{{Set: set, UserID: "a", Requests: []access.Request{deploy, approve}}},
+		},
+		{
+			name: "grants don't overlap",
+			requests: []access.Request{
+				deploy,
+				{ID: "req_2", RequestedBy: "a", Rule: "rul_approve", Status: access.APPROVED, Grant: grant(now.Add(time.Hour), now.Add(2*time.Hour), ahTypes.GrantStatusPENDING)},
+			},
+		},
+		{
+			name: "revoked grants are ignored",
+			requests: []access.Request{
+				deploy,
+				{ID: "req_2", RequestedBy: "a", Rule: "rul_approve", Status: access.APPROVED, Grant: grant(now, now.Add(time.Hour), ahTypes.GrantStatusREVOKED)},
+			},
+		},
+		{
+			name: "different users",
+			requests: []access.Request{
+				deploy,
+				{ID: "req_2", RequestedBy: "b", Rule: "rul_approve", Status: access.APPROVED, Grant: grant(now, now.Add(time.Hour), ahTypes.GrantStatusACTIVE)},
+			},
+		},
+		{
+			name: "rules which aren't in the set",
+			requests: []access.Request{
+				deploy,
+				{ID: "req_2", RequestedBy: "a", Rule: "rul_other", Status: access.APPROVED, Grant: grant(now, now.Add(time.Hour), ahTypes.GrantStatusACTIVE)},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := FindExclusiveRuleViolations([]rule.ExclusiveRuleSet{set}, tc.requests)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/ddb"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/policy"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// ExtendRequest creates an extension request for the grant of an existing request.
+// The extension goes through the same approval workflow as the Access Rule, including its limits, policy,
+// on-call schedule and exclusive rule sets. When it is approved, the existing grant is extended rather than
+// creating a new grant. If the extension is approved automatically, the grant is extended immediately.
+func (s *Service) ExtendRequest(ctx context.Context, user *identity.User, requestID string, in types.ExtendRequestRequest) (*CreateRequestResult, error) {
+	log := logger.Get(ctx).With("user.id", user.ID, "request.id", requestID)
+	q := storage.GetRequest{ID: requestID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	original := q.Result
+
+	// users can only extend their own requests.
+	if original.RequestedBy != user.ID {
+		return nil, ErrUserNotAuthorized
+	}
+
+	rq := storage.GetAccessRuleCurrent{ID: original.Rule}
+	_, err = s.DB.Query(ctx, &rq)
+	if err == ddb.ErrNoItems {
+		return nil, ErrRuleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	rule := rq.Result
+
+	err = groupMatches(rule.Groups, user.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.Clock.Now()
+	duration := time.Second * time.Duration(in.ExtensionDurationSeconds)
+	err = validateExtension(*original, *rule, duration, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkLimits(ctx, user.ID, *rule, original.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// the extended grant must not overlap grants from mutually exclusive access rules.
+	start := original.Grant.End
+	err = s.checkExclusiveRules(ctx, user.ID, rule.ID, start, start.Add(duration))
+	if err != nil {
+		return nil, err
+	}
+
+	// the policy is evaluated against the whole extended grant, so that extensions
+	// can't be used to get access for longer than the policy would approve.
+	decision := policy.Review
+	if rule.Policy != nil {
+		grantStart := original.Grant.Start
+		timing := access.Timing{Duration: original.Grant.End.Add(duration).Sub(grantStart), StartTime: &grantStart}
+		decision, err = s.evaluatePolicy(ctx, user, *rule, original.Grant.With.AdditionalProperties, timing, now)
+		if err != nil {
+			return nil, err
+		}
+		log.Infow("evaluated access rule policy", "decision", decision)
+		if decision == policy.Deny {
+			return nil, ErrRequestDeniedByPolicy
+		}
+	}
+
+	var onCall onCallResult
+	if rule.OnCall != nil {
+		onCall, err = s.checkOnCall(ctx, user, *rule.OnCall)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req := access.Request{
+		ID:          types.NewRequestID(),
+		RequestedBy: user.ID,
+		Data: access.RequestData{
+			Reason: in.Reason,
+			// reviewers of the extension see the form data and ticket of the original request.
+			FormData: original.Data.FormData,
+			Ticket:   original.Data.Ticket,
+		},
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		Status:          access.PENDING,
+		RequestedTiming: access.Timing{Duration: duration},
+		Rule:            rule.ID,
+		RuleVersion:     rule.Version,
+		SelectedWith:    original.SelectedWith,
+		ExtensionOf:     &original.ID,
+	}
+
+	autoApprove := isAutoApproved(*rule, decision, onCall)
+	auto := types.AUTOMATIC
+	revd := types.REVIEWED
+
+	if autoApprove {
+		req.Status = access.APPROVED
+		req.ApprovalMethod = &auto
+	} else {
+		req.ApprovalMethod = &revd
+		req.ApprovalProgress = approvalProgress(rule.Approval.GetStages()[0], 0)
+	}
+
+	approvers, err := rulesvc.GetStageApprovers(ctx, s.DB, rule.Approval.GetStages()[0])
+	if err != nil {
+		return nil, err
+	}
+	// extensions approved by the policy or the on-call schedule don't need to be reviewed.
+	if (rule.Policy != nil && decision == policy.Approve) || onCall.approved {
+		approvers = nil
+	} else {
+		for _, u := range onCall.approvers {
+			if !contains(approvers, u) {
+				approvers = append(approvers, u)
+			}
+		}
+	}
+
+	approvers, delegatedBy, err := rulesvc.WithDelegates(ctx, s.DB, req.RequestedBy, approvers, now)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []ddb.Keyer{&req}
+
+	var reviewers []access.Reviewer
+	for _, u := range approvers {
+		// users cannot approve their own extensions.
+		if u == req.RequestedBy {
+			continue
+		}
+
+		r := access.Reviewer{
+			ReviewerID:  u,
+			Request:     req,
+			DelegatedBy: delegatedBy[u],
+		}
+
+		reviewers = append(reviewers, r)
+		items = append(items, &r)
+	}
+
+	log.Debugw("saving extension request", "request", req, "reviewers", reviewers)
+
+	// audit log event
+	reqEvent := access.NewRequestCreatedEvent(req.ID, req.CreatedAt, &req.RequestedBy)
+	items = append(items, &reqEvent)
+
+	err = s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.EventPutter.Put(ctx, gevent.RequestCreated{Request: req})
+	// in a future PR we will shift these events out to be triggered by dynamo db streams
+	// This will currently put the app in a strange state if this fails
+	if err != nil {
+		return nil, err
+	}
+
+	if autoApprove {
+		log.Debugw("auto-approving extension", "request", req)
+		_, err = s.extendGrant(ctx, req, *rule, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := CreateRequestResult{
+		Request:   req,
+		Reviewers: reviewers,
+	}
+
+	return &res, nil
+}
+
+// extendGrant extends the grant of the request which an approved extension request relates to.
+// extenderID is the ID of the user who approved the extension, or nil if it was approved automatically.
+func (s *Service) extendGrant(ctx context.Context, extension access.Request, accessRule rule.AccessRule, extenderID *string) (*access.Request, error) {
+	if extension.ExtensionOf == nil {
+		return nil, errors.New("request is not an extension request")
+	}
+	q := storage.GetRequest{ID: *extension.ExtensionOf}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	original := *q.Result
+
+	// the grant may have changed since the extension was requested, so check it can still be extended.
+	duration := extension.GetTiming().Duration
+	err = validateExtension(original, accessRule, duration, s.Clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// the extended grant must not overlap any other grants for the user and rule.
+	start := original.Grant.End
+	end := start.Add(duration)
+	rq := storage.ListRequestsForUserAndRuleAndRequestend{
+		UserID:               original.RequestedBy,
+		RuleID:               original.Rule,
+		RequestEndComparator: storage.GreaterThanEqual,
+		CompareTo:            start,
+	}
+	_, err = s.DB.Query(ctx, &rq)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	var others []access.Request
+	for _, r := range rq.Result {
+		if r.ID != original.ID {
+			others = append(others, r)
+		}
+	}
+	if overlapsExistingGrant(start, end, others) {
+		return nil, ErrRequestOverlapsExistingGrant
+	}
+	// exclusive rule sets may have changed, or conflicting grants may have been approved, since the extension was requested.
+	err = s.checkExclusiveRules(ctx, original.RequestedBy, original.Rule, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Granter.ExtendGrant(ctx, grantsvc.ExtendGrantOpts{Request: original, Duration: duration, ExtenderID: extenderID})
+}
+
+// validateExtension checks that the grant of a request can be extended by the duration,
+// and that the extended grant doesn't exceed the maximum duration of the access rule.
+func validateExtension(original access.Request, accessRule rule.AccessRule, duration time.Duration, now time.Time) error {
+	if original.Grant == nil {
+		return ErrRequestCannotBeExtended
+	}
+	isExtendable := original.Grant.Status == ahTypes.GrantStatusACTIVE || original.Grant.Status == ahTypes.GrantStatusPENDING
+	if !isExtendable || original.Grant.End.Before(now) {
+		return ErrRequestCannotBeExtended
+	}
+
+	total := int(original.Grant.End.Add(duration).Sub(original.Grant.Start).Seconds())
+	if total > accessRule.TimeConstraints.MaxDurationSeconds {
+		return &apio.APIError{
+			Err:    errors.New("request validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{
+				{
+					Field: "extensionDurationSeconds",
+					Error: fmt.Sprintf("extended grant duration: %d exceeds the maximum duration seconds: %d", total, accessRule.TimeConstraints.MaxDurationSeconds),
+				},
+			},
+		}
+	}
+	// the extended grant must also be within the allowed windows of the access rule.
+	if accessRule.TimeConstraints.AllowedWindows != nil {
+		ok, err := rule.AllowedWindowsContain(*accessRule.TimeConstraints.AllowedWindows, original.Grant.Start, original.Grant.End.Add(duration))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &apio.APIError{
+				Err:    errors.New("request validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{
+					{
+						Field: "extensionDurationSeconds",
+						Error: "the extended grant must end within the allowed windows for the access rule",
+					},
+				},
+			}
+		}
+	}
+	return nil
+}
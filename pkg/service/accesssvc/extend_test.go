@@ -0,0 +1,239 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	accessMocks "github.com/common-fate/granted-approvals/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendRequest(t *testing.T) {
+	type testcase struct {
+		name      string
+		giveUser  identity.User
+		giveInput types.ExtendRequestRequest
+		original  *access.Request
+		rule      *rule.AccessRule
+		ruleErr   error
+		// userRequests are the requests of the user for any rule, used to check exclusive rule sets.
+		userRequests          []access.Request
+		withExclusiveRuleSets []rule.ExclusiveRuleSet
+		wantExtendGrant       bool
+		wantStatus            access.Status
+		wantReviewers         []string
+		wantErr               error
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	approvePolicy := `timing.durationSeconds <= 3 * 3600 ? "approve" : "review"`
+	denyPolicy := `"deny"`
+	activeRequest := &access.Request{
+		ID:          "req_123",
+		RequestedBy: "a",
+		Rule:        "rul_123",
+		Status:      access.APPROVED,
+		Grant: &access.Grant{
+			Start:  now.Add(-time.Hour),
+			End:    now.Add(time.Hour),
+			Status: ahTypes.GrantStatusACTIVE,
+		},
+	}
+
+	testcases := []testcase{
+		{
+			name:      "ok, with reviewers",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.ExtendRequestRequest{ExtensionDurationSeconds: 3600},
+			original:  activeRequest,
+			rule: &rule.AccessRule{
+				ID:              "rul_123",
+				Groups:          []string{"a"},
+				Approval:        rule.Approval{Users: []string{"a", "b"}},
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3 * 3600},
+			},
+			wantStatus:    access.PENDING,
+			wantReviewers: []string{"b"},
+		},
+		{
+			name:      "ok, no approvers so should extend the grant",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.ExtendRequestRequest{ExtensionDurationSeconds: 3600},
+			original:  activeRequest,
+			rule: &rule.AccessRule{
+				ID:              "rul_123",
+				Groups:          []string{"a"},
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3 * 3600},
+			},
+			wantExtendGrant: true,
+			wantStatus:      access.APPROVED,
+		},
+		{
+			name:      "policy approves the extension",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.ExtendRequestRequest{ExtensionDurationSeconds: 3600},
+			original:  activeRequest,
+			rule: &rule.AccessRule{
+				ID:              "rul_123",
+				Groups:          []string{"a"},
+				Approval:        rule.Approval{Users: []string{"a", "b"}},
+				Policy:          &approvePolicy,
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3 * 3600},
+			},
+			wantExtendGrant: true,
+			wantStatus:      access.APPROVED,
+		},
+		{
+			name:      "policy denies the extension",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.ExtendRequestRequest{ExtensionDurationSeconds: 3600},
+			original:  activeRequest,
+			rule: &rule.AccessRule{
+				ID:              "rul_123",
+				Groups:          []string{"a"},
+				Policy:          &denyPolicy,
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3 * 3600},
+			},
+			wantErr: ErrRequestDeniedByPolicy,
+		},
+		{
+			name:      "extended grant overlaps a grant from a mutually exclusive rule",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.ExtendRequestRequest{ExtensionDurationSeconds: 3600},
+			original:  activeRequest,
+			rule: &rule.AccessRule{
+				ID:              "rul_123",
+				Groups:          []string{"a"},
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3 * 3600},
+			},
+			withExclusiveRuleSets: []rule.ExclusiveRuleSet{
+				{Name: "prod changes", RuleIDs: []string{"rul_123", "rul_approve"}},
+			},
+			userRequests: []access.Request{
+				{
+					ID:     "req_approve",
+					Rule:   "rul_approve",
+					Status: access.APPROVED,
+					Grant:  &access.Grant{Status: ahTypes.GrantStatusPENDING, Start: now.Add(90 * time.Minute), End: now.Add(3 * time.Hour)},
+				},
+			},
+			wantErr: ExclusiveRuleConflictError{SetName: "prod changes", ConflictingRuleID: "rul_approve", ConflictingRequestID: "req_approve"},
+		},
+		{
+			name:      "user did not make the original request",
+			giveUser:  identity.User{ID: "b", Groups: []string{"a"}},
+			giveInput: types.ExtendRequestRequest{ExtensionDurationSeconds: 3600},
+			original:  activeRequest,
+			wantErr:   ErrUserNotAuthorized,
+		},
+		{
+			name:      "rule not found",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.ExtendRequestRequest{ExtensionDurationSeconds: 3600},
+			original:  activeRequest,
+			ruleErr:   ddb.ErrNoItems,
+			wantErr:   ErrRuleNotFound,
+		},
+		{
+			name:      "request has no grant",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.ExtendRequestRequest{ExtensionDurationSeconds: 3600},
+			original:  &access.Request{ID: "req_123", RequestedBy: "a", Status: access.PENDING},
+			rule: &rule.AccessRule{
+				Groups:          []string{"a"},
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3 * 3600},
+			},
+			wantErr: ErrRequestCannotBeExtended,
+		},
+		{
+			name:      "grant has been revoked",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.ExtendRequestRequest{ExtensionDurationSeconds: 3600},
+			original: &access.Request{ID: "req_123", RequestedBy: "a", Status: access.APPROVED, Grant: &access.Grant{
+				Start:  now.Add(-time.Hour),
+				End:    now.Add(time.Hour),
+				Status: ahTypes.GrantStatusREVOKED,
+			}},
+			rule: &rule.AccessRule{
+				Groups:          []string{"a"},
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3 * 3600},
+			},
+			wantErr: ErrRequestCannotBeExtended,
+		},
+		{
+			name:      "extended grant exceeds max duration",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.ExtendRequestRequest{ExtensionDurationSeconds: 2 * 3600},
+			original:  activeRequest,
+			rule: &rule.AccessRule{
+				Groups:          []string{"a"},
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3 * 3600},
+			},
+			wantErr: &apio.APIError{
+				Err:    errors.New("request validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{
+					{
+						Field: "extensionDurationSeconds",
+						Error: "extended grant duration: 14400 exceeds the maximum duration seconds: 10800",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetRequest{Result: tc.original})
+			db.MockQueryWithErr(&storage.GetAccessRuleCurrent{Result: tc.rule}, tc.ruleErr)
+			db.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{Result: []access.Request{*tc.original}})
+			db.MockQuery(&storage.ListDelegationsForDelegator{})
+			db.MockQuery(&storage.ListExclusiveRuleSets{Result: tc.withExclusiveRuleSets})
+			db.MockQuery(&storage.ListRequestsForUserAndRequestend{Result: tc.userRequests})
+
+			ctrl := gomock.NewController(t)
+			g := accessMocks.NewMockGranter(ctrl)
+			if tc.wantExtendGrant {
+				g.EXPECT().ExtendGrant(gomock.Any(), gomock.Any()).Return(tc.original, nil).Times(1)
+			}
+			ep := accessMocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				Granter:     g,
+				EventPutter: ep,
+			}
+			got, err := s.ExtendRequest(context.Background(), &tc.giveUser, "req_123", tc.giveInput)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantStatus, got.Request.Status)
+			assert.Equal(t, &tc.original.ID, got.Request.ExtensionOf)
+			assert.Equal(t, time.Duration(tc.giveInput.ExtensionDurationSeconds)*time.Second, got.Request.RequestedTiming.Duration)
+			var reviewers []string
+			for _, r := range got.Reviewers {
+				reviewers = append(reviewers, r.ReviewerID)
+			}
+			assert.Equal(t, tc.wantReviewers, reviewers)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package accesssvc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// parseFormData checks the form data of a request against the form fields of the access rule,
+// and returns the values of the fields in the order they are defined on the rule.
+func parseFormData(fields []types.FormField, data *types.CreateRequestFormData) ([]access.FormFieldValue, error) {
+	given := make(map[string]interface{})
+	if data != nil && data.AdditionalProperties != nil {
+		given = data.AdditionalProperties
+	}
+
+	var fieldErrs []apio.FieldError
+	defined := make(map[string]bool)
+	var res []access.FormFieldValue
+	for _, f := range fields {
+		defined[f.Id] = true
+		v, ok := given[f.Id]
+		if !ok || v == nil || v == "" {
+			if rule.FormFieldRequired(f) {
+				fieldErrs = append(fieldErrs, apio.FieldError{Field: "formData." + f.Id, Error: fmt.Sprintf("%s is required", f.Label)})
+			}
+			continue
+		}
+		value, err := formFieldValue(f, v)
+		if err != nil {
+			fieldErrs = append(fieldErrs, apio.FieldError{Field: "formData." + f.Id, Error: err.Error()})
+			continue
+		}
+		res = append(res, access.FormFieldValue{ID: f.Id, Label: f.Label, Value: value})
+	}
+	for id := range given {
+		if !defined[id] {
+			fieldErrs = append(fieldErrs, apio.FieldError{Field: "formData." + id, Error: "unexpected form field"})
+		}
+	}
+
+	if len(fieldErrs) > 0 {
+		return nil, &apio.APIError{
+			Err:    errors.New("request validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: fieldErrs,
+		}
+	}
+	return res, nil
+}
+
+// formFieldValue checks that the value given for a field matches its type,
+// and returns the value formatted as a string.
+func formFieldValue(f types.FormField, v interface{}) (string, error) {
+	switch f.Type {
+	case types.Text:
+		s, ok := v.(string)
+		if !ok {
+			return "", fmt.Errorf("%s must be text", f.Label)
+		}
+		if f.Pattern != nil {
+			re, err := rule.FormFieldPattern(*f.Pattern)
+			if err != nil {
+				return "", err
+			}
+			if !re.MatchString(s) {
+				return "", fmt.Errorf("%s must match the pattern %s", f.Label, *f.Pattern)
+			}
+		}
+		return s, nil
+	case types.Select:
+		s, ok := v.(string)
+		if !ok || f.Options == nil || !contains(*f.Options, s) {
+			return "", fmt.Errorf("%s must be one of: %s", f.Label, strings.Join(options(f), ", "))
+		}
+		return s, nil
+	case types.Number:
+		n, ok := v.(float64)
+		if !ok {
+			return "", fmt.Errorf("%s must be a number", f.Label)
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case types.Boolean:
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("%s must be true or false", f.Label)
+		}
+		return strconv.FormatBool(b), nil
+	}
+	return "", fmt.Errorf("%s has an unsupported type: %s", f.Label, f.Type)
+}
+
+func options(f types.FormField) []string {
+	if f.Options == nil {
+		return nil
+	}
+	return *f.Options
+}
@@ -0,0 +1,88 @@
+package accesssvc
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFormData(t *testing.T) {
+	yes := true
+	pattern := `[A-Z]+-\d+`
+	changeTypes := []string{"standard", "normal", "emergency"}
+	fields := []types.FormField{
+		{Id: "ticket", Label: "Ticket ID", Type: types.Text, Pattern: &pattern, Required: &yes},
+		{Id: "changeType", Label: "Change type", Type: types.Select, Options: &changeTypes},
+		{Id: "customer", Label: "Customer ID", Type: types.Number},
+		{Id: "outage", Label: "Customer outage", Type: types.Boolean},
+	}
+
+	type testcase struct {
+		name       string
+		give       map[string]interface{}
+		want       []access.FormFieldValue
+		wantFields []apio.FieldError
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: map[string]interface{}{"ticket": "CHG-123", "changeType": "normal", "customer": float64(4021), "outage": false},
+			want: []access.FormFieldValue{
+				{ID: "ticket", Label: "Ticket ID", Value: "CHG-123"},
+				{ID: "changeType", Label: "Change type", Value: "normal"},
+				{ID: "customer", Label: "Customer ID", Value: "4021"},
+				{ID: "outage", Label: "Customer outage", Value: "false"},
+			},
+		},
+		{
+			name: "optional fields can be omitted",
+			give: map[string]interface{}{"ticket": "CHG-123"},
+			want: []access.FormFieldValue{{ID: "ticket", Label: "Ticket ID", Value: "CHG-123"}},
+		},
+		{
+			name:       "required field missing",
+			give:       map[string]interface{}{"ticket": ""},
+			wantFields: []apio.FieldError{{Field: "formData.ticket", Error: "Ticket ID is required"}},
+		},
+		{
+			name:       "pattern must match the whole value",
+			give:       map[string]interface{}{"ticket": "see CHG-123"},
+			wantFields: []apio.FieldError{{Field: "formData.ticket", Error: `Ticket ID must match the pattern [A-Z]+-\d+`}},
+		},
+		{
+			name: "wrong types",
+			give: map[string]interface{}{"ticket": "CHG-123", "changeType": "yolo", "customer": "4021", "outage": "yes"},
+			wantFields: []apio.FieldError{
+				{Field: "formData.changeType", Error: "Change type must be one of: standard, normal, emergency"},
+				{Field: "formData.customer", Error: "Customer ID must be a number"},
+				{Field: "formData.outage", Error: "Customer outage must be true or false"},
+			},
+		},
+		{
+			name:       "unexpected field",
+			give:       map[string]interface{}{"ticket": "CHG-123", "priority": "high"},
+			wantFields: []apio.FieldError{{Field: "formData.priority", Error: "unexpected form field"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseFormData(fields, &types.CreateRequestFormData{AdditionalProperties: tc.give})
+			if tc.wantFields != nil {
+				var apiErr *apio.APIError
+				if assert.ErrorAs(t, err, &apiErr) {
+					assert.Equal(t, http.StatusBadRequest, apiErr.Status)
+					assert.Equal(t, tc.wantFields, apiErr.Fields)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+)
+
+// checkLimits returns an error if creating a request for the access rule would exceed
+// the limits on pending requests, active grants or requests within the rate limit window for the user.
+// extensionOf is the ID of the request being extended when checking an extension request, as extending
+// a grant doesn't create another active grant. It is empty for other requests.
+func (s *Service) checkLimits(ctx context.Context, userID string, accessRule rule.AccessRule, extensionOf string, now time.Time) error {
+	limits := accessRule.Limits
+	if limits == nil {
+		return nil
+	}
+
+	if limits.MaxPendingRequests != nil {
+		q := storage.ListRequestsForUserAndStatus{UserId: userID, Status: access.PENDING}
+		_, err := s.DB.Query(ctx, &q)
+		if err != nil && err != ddb.ErrNoItems {
+			return err
+		}
+		pending := 0
+		for _, r := range q.Result {
+			if r.Rule == accessRule.ID {
+				pending++
+			}
+		}
+		if pending >= *limits.MaxPendingRequests {
+			return limitError(http.StatusBadRequest, fmt.Sprintf("you have %d pending requests for this access rule, the maximum is %d", pending, *limits.MaxPendingRequests))
+		}
+	}
+
+	if limits.MaxActiveGrants == nil && limits.RateLimit == nil {
+		return nil
+	}
+
+	// requests always end after they are created, so requests created within the rate limit window
+	// are included when querying for requests which end after the start of the window.
+	compareTo := now
+	var windowStart time.Time
+	if limits.RateLimit != nil {
+		windowStart = now.Add(-time.Second * time.Duration(limits.RateLimit.WindowSeconds))
+		compareTo = windowStart
+	}
+	rq := storage.ListRequestsForUserAndRuleAndRequestend{
+		UserID:               userID,
+		RuleID:               accessRule.ID,
+		RequestEndComparator: storage.GreaterThanEqual,
+		CompareTo:            compareTo,
+	}
+	_, err := s.DB.Query(ctx, &rq)
+	if err != nil && err != ddb.ErrNoItems {
+		return err
+	}
+
+	// the occurrences of a recurring request are counted once, as a single series,
+	// so that one recurring request doesn't use up the limits for as long as it repeats.
+	if limits.MaxActiveGrants != nil {
+		activeSeries := make(map[string]bool)
+		for _, r := range rq.Result {
+			if r.Grant == nil || !r.Grant.End.After(now) || (extensionOf != "" && r.ID == extensionOf) {
+				continue
+			}
+			if r.Grant.Status == ahTypes.GrantStatusACTIVE || r.Grant.Status == ahTypes.GrantStatusPENDING {
+				activeSeries[seriesID(r)] = true
+			}
+		}
+		active := len(activeSeries)
+		if active >= *limits.MaxActiveGrants {
+			return limitError(http.StatusBadRequest, fmt.Sprintf("you have %d active grants for this access rule, the maximum is %d", active, *limits.MaxActiveGrants))
+		}
+	}
+
+	if limits.RateLimit != nil {
+		recentSeries := make(map[string]bool)
+		for _, r := range rq.Result {
+			if !r.CreatedAt.Before(windowStart) {
+				recentSeries[seriesID(r)] = true
+			}
+		}
+		recent := len(recentSeries)
+		if recent >= limits.RateLimit.MaxRequests {
+			return limitError(http.StatusTooManyRequests, fmt.Sprintf("you have made %d requests for this access rule in the last %d seconds, the maximum is %d", recent, limits.RateLimit.WindowSeconds, limits.RateLimit.MaxRequests))
+		}
+	}
+	return nil
+}
+
+// seriesID returns the ID of the recurring request which a request is an occurrence of,
+// or the ID of the request itself if it isn't an occurrence.
+func seriesID(r access.Request) string {
+	if r.RecurrenceOf != nil {
+		return *r.RecurrenceOf
+	}
+	return r.ID
+}
+
+func limitError(status int, msg string) error {
+	return &apio.APIError{
+		Err:    errors.New("request limit exceeded"),
+		Status: status,
+		Fields: []apio.FieldError{
+			{
+				Field: "accessRuleId",
+				Error: msg,
+			},
+		},
+	}
+}
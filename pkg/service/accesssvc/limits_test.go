@@ -0,0 +1,134 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb/ddbmock"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckLimits(t *testing.T) {
+	type testcase struct {
+		name         string
+		limits       *types.RequestLimits
+		pending      []access.Request
+		byRequestEnd []access.Request
+		extensionOf  string
+		wantErr      error
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	one := 1
+	two := 2
+	series := "req_series"
+	activeGrant := access.Request{
+		ID:        "req_active",
+		Rule:      "rul_123",
+		Status:    access.APPROVED,
+		CreatedAt: now.Add(-time.Hour),
+		Grant:     &access.Grant{Start: now.Add(-time.Hour), End: now.Add(time.Hour), Status: ahTypes.GrantStatusACTIVE},
+	}
+	revokedGrant := access.Request{
+		ID:        "req_revoked",
+		Rule:      "rul_123",
+		Status:    access.APPROVED,
+		CreatedAt: now.Add(-time.Hour),
+		Grant:     &access.Grant{Start: now.Add(-time.Hour), End: now.Add(time.Hour), Status: ahTypes.GrantStatusREVOKED},
+	}
+
+	testcases := []testcase{
+		{
+			name: "no limits",
+		},
+		{
+			name:         "grant being extended is not counted",
+			limits:       &types.RequestLimits{MaxActiveGrants: &one},
+			byRequestEnd: []access.Request{{ID: "req_123", Rule: "rul_123", Status: access.APPROVED, CreatedAt: now.Add(-time.Hour), Grant: activeGrant.Grant}},
+			extensionOf:  "req_123",
+		},
+		{
+			name:    "pending requests for other rules are not counted",
+			limits:  &types.RequestLimits{MaxPendingRequests: &one},
+			pending: []access.Request{{Rule: "rul_other", Status: access.PENDING}},
+		},
+		{
+			name:    "too many pending requests",
+			limits:  &types.RequestLimits{MaxPendingRequests: &one},
+			pending: []access.Request{{Rule: "rul_123", Status: access.PENDING}},
+			wantErr: &apio.APIError{
+				Err:    errors.New("request limit exceeded"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{{Field: "accessRuleId", Error: "you have 1 pending requests for this access rule, the maximum is 1"}},
+			},
+		},
+		{
+			name:         "revoked grants are not counted",
+			limits:       &types.RequestLimits{MaxActiveGrants: &one},
+			byRequestEnd: []access.Request{revokedGrant},
+		},
+		{
+			name:         "too many active grants",
+			limits:       &types.RequestLimits{MaxActiveGrants: &one},
+			byRequestEnd: []access.Request{activeGrant},
+			wantErr: &apio.APIError{
+				Err:    errors.New("request limit exceeded"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{{Field: "accessRuleId", Error: "you have 1 active grants for this access rule, the maximum is 1"}},
+			},
+		},
+		{
+			name:   "requests outside the rate limit window are not counted",
+			limits: &types.RequestLimits{RateLimit: &types.RateLimit{MaxRequests: 2, WindowSeconds: 3600}},
+			byRequestEnd: []access.Request{
+				activeGrant,
+				{ID: "req_declined", Rule: "rul_123", Status: access.DECLINED, CreatedAt: now.Add(-2 * time.Hour)},
+			},
+		},
+		{
+			name:   "rate limit exceeded",
+			limits: &types.RequestLimits{MaxActiveGrants: &two, RateLimit: &types.RateLimit{MaxRequests: 2, WindowSeconds: 7200}},
+			byRequestEnd: []access.Request{
+				activeGrant,
+				{ID: "req_declined", Rule: "rul_123", Status: access.DECLINED, CreatedAt: now.Add(-90 * time.Minute)},
+			},
+			wantErr: &apio.APIError{
+				Err:    errors.New("request limit exceeded"),
+				Status: http.StatusTooManyRequests,
+				Fields: []apio.FieldError{{Field: "accessRuleId", Error: "you have made 2 requests for this access rule in the last 7200 seconds, the maximum is 2"}},
+			},
+		},
+		{
+			name:   "occurrences of a recurring request are counted once",
+			limits: &types.RequestLimits{MaxActiveGrants: &two, RateLimit: &types.RateLimit{MaxRequests: 2, WindowSeconds: 7200}},
+			byRequestEnd: []access.Request{
+				{ID: "req_series", Rule: "rul_123", Status: access.APPROVED, CreatedAt: now.Add(-time.Hour)},
+				{ID: "req_series-0", RecurrenceOf: &series, Rule: "rul_123", Status: access.APPROVED, CreatedAt: now.Add(-time.Hour), Grant: activeGrant.Grant},
+				{ID: "req_series-1", RecurrenceOf: &series, Rule: "rul_123", Status: access.APPROVED, CreatedAt: now.Add(-time.Hour), Grant: &access.Grant{Start: now.Add(24 * time.Hour), End: now.Add(25 * time.Hour), Status: ahTypes.GrantStatusPENDING}},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestsForUserAndStatus{Result: tc.pending})
+			db.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{Result: tc.byRequestEnd})
+
+			s := Service{Clock: clk, DB: db}
+			err := s.checkLimits(context.Background(), "usr_123", rule.AccessRule{ID: "rul_123", Limits: tc.limits}, tc.extensionOf, now)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
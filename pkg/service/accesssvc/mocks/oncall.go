@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/granted-approvals/pkg/service/accesssvc (interfaces: OnCallResolver)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOnCallResolver is a mock of OnCallResolver interface.
+type MockOnCallResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockOnCallResolverMockRecorder
+}
+
+// MockOnCallResolverMockRecorder is the mock recorder for MockOnCallResolver.
+type MockOnCallResolverMockRecorder struct {
+	mock *MockOnCallResolver
+}
+
+// NewMockOnCallResolver creates a new mock instance.
+func NewMockOnCallResolver(ctrl *gomock.Controller) *MockOnCallResolver {
+	mock := &MockOnCallResolver{ctrl: ctrl}
+	mock.recorder = &MockOnCallResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOnCallResolver) EXPECT() *MockOnCallResolverMockRecorder {
+	return m.recorder
+}
+
+// OnCallEmails mocks base method.
+func (m *MockOnCallResolver) OnCallEmails(arg0 context.Context, arg1, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnCallEmails", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OnCallEmails indicates an expected call of OnCallEmails.
+func (mr *MockOnCallResolverMockRecorder) OnCallEmails(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnCallEmails", reflect.TypeOf((*MockOnCallResolver)(nil).OnCallEmails), arg0, arg1, arg2)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/granted-approvals/pkg/service/accesssvc (interfaces: RequestWriter)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	access "github.com/common-fate/granted-approvals/pkg/access"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRequestWriter is a mock of RequestWriter interface.
+type MockRequestWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestWriterMockRecorder
+}
+
+// MockRequestWriterMockRecorder is the mock recorder for MockRequestWriter.
+type MockRequestWriterMockRecorder struct {
+	mock *MockRequestWriter
+}
+
+// NewMockRequestWriter creates a new mock instance.
+func NewMockRequestWriter(ctrl *gomock.Controller) *MockRequestWriter {
+	mock := &MockRequestWriter{ctrl: ctrl}
+	mock.recorder = &MockRequestWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestWriter) EXPECT() *MockRequestWriterMockRecorder {
+	return m.recorder
+}
+
+// PutRequest mocks base method.
+func (m *MockRequestWriter) PutRequest(arg0 context.Context, arg1 *access.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutRequest indicates an expected call of PutRequest.
+func (mr *MockRequestWriterMockRecorder) PutRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRequest", reflect.TypeOf((*MockRequestWriter)(nil).PutRequest), arg0, arg1)
+}
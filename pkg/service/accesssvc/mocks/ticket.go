@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/common-fate/granted-approvals/pkg/service/accesssvc (interfaces: TicketVerifier)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ticket "github.com/common-fate/granted-approvals/pkg/ticket"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTicketVerifier is a mock of TicketVerifier interface.
+type MockTicketVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketVerifierMockRecorder
+}
+
+// MockTicketVerifierMockRecorder is the mock recorder for MockTicketVerifier.
+type MockTicketVerifierMockRecorder struct {
+	mock *MockTicketVerifier
+}
+
+// NewMockTicketVerifier creates a new mock instance.
+func NewMockTicketVerifier(ctrl *gomock.Controller) *MockTicketVerifier {
+	mock := &MockTicketVerifier{ctrl: ctrl}
+	mock.recorder = &MockTicketVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketVerifier) EXPECT() *MockTicketVerifierMockRecorder {
+	return m.recorder
+}
+
+// GetTicket mocks base method.
+func (m *MockTicketVerifier) GetTicket(arg0 context.Context, arg1, arg2 string) (*ticket.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicket", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*ticket.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicket indicates an expected call of GetTicket.
+func (mr *MockTicketVerifierMockRecorder) GetTicket(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicket", reflect.TypeOf((*MockTicketVerifier)(nil).GetTicket), arg0, arg1, arg2)
+}
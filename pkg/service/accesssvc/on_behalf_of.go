@@ -0,0 +1,112 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// requestSubjects returns the users who a request is made on behalf of.
+// For a group, this is every active member of the group.
+func (s *Service) requestSubjects(ctx context.Context, in types.CreateRequestOnBehalfOf) ([]identity.User, error) {
+	hasUser := in.UserId != nil && *in.UserId != ""
+	hasGroup := in.GroupId != nil && *in.GroupId != ""
+	if hasUser == hasGroup {
+		return nil, onBehalfOfError("onBehalfOf", "exactly one of userId or groupId must be provided")
+	}
+
+	if hasUser {
+		q := storage.GetUser{ID: *in.UserId}
+		_, err := s.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			return nil, onBehalfOfError("onBehalfOf.userId", "user not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if q.Result.Status != types.IdpStatusACTIVE {
+			return nil, onBehalfOfError("onBehalfOf.userId", "user is not active")
+		}
+		return []identity.User{*q.Result}, nil
+	}
+
+	gq := storage.GetGroup{ID: *in.GroupId}
+	_, err := s.DB.Query(ctx, &gq)
+	if err == ddb.ErrNoItems {
+		return nil, onBehalfOfError("onBehalfOf.groupId", "group not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	var users []identity.User
+	for _, id := range gq.Result.Users {
+		q := storage.GetUser{ID: id}
+		_, err := s.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if q.Result.Status == types.IdpStatusACTIVE {
+			users = append(users, *q.Result)
+		}
+	}
+	if len(users) == 0 {
+		return nil, onBehalfOfError("onBehalfOf.groupId", "group has no active members")
+	}
+	return users, nil
+}
+
+// canRequestOnBehalfOf checks that the user may request access to the rule on behalf of the subjects.
+// Administrators and approvers of the rule can request access on behalf of any eligible user.
+// Other users must share one of the groups of the rule with each subject.
+func (s *Service) canRequestOnBehalfOf(ctx context.Context, user *identity.User, ar *rule.AccessRule, subjects []identity.User) error {
+	if s.AdminGroup != "" && user.BelongsToGroup(s.AdminGroup) {
+		return nil
+	}
+	approvers, err := rulesvc.GetApprovers(ctx, s.DB, *ar)
+	if err != nil {
+		return err
+	}
+	for _, a := range approvers {
+		if a == user.ID {
+			return nil
+		}
+	}
+	for _, subject := range subjects {
+		if subject.ID == user.ID {
+			continue
+		}
+		if !sharesGroup(ar.Groups, user.Groups, subject.Groups) {
+			return ErrOnBehalfOfNotAllowed
+		}
+	}
+	return nil
+}
+
+// sharesGroup returns true if both users belong to one of the groups.
+func sharesGroup(groups []string, a []string, b []string) bool {
+	for _, g := range groups {
+		if contains(a, g) && contains(b, g) {
+			return true
+		}
+	}
+	return false
+}
+
+func onBehalfOfError(field string, msg string) error {
+	return &apio.APIError{
+		Err:    errors.New("request validation failed"),
+		Status: http.StatusBadRequest,
+		Fields: []apio.FieldError{{Field: field, Error: msg}},
+	}
+}
@@ -0,0 +1,170 @@
+package accesssvc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestSubjects(t *testing.T) {
+	userID := "usr_a"
+	groupID := "responders"
+	active := identity.User{ID: userID, Groups: []string{groupID}, Status: types.IdpStatusACTIVE}
+	archived := identity.User{ID: userID, Groups: []string{groupID}, Status: types.IdpStatusARCHIVED}
+
+	type testcase struct {
+		name         string
+		give         types.CreateRequestOnBehalfOf
+		withUser     *identity.User
+		withGroup    *identity.Group
+		want         []identity.User
+		wantFieldErr *apio.FieldError
+	}
+
+	testcases := []testcase{
+		{
+			name:     "user",
+			give:     types.CreateRequestOnBehalfOf{UserId: &userID},
+			withUser: &active,
+			want:     []identity.User{active},
+		},
+		{
+			name:         "user not found",
+			give:         types.CreateRequestOnBehalfOf{UserId: &userID},
+			wantFieldErr: &apio.FieldError{Field: "onBehalfOf.userId", Error: "user not found"},
+		},
+		{
+			name:         "user not active",
+			give:         types.CreateRequestOnBehalfOf{UserId: &userID},
+			withUser:     &archived,
+			wantFieldErr: &apio.FieldError{Field: "onBehalfOf.userId", Error: "user is not active"},
+		},
+		{
+			name:      "group",
+			give:      types.CreateRequestOnBehalfOf{GroupId: &groupID},
+			withGroup: &identity.Group{ID: groupID, Users: []string{userID}},
+			withUser:  &active,
+			want:      []identity.User{active},
+		},
+		{
+			name:         "group without active members",
+			give:         types.CreateRequestOnBehalfOf{GroupId: &groupID},
+			withGroup:    &identity.Group{ID: groupID, Users: []string{userID}},
+			withUser:     &archived,
+			wantFieldErr: &apio.FieldError{Field: "onBehalfOf.groupId", Error: "group has no active members"},
+		},
+		{
+			name:         "group not found",
+			give:         types.CreateRequestOnBehalfOf{GroupId: &groupID},
+			wantFieldErr: &apio.FieldError{Field: "onBehalfOf.groupId", Error: "group not found"},
+		},
+		{
+			name:         "user and group",
+			give:         types.CreateRequestOnBehalfOf{UserId: &userID, GroupId: &groupID},
+			wantFieldErr: &apio.FieldError{Field: "onBehalfOf", Error: "exactly one of userId or groupId must be provided"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			if tc.withUser != nil {
+				db.MockQuery(&storage.GetUser{Result: tc.withUser})
+			} else {
+				db.MockQueryWithErr(&storage.GetUser{}, ddb.ErrNoItems)
+			}
+			if tc.withGroup != nil {
+				db.MockQuery(&storage.GetGroup{Result: tc.withGroup})
+			} else {
+				db.MockQueryWithErr(&storage.GetGroup{}, ddb.ErrNoItems)
+			}
+			s := Service{DB: db}
+
+			got, err := s.requestSubjects(context.Background(), tc.give)
+			if tc.wantFieldErr != nil {
+				var apiErr *apio.APIError
+				if assert.ErrorAs(t, err, &apiErr) {
+					assert.Equal(t, http.StatusBadRequest, apiErr.Status)
+					assert.Equal(t, []apio.FieldError{*tc.wantFieldErr}, apiErr.Fields)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCanRequestOnBehalfOf(t *testing.T) {
+	starter := identity.User{ID: "usr_starter", Groups: []string{"engineering"}}
+	contractor := identity.User{ID: "usr_contractor", Groups: []string{"contractors"}}
+	ar := rule.AccessRule{
+		Groups: []string{"engineering", "contractors"},
+		Approval: rule.Approval{
+			Users:  []string{"usr_approver"},
+			Groups: []string{"leads"},
+		},
+	}
+
+	type testcase struct {
+		name     string
+		user     identity.User
+		subjects []identity.User
+		wantErr  error
+	}
+
+	testcases := []testcase{
+		{
+			name:     "shares a group of the rule with every subject",
+			user:     identity.User{ID: "usr_a", Groups: []string{"engineering"}},
+			subjects: []identity.User{starter},
+		},
+		{
+			name:     "doesn't share a group of the rule with a subject",
+			user:     identity.User{ID: "usr_a", Groups: []string{"engineering"}},
+			subjects: []identity.User{starter, contractor},
+			wantErr:  ErrOnBehalfOfNotAllowed,
+		},
+		{
+			name:     "only shares a group which isn't a group of the rule",
+			user:     identity.User{ID: "usr_a", Groups: []string{"everyone", "engineering"}},
+			subjects: []identity.User{{ID: "usr_b", Groups: []string{"everyone", "contractors"}}},
+			wantErr:  ErrOnBehalfOfNotAllowed,
+		},
+		{
+			name:     "approver of the rule",
+			user:     identity.User{ID: "usr_approver", Groups: []string{"engineering"}},
+			subjects: []identity.User{contractor},
+		},
+		{
+			name:     "in an approver group of the rule",
+			user:     identity.User{ID: "usr_lead", Groups: []string{"leads"}},
+			subjects: []identity.User{contractor},
+		},
+		{
+			name:     "administrator",
+			user:     identity.User{ID: "usr_admin", Groups: []string{"admins"}},
+			subjects: []identity.User{contractor},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetGroup{Result: &identity.Group{ID: "leads", Users: []string{"usr_lead"}}})
+			s := Service{DB: db, AdminGroup: "admins"}
+
+			err := s.canRequestOnBehalfOf(context.Background(), &tc.user, &ar, tc.subjects)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
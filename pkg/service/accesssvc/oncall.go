@@ -0,0 +1,64 @@
+package accesssvc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// onCallResult is the outcome of looking up the on-call schedule of an access rule.
+type onCallResult struct {
+	// approved is true if the requesting user is currently on call and the rule approves on-call users automatically.
+	approved bool
+	// routesReview is true if the rule routes review to the on-call users, even if they couldn't be looked up.
+	routesReview bool
+	// approvers are the IDs of the on-call users, if review is routed to them.
+	approvers []string
+}
+
+// checkOnCall looks up who is on call for the schedule of an access rule.
+// On-call sources are external services, so if the lookup fails the error is logged
+// and the request follows the regular approval flow of the rule.
+func (s *Service) checkOnCall(ctx context.Context, user *identity.User, oc types.OnCallConfig) (onCallResult, error) {
+	log := logger.Get(ctx).With("oncall.source", oc.Source, "oncall.schedule", oc.ScheduleId)
+	res := onCallResult{routesReview: rule.OnCallRoutesReview(oc)}
+	if s.OnCall == nil {
+		log.Warn("access rule has an on-call schedule but no on-call sources are configured")
+		return res, nil
+	}
+	emails, err := s.OnCall.OnCallEmails(ctx, string(oc.Source), oc.ScheduleId)
+	if err != nil {
+		log.Errorw("failed to look up on-call users", "error", err)
+		return res, nil
+	}
+
+	for _, email := range emails {
+		if strings.EqualFold(email, user.Email) && rule.OnCallAutoApproves(oc) {
+			res.approved = true
+		}
+		if !res.routesReview {
+			continue
+		}
+		q := storage.GetUserByEmail{Email: email}
+		_, err := s.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			log.Warnw("on-call user was not found", "email", email)
+			continue
+		}
+		if err != nil {
+			return onCallResult{}, err
+		}
+		if q.Result.Status != types.IdpStatusACTIVE {
+			continue
+		}
+		res.approvers = append(res.approvers, q.Result.ID)
+	}
+	log.Infow("looked up on-call users", "approved", res.approved, "approvers", res.approvers)
+	return res, nil
+}
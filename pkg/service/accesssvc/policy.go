@@ -0,0 +1,58 @@
+package accesssvc
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/policy"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+)
+
+// evaluatePolicy evaluates the policy of the access rule against a new request.
+// The user's requests for the rule which ended within the policy history window are included as the request history.
+//
+// If the policy can't be evaluated, for example because it reads a request argument which wasn't provided,
+// the request falls back to being reviewed so that one bad expression doesn't block every request for the rule.
+func (s *Service) evaluatePolicy(ctx context.Context, user *identity.User, accessRule rule.AccessRule, with map[string]string, timing access.Timing, now time.Time) (policy.Decision, error) {
+	rq := storage.ListRequestsForUserAndRuleAndRequestend{
+		UserID:               user.ID,
+		RuleID:               accessRule.ID,
+		RequestEndComparator: storage.GreaterThanEqual,
+		CompareTo:            now.Add(-policy.HistoryWindow),
+	}
+	_, err := s.DB.Query(ctx, &rq)
+	if err != nil && err != ddb.ErrNoItems {
+		return "", err
+	}
+
+	decision, err := policy.Evaluate(*accessRule.Policy, policy.Input{
+		User:    *user,
+		With:    with,
+		Timing:  timing,
+		Now:     now,
+		History: policy.HistoryFromRequests(rq.Result),
+	})
+	if err != nil {
+		logger.Get(ctx).Warnw("failed to evaluate access rule policy, falling back to review", "rule.id", accessRule.ID, "error", err)
+		return policy.Review, nil
+	}
+	return decision, nil
+}
+
+// isAutoApproved returns true if a request is approved without being reviewed, based on whether the
+// access rule requires approval, the decision of the rule's policy and the rule's on-call schedule.
+// Routing review to the on-call users of the rule requires the request to be reviewed.
+// If the rule has a policy, the policy decides whether the request is approved, and requests
+// from users who are on call are approved unless the policy denied the request.
+func isAutoApproved(accessRule rule.AccessRule, decision policy.Decision, onCall onCallResult) bool {
+	autoApprove := !(accessRule.Approval.IsRequired() || onCall.routesReview)
+	if accessRule.Policy != nil {
+		autoApprove = decision == policy.Approve
+	}
+	return autoApprove || onCall.approved
+}
@@ -0,0 +1,79 @@
+package accesssvc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/recurrence"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// requestRecurrence parses the recurrence rule of a request and works out its occurrences.
+// Occurrences are scheduled up to the recurrence horizon of the access rule. Rules which would have
+// occurrences after the horizon are rejected, apart from unbounded rules, which stop at the horizon.
+// Every occurrence must meet the time constraints of the access rule.
+// Returns nil if the request doesn't recur.
+func requestRecurrence(in types.CreateRequestRequest, accessRule rule.AccessRule, now time.Time) (*access.Recurrence, error) {
+	if in.Recurrence == nil || *in.Recurrence == "" {
+		return nil, nil
+	}
+	if accessRule.Recurrence == nil {
+		return nil, recurrenceError("recurring requests aren't allowed for this access rule")
+	}
+	// break-glass access is for incidents, which can't be scheduled in advance.
+	if in.BreakGlass != nil {
+		return nil, recurrenceError("break-glass requests can't recur")
+	}
+	if in.Timing.StartTime == nil {
+		return nil, recurrenceError("recurring requests must have a scheduled start time")
+	}
+
+	r, err := recurrence.Parse(*in.Recurrence)
+	if err != nil {
+		return nil, recurrenceError(err.Error())
+	}
+	horizon := rule.RecurrenceHorizon(*accessRule.Recurrence)
+	occurrences, truncated, err := r.Occurrences(*in.Timing.StartTime, now.Add(horizon), rule.MaxRecurrenceOccurrences)
+	if err == recurrence.ErrTooManyOccurrences {
+		return nil, recurrenceError(fmt.Sprintf("recurring requests can have at most %d occurrences", rule.MaxRecurrenceOccurrences))
+	}
+	if err != nil {
+		return nil, recurrenceError(err.Error())
+	}
+	if len(occurrences) == 0 {
+		return nil, recurrenceError("the recurrence rule doesn't have any occurrences")
+	}
+	if truncated && r.IsBounded() {
+		return nil, recurrenceError(fmt.Sprintf("every occurrence must start within %s of the request", horizon))
+	}
+
+	rec := access.Recurrence{
+		Rule:        *in.Recurrence,
+		Occurrences: occurrences,
+	}
+	duration := time.Second * time.Duration(in.Timing.DurationSeconds)
+	for _, timing := range rec.Timings(duration) {
+		err = validateTiming(accessRule.TimeConstraints, timing, now, "recurrence")
+		var apiErr *apio.APIError
+		if errors.As(err, &apiErr) && len(apiErr.Fields) > 0 {
+			return nil, recurrenceError(fmt.Sprintf("the occurrence starting at %s is invalid: %s", timing.StartTime.Format(time.RFC3339), apiErr.Fields[0].Error))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &rec, nil
+}
+
+func recurrenceError(msg string) error {
+	return &apio.APIError{
+		Err:    errors.New("request validation failed"),
+		Status: http.StatusBadRequest,
+		Fields: []apio.FieldError{{Field: "recurrence", Error: msg}},
+	}
+}
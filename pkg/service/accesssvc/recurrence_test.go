@@ -0,0 +1,114 @@
+package accesssvc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestRecurrence(t *testing.T) {
+	now := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+	// a Tuesday
+	start := time.Date(2022, 11, 1, 2, 0, 0, 0, time.UTC)
+	withRecurrence := rule.AccessRule{Recurrence: &types.RecurrenceConfig{MaxHorizonSeconds: 60 * 60 * 24 * 28}}
+	str := func(s string) *string { return &s }
+	tenDays := 60 * 60 * 24 * 10
+
+	type testcase struct {
+		name         string
+		give         types.CreateRequestRequest
+		rule         rule.AccessRule
+		want         *access.Recurrence
+		wantFieldErr string
+	}
+
+	testcases := []testcase{
+		{
+			name: "no recurrence",
+			give: types.CreateRequestRequest{Timing: types.RequestTiming{StartTime: &start}},
+			rule: withRecurrence,
+		},
+		{
+			name: "weekly",
+			give: types.CreateRequestRequest{Timing: types.RequestTiming{StartTime: &start}, Recurrence: str("FREQ=WEEKLY;BYDAY=TU;COUNT=3")},
+			rule: withRecurrence,
+			want: &access.Recurrence{
+				Rule:        "FREQ=WEEKLY;BYDAY=TU;COUNT=3",
+				Occurrences: []time.Time{start, start.AddDate(0, 0, 7), start.AddDate(0, 0, 14)},
+			},
+		},
+		{
+			name: "unbounded rules stop at the horizon",
+			give: types.CreateRequestRequest{Timing: types.RequestTiming{StartTime: &start}, Recurrence: str("FREQ=WEEKLY")},
+			rule: withRecurrence,
+			want: &access.Recurrence{
+				Rule:        "FREQ=WEEKLY",
+				Occurrences: []time.Time{start, start.AddDate(0, 0, 7), start.AddDate(0, 0, 14), start.AddDate(0, 0, 21)},
+			},
+		},
+		{
+			name:         "bounded rules must end within the horizon",
+			give:         types.CreateRequestRequest{Timing: types.RequestTiming{StartTime: &start}, Recurrence: str("FREQ=WEEKLY;COUNT=8")},
+			rule:         withRecurrence,
+			wantFieldErr: "every occurrence must start within 672h0m0s of the request",
+		},
+		{
+			name:         "rule doesn't allow recurrence",
+			give:         types.CreateRequestRequest{Timing: types.RequestTiming{StartTime: &start}, Recurrence: str("FREQ=DAILY")},
+			wantFieldErr: "recurring requests aren't allowed for this access rule",
+		},
+		{
+			name:         "asap requests can't recur",
+			give:         types.CreateRequestRequest{Recurrence: str("FREQ=DAILY")},
+			rule:         withRecurrence,
+			wantFieldErr: "recurring requests must have a scheduled start time",
+		},
+		{
+			name:         "break-glass requests can't recur",
+			give:         types.CreateRequestRequest{Timing: types.RequestTiming{StartTime: &start}, Recurrence: str("FREQ=DAILY"), BreakGlass: &types.CreateRequestBreakGlass{}},
+			rule:         withRecurrence,
+			wantFieldErr: "break-glass requests can't recur",
+		},
+		{
+			name:         "invalid rule",
+			give:         types.CreateRequestRequest{Timing: types.RequestTiming{StartTime: &start}, Recurrence: str("FREQ=HOURLY")},
+			rule:         withRecurrence,
+			wantFieldErr: "unsupported recurrence frequency: HOURLY",
+		},
+		{
+			name: "occurrences must be within the schedule ahead limit",
+			give: types.CreateRequestRequest{Timing: types.RequestTiming{StartTime: &start}, Recurrence: str("FREQ=WEEKLY;COUNT=3")},
+			rule: rule.AccessRule{
+				Recurrence:      withRecurrence.Recurrence,
+				TimeConstraints: types.TimeConstraints{MaxScheduleAheadSeconds: &tenDays},
+			},
+			wantFieldErr: "the occurrence starting at 2022-11-15T02:00:00Z is invalid: startTime: access can only be scheduled up to 864000 seconds in advance",
+		},
+		{
+			name:         "too many occurrences",
+			give:         types.CreateRequestRequest{Timing: types.RequestTiming{StartTime: &start}, Recurrence: str("FREQ=DAILY;COUNT=101")},
+			rule:         rule.AccessRule{Recurrence: &types.RecurrenceConfig{MaxHorizonSeconds: 31536000}},
+			wantFieldErr: "recurring requests can have at most 100 occurrences",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := requestRecurrence(tc.give, tc.rule, now)
+			if tc.wantFieldErr != "" {
+				var apiErr *apio.APIError
+				if assert.ErrorAs(t, err, &apiErr) {
+					assert.Equal(t, []apio.FieldError{{Field: "recurrence", Error: tc.wantFieldErr}}, apiErr.Fields)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/ticket"
 	"github.com/common-fate/granted-approvals/pkg/types"
 )
 
@@ -24,6 +25,27 @@ type Service struct {
 	Cache       CacheService
 	AHClient    AHClient
 	Rules       AccessRuleService
+	// OnCall looks up on-call users for access rules with an on-call schedule.
+	// If it is nil, the on-call configuration of access rules is ignored.
+	OnCall OnCallResolver
+	// Tickets verifies the tickets linked to requests for access rules which require a ticket.
+	// If it is nil, requests for these access rules can't be made.
+	Tickets TicketVerifier
+	// Requests writes requests which are updated by reviews, failing if they were updated by a concurrent review.
+	// If it is nil, requests are written without checking for concurrent reviews.
+	Requests RequestWriter
+	// AdminGroup is the group of administrators, who can request access on behalf of any user.
+	AdminGroup string
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/requestwriter.go -package=mocks . RequestWriter
+
+// RequestWriter stores requests if they haven't been updated since they were read.
+// storage.RequestWriter implements this interface.
+type RequestWriter interface {
+	// PutRequest increments the version of the request and stores it.
+	// It returns storage.ErrVersionConflict if the request was updated since it was read.
+	PutRequest(ctx context.Context, r *access.Request) error
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/granter.go -package=mocks . Granter
@@ -32,6 +54,7 @@ type Service struct {
 type Granter interface {
 	CreateGrant(ctx context.Context, opts grantsvc.CreateGrantOpts) (*access.Request, error)
 	RevokeGrant(ctx context.Context, opts grantsvc.RevokeGrantOpts) (*access.Request, error)
+	ExtendGrant(ctx context.Context, opts grantsvc.ExtendGrantOpts) (*access.Request, error)
 	ValidateGrant(ctx context.Context, opts grantsvc.CreateGrantOpts) error
 }
 
@@ -53,6 +76,20 @@ type AccessRuleService interface {
 	RequestArguments(ctx context.Context, accessRuleTarget rule.Target) (map[string]types.RequestArgument, error)
 }
 
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/oncall.go -package=mocks . OnCallResolver
+
+// OnCallResolver looks up the users who are on call for a schedule.
+type OnCallResolver interface {
+	OnCallEmails(ctx context.Context, source string, scheduleID string) ([]string, error)
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/ticket.go -package=mocks . TicketVerifier
+
+// TicketVerifier looks up tickets in a ticketing system such as Jira or Linear.
+type TicketVerifier interface {
+	GetTicket(ctx context.Context, system string, ticketID string) (*ticket.Ticket, error)
+}
+
 type AHClient interface {
 	ahTypes.ClientWithResponsesInterface
 }
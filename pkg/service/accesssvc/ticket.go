@@ -0,0 +1,76 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/ticket"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// checkTicket verifies the ticket linked to a request against the ticketing system of the access rule.
+// The ticket must exist, be in one of the allowed statuses and, if the rule requires it, be assigned to the user.
+// Break-glass requests may be made before a ticket has been raised, so a ticket is optional for them.
+func (s *Service) checkTicket(ctx context.Context, user *identity.User, tc types.TicketConfig, ticketID *string, isBreakGlass bool) (*access.Ticket, error) {
+	if ticketID == nil || strings.TrimSpace(*ticketID) == "" {
+		if isBreakGlass {
+			return nil, nil
+		}
+		return nil, ticketError("a ticket is required to request this access rule")
+	}
+	id := strings.TrimSpace(*ticketID)
+	if s.Tickets == nil {
+		return nil, ticket.SystemNotConfiguredError{System: string(tc.System)}
+	}
+
+	t, err := s.Tickets.GetTicket(ctx, string(tc.System), id)
+	if err == ticket.ErrTicketNotFound {
+		return nil, ticketError(fmt.Sprintf("ticket %s was not found in %s", id, tc.System))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := rule.TicketAllowedStatuses(tc)
+	if len(allowed) > 0 && !containsFold(allowed, t.Status) {
+		return nil, ticketError(fmt.Sprintf("ticket %s has status %s, but must have one of these statuses: %s", t.ID, t.Status, strings.Join(allowed, ", ")))
+	}
+	if rule.TicketRequiresAssignee(tc) && !strings.EqualFold(t.AssigneeEmail, user.Email) {
+		return nil, ticketError(fmt.Sprintf("ticket %s must be assigned to you", t.ID))
+	}
+
+	logger.Get(ctx).Infow("verified ticket", "ticket.system", tc.System, "ticket.id", t.ID, "ticket.status", t.Status)
+	return &access.Ticket{
+		System:  string(tc.System),
+		ID:      t.ID,
+		URL:     t.URL,
+		Summary: t.Summary,
+		Status:  t.Status,
+	}, nil
+}
+
+func ticketError(msg string) error {
+	return &apio.APIError{
+		Err:    errors.New("request validation failed"),
+		Status: http.StatusBadRequest,
+		Fields: []apio.FieldError{{Field: "ticketId", Error: msg}},
+	}
+}
+
+// containsFold returns true if str is in set, ignoring case.
+func containsFold(set []string, str string) bool {
+	for _, s := range set {
+		if strings.EqualFold(s, str) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/granted-approvals/pkg/ticket"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckTicket(t *testing.T) {
+	yes := true
+	statuses := []string{"In Progress", "Approved"}
+	user := identity.User{ID: "usr_a", Email: "alice@acme.com"}
+	inProgress := ticket.Ticket{ID: "OPS-1", URL: "https://acme.atlassian.net/browse/OPS-1", Summary: "Database failover", Status: "in progress", AssigneeEmail: "Alice@acme.com"}
+	str := func(s string) *string { return &s }
+
+	type testcase struct {
+		name          string
+		config        types.TicketConfig
+		ticketID      *string
+		isBreakGlass  bool
+		withTicket    *ticket.Ticket
+		withTicketErr error
+		want          *access.Ticket
+		wantFieldErr  string
+		wantErr       error
+	}
+
+	testcases := []testcase{
+		{
+			name:       "ok",
+			config:     types.TicketConfig{System: types.Jira, AllowedStatuses: &statuses, RequireAssignee: &yes},
+			ticketID:   str(" OPS-1 "),
+			withTicket: &inProgress,
+			want:       &access.Ticket{System: "jira", ID: "OPS-1", URL: "https://acme.atlassian.net/browse/OPS-1", Summary: "Database failover", Status: "in progress"},
+		},
+		{
+			name:         "missing ticket",
+			config:       types.TicketConfig{System: types.Jira},
+			wantFieldErr: "a ticket is required to request this access rule",
+		},
+		{
+			name:         "break-glass without a ticket",
+			config:       types.TicketConfig{System: types.Jira},
+			isBreakGlass: true,
+		},
+		{
+			name:          "ticket not found",
+			config:        types.TicketConfig{System: types.Linear},
+			ticketID:      str("ENG-404"),
+			withTicketErr: ticket.ErrTicketNotFound,
+			wantFieldErr:  "ticket ENG-404 was not found in linear",
+		},
+		{
+			name:         "status not allowed",
+			config:       types.TicketConfig{System: types.Jira, AllowedStatuses: &statuses},
+			ticketID:     str("OPS-1"),
+			withTicket:   &ticket.Ticket{ID: "OPS-1", Status: "Done"},
+			wantFieldErr: "ticket OPS-1 has status Done, but must have one of these statuses: In Progress, Approved",
+		},
+		{
+			name:         "not assigned to the user",
+			config:       types.TicketConfig{System: types.Jira, RequireAssignee: &yes},
+			ticketID:     str("OPS-1"),
+			withTicket:   &ticket.Ticket{ID: "OPS-1", Status: "Done", AssigneeEmail: "bob@acme.com"},
+			wantFieldErr: "ticket OPS-1 must be assigned to you",
+		},
+		{
+			name:          "ticketing system unavailable",
+			config:        types.TicketConfig{System: types.Jira},
+			ticketID:      str("OPS-1"),
+			withTicketErr: errors.New("connection refused"),
+			wantErr:       errors.New("connection refused"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tv := mocks.NewMockTicketVerifier(ctrl)
+			if tc.withTicket != nil || tc.withTicketErr != nil {
+				tv.EXPECT().GetTicket(gomock.Any(), string(tc.config.System), gomock.Any()).Return(tc.withTicket, tc.withTicketErr)
+			}
+			s := Service{Tickets: tv}
+
+			got, err := s.checkTicket(context.Background(), &user, tc.config, tc.ticketID, tc.isBreakGlass)
+			if tc.wantFieldErr != "" {
+				var apiErr *apio.APIError
+				if assert.ErrorAs(t, err, &apiErr) {
+					assert.Equal(t, http.StatusBadRequest, apiErr.Status)
+					assert.Equal(t, []apio.FieldError{{Field: "ticketId", Error: tc.wantFieldErr}}, apiErr.Fields)
+				}
+				return
+			}
+			if tc.wantErr != nil {
+				assert.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package accesssvc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// validateTiming checks the timing of a request against the time constraints of an access rule.
+// field is the name of the timing field in the API request, which is used in the returned field errors.
+func validateTiming(tc types.TimeConstraints, timing access.Timing, now time.Time, field string) error {
+	var fields []apio.FieldError
+	durationSeconds := int(timing.Duration.Seconds())
+
+	if durationSeconds > tc.MaxDurationSeconds {
+		fields = append(fields, apio.FieldError{
+			Field: field + ".durationSeconds",
+			Error: fmt.Sprintf("durationSeconds: %d exceeds the maximum duration seconds: %d", durationSeconds, tc.MaxDurationSeconds),
+		})
+	}
+	if tc.MinDurationSeconds != nil && durationSeconds < *tc.MinDurationSeconds {
+		fields = append(fields, apio.FieldError{
+			Field: field + ".durationSeconds",
+			Error: fmt.Sprintf("durationSeconds: %d is less than the minimum duration seconds: %d", durationSeconds, *tc.MinDurationSeconds),
+		})
+	}
+	if timing.StartTime != nil && tc.MaxScheduleAheadSeconds != nil {
+		horizon := now.Add(time.Second * time.Duration(*tc.MaxScheduleAheadSeconds))
+		if timing.StartTime.After(horizon) {
+			fields = append(fields, apio.FieldError{
+				Field: field + ".startTime",
+				Error: fmt.Sprintf("startTime: access can only be scheduled up to %d seconds in advance", *tc.MaxScheduleAheadSeconds),
+			})
+		}
+	}
+	if tc.AllowedWindows != nil {
+		start, end := timing.GetInterval(access.WithNow(now))
+		ok, err := rule.AllowedWindowsContain(*tc.AllowedWindows, start, end)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fields = append(fields, apio.FieldError{
+				Field: field,
+				Error: "access must start and end within the allowed windows for the access rule",
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return &apio.APIError{
+			Err:    errors.New("request validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package accesssvc
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTiming(t *testing.T) {
+	type testcase struct {
+		name       string
+		give       access.Timing
+		tc         types.TimeConstraints
+		wantFields []apio.FieldError
+	}
+
+	// 2022-10-03 is a Monday.
+	now := time.Date(2022, 10, 3, 10, 0, 0, 0, time.UTC)
+	min := 1800
+	ahead := 86400
+	brisbane := "Australia/Brisbane"
+	businessHours := &types.AllowedWindows{
+		Windows: []types.TimeWindow{
+			{Days: []types.Weekday{types.MONDAY, types.TUESDAY, types.WEDNESDAY, types.THURSDAY, types.FRIDAY}, StartHour: 9, EndHour: 17},
+		},
+	}
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: access.Timing{Duration: time.Hour},
+			tc:   types.TimeConstraints{MaxDurationSeconds: 3600, MinDurationSeconds: &min},
+		},
+		{
+			name: "exceeds max duration",
+			give: access.Timing{Duration: 2 * time.Hour},
+			tc:   types.TimeConstraints{MaxDurationSeconds: 3600},
+			wantFields: []apio.FieldError{
+				{Field: "timing.durationSeconds", Error: "durationSeconds: 7200 exceeds the maximum duration seconds: 3600"},
+			},
+		},
+		{
+			name: "less than min duration",
+			give: access.Timing{Duration: time.Minute},
+			tc:   types.TimeConstraints{MaxDurationSeconds: 3600, MinDurationSeconds: &min},
+			wantFields: []apio.FieldError{
+				{Field: "timing.durationSeconds", Error: "durationSeconds: 60 is less than the minimum duration seconds: 1800"},
+			},
+		},
+		{
+			name: "scheduled within horizon",
+			give: access.Timing{Duration: time.Hour, StartTime: ptr(now.Add(12 * time.Hour))},
+			tc:   types.TimeConstraints{MaxDurationSeconds: 3600, MaxScheduleAheadSeconds: &ahead},
+		},
+		{
+			name: "scheduled beyond horizon",
+			give: access.Timing{Duration: time.Hour, StartTime: ptr(now.Add(48 * time.Hour))},
+			tc:   types.TimeConstraints{MaxDurationSeconds: 3600, MaxScheduleAheadSeconds: &ahead},
+			wantFields: []apio.FieldError{
+				{Field: "timing.startTime", Error: "startTime: access can only be scheduled up to 86400 seconds in advance"},
+			},
+		},
+		{
+			name: "within business hours",
+			give: access.Timing{Duration: time.Hour},
+			tc:   types.TimeConstraints{MaxDurationSeconds: 3600, AllowedWindows: businessHours},
+		},
+		{
+			name: "ends after business hours",
+			give: access.Timing{Duration: 2 * time.Hour, StartTime: ptr(time.Date(2022, 10, 3, 16, 0, 0, 0, time.UTC))},
+			tc:   types.TimeConstraints{MaxDurationSeconds: 7200, AllowedWindows: businessHours},
+			wantFields: []apio.FieldError{
+				{Field: "timing", Error: "access must start and end within the allowed windows for the access rule"},
+			},
+		},
+		{
+			name: "on the weekend",
+			give: access.Timing{Duration: time.Hour, StartTime: ptr(time.Date(2022, 10, 8, 10, 0, 0, 0, time.UTC))},
+			tc:   types.TimeConstraints{MaxDurationSeconds: 3600, AllowedWindows: businessHours},
+			wantFields: []apio.FieldError{
+				{Field: "timing", Error: "access must start and end within the allowed windows for the access rule"},
+			},
+		},
+		{
+			name: "windows are evaluated in the configured timezone",
+			// 10:00 UTC is 20:00 in Brisbane, which is outside business hours.
+			give: access.Timing{Duration: time.Hour},
+			tc: types.TimeConstraints{MaxDurationSeconds: 3600, AllowedWindows: &types.AllowedWindows{
+				Timezone: &brisbane,
+				Windows:  businessHours.Windows,
+			}},
+			wantFields: []apio.FieldError{
+				{Field: "timing", Error: "access must start and end within the allowed windows for the access rule"},
+			},
+		},
+		{
+			name: "adjacent windows allow access across midnight",
+			give: access.Timing{Duration: 4 * time.Hour, StartTime: ptr(time.Date(2022, 10, 3, 22, 0, 0, 0, time.UTC))},
+			tc: types.TimeConstraints{MaxDurationSeconds: 14400, AllowedWindows: &types.AllowedWindows{
+				Windows: []types.TimeWindow{
+					{Days: []types.Weekday{types.MONDAY}, StartHour: 20, EndHour: 24},
+					{Days: []types.Weekday{types.TUESDAY}, StartHour: 0, EndHour: 6},
+				},
+			}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateTiming(tc.tc, tc.give, now, "timing")
+			if tc.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, &apio.APIError{
+				Err:    errors.New("request validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: tc.wantFields,
+			}, err)
+		})
+	}
+}
@@ -8,12 +8,15 @@ import (
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/iso8601"
 
+	"github.com/common-fate/ddb"
 	"github.com/common-fate/ddb/ddbmock"
 	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
 	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/identity"
 	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -211,3 +214,134 @@ func TestCreateGrant(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateRecurringGrants(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	occurrences := []time.Time{now.Add(-time.Hour * 2), now.Add(time.Hour), now.Add(time.Hour * 24 * 7)}
+	approved := types.REVIEWED
+	parent := access.Request{
+		ID:             "req_parent",
+		RequestedBy:    "usr_a",
+		Rule:           "rul_a",
+		Status:         access.APPROVED,
+		ApprovalMethod: &approved,
+		RequestedTiming: access.Timing{
+			Duration:  time.Hour,
+			StartTime: &occurrences[0],
+		},
+		Recurrence: &access.Recurrence{
+			Rule:        "FREQ=WEEKLY;COUNT=3",
+			Occurrences: occurrences,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	g := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+	// the first occurrence has already ended, so grants are only created for the other two.
+	for _, occurrence := range occurrences[1:] {
+		start, end := occurrence, occurrence.Add(time.Hour)
+		g.EXPECT().PostGrantsWithResponse(gomock.Any(), gomock.AssignableToTypeOf(ahTypes.PostGrantsJSONRequestBody{})).DoAndReturn(
+			func(ctx context.Context, body ahTypes.PostGrantsJSONRequestBody, _ ...ahTypes.RequestEditorFn) (*ahTypes.PostGrantsResponse, error) {
+				assert.Equal(t, iso8601.New(start), body.Start)
+				assert.Equal(t, iso8601.New(end), body.End)
+				return &ahTypes.PostGrantsResponse{
+					JSON201: &struct {
+						Grant ahTypes.Grant "json:\"grant\""
+					}{
+						Grant: ahTypes.Grant{ID: body.Id, Start: body.Start, End: body.End, Subject: body.Subject},
+					},
+				}, nil
+			})
+	}
+	c := ddbmock.New(t)
+	c.MockQuery(&storage.GetUser{Result: &identity.User{Email: "test@test.com"}})
+	c.MockQueryWithErr(&storage.GetRequest{}, ddb.ErrNoItems)
+
+	s := Granter{
+		AHClient:           g,
+		DB:                 c,
+		Clock:              clk,
+		accessTokenChecker: testAccessTokenChecker{},
+	}
+
+	got, err := s.CreateGrant(context.Background(), CreateGrantOpts{Request: parent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, got.Grant)
+	assert.Equal(t, []string{"req_parent-0-1", "req_parent-0-2"}, got.Recurrence.OccurrenceRequestIDs)
+	assert.Nil(t, parent.Recurrence.OccurrenceRequestIDs, "the recurrence of the given request should not be modified")
+
+	// creating the grants again keeps the occurrences which were already created.
+	c.MockQuery(&storage.GetRequest{Result: &access.Request{ID: "req_parent-0-1"}})
+	got, err = s.CreateGrant(context.Background(), CreateGrantOpts{Request: parent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"req_parent-0-1", "req_parent-0-2"}, got.Recurrence.OccurrenceRequestIDs)
+}
+
+func TestCreateRecurringGrantsRevokesOccurrencesOnFailure(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	occurrences := []time.Time{now.Add(time.Hour), now.Add(time.Hour * 24 * 7), now.Add(time.Hour * 24 * 14)}
+	parent := access.Request{
+		ID:          "req_parent",
+		RequestedBy: "usr_a",
+		Status:      access.APPROVED,
+		RequestedTiming: access.Timing{
+			Duration:  time.Hour,
+			StartTime: &occurrences[0],
+		},
+		Recurrence: &access.Recurrence{
+			Rule:        "FREQ=WEEKLY;COUNT=3",
+			Occurrences: occurrences,
+		},
+		Version: 1,
+	}
+
+	ctrl := gomock.NewController(t)
+	g := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+	// the grant for the third occurrence fails to be created.
+	created := 0
+	g.EXPECT().PostGrantsWithResponse(gomock.Any(), gomock.AssignableToTypeOf(ahTypes.PostGrantsJSONRequestBody{})).DoAndReturn(
+		func(ctx context.Context, body ahTypes.PostGrantsJSONRequestBody, _ ...ahTypes.RequestEditorFn) (*ahTypes.PostGrantsResponse, error) {
+			created++
+			if created == 3 {
+				msg := "provider error"
+				return &ahTypes.PostGrantsResponse{JSON400: &struct {
+					Error *string "json:\"error,omitempty\""
+				}{Error: &msg}}, nil
+			}
+			return &ahTypes.PostGrantsResponse{
+				JSON201: &struct {
+					Grant ahTypes.Grant "json:\"grant\""
+				}{
+					Grant: ahTypes.Grant{ID: body.Id, Start: body.Start, End: body.End, Subject: body.Subject, Status: ahTypes.GrantStatusPENDING},
+				},
+			}, nil
+		}).Times(3)
+	// the grants which were created for the first two occurrences are revoked.
+	for _, id := range []string{"req_parent-1-0", "req_parent-1-1"} {
+		g.EXPECT().PostGrantsRevokeWithResponse(gomock.Any(), id, ahTypes.PostGrantsRevokeJSONRequestBody{RevokerId: occurrenceRevokerID}).Return(&ahTypes.PostGrantsRevokeResponse{JSON200: &struct {
+			Grant ahTypes.Grant "json:\"grant\""
+		}{}}, nil)
+	}
+
+	c := ddbmock.New(t)
+	c.MockQuery(&storage.GetUser{Result: &identity.User{Email: "test@test.com"}})
+	c.MockQueryWithErr(&storage.GetRequest{}, ddb.ErrNoItems)
+	c.MockQuery(&storage.ListRequestReviewers{})
+
+	s := Granter{
+		AHClient:           g,
+		DB:                 c,
+		Clock:              clk,
+		EventBus:           gevent.NewInProcessBus(),
+		accessTokenChecker: testAccessTokenChecker{},
+	}
+
+	_, err := s.CreateGrant(context.Background(), CreateGrantOpts{Request: parent})
+	assert.EqualError(t, err, "provider error")
+}
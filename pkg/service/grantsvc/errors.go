@@ -9,6 +9,10 @@ var (
 	ErrGrantInactive = errors.New("only active grants can be revoked")
 	// ErrNoGrant is returned when attempting to revoke a request which has no grant yet
 	ErrNoGrant = errors.New("request has no grant")
+	// ErrGrantNotExtendable is returned when attempting to extend a grant which is not pending or active
+	ErrGrantNotExtendable = errors.New("only pending or active grants can be extended")
+	// ErrNoUpcomingOccurrences is returned when approving a recurring request whose occurrences have all ended
+	ErrNoUpcomingOccurrences = errors.New("every occurrence of the recurring request has ended")
 	// ErrNoGrant is returned when attempting to revoke a request which has no grant yet
 )
 
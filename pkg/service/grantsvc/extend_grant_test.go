@@ -0,0 +1,92 @@
+package grantsvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	ah_types "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/iso8601"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendGrant(t *testing.T) {
+	type testcase struct {
+		name    string
+		give    ExtendGrantOpts
+		wantEnd time.Time
+		wantErr error
+	}
+	clk := clock.NewMock()
+	start := clk.Now().Add(-time.Hour)
+	end := clk.Now().Add(time.Hour)
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: ExtendGrantOpts{
+				Request: access.Request{
+					ID:              "123",
+					RequestedTiming: access.Timing{Duration: 2 * time.Hour},
+					Grant:           &access.Grant{Start: start, End: end, Status: ah_types.GrantStatusACTIVE},
+				},
+				Duration: time.Hour,
+			},
+			wantEnd: end.Add(time.Hour),
+		},
+		{
+			name:    "request has no grant",
+			give:    ExtendGrantOpts{Request: access.Request{ID: "123"}, Duration: time.Hour},
+			wantErr: ErrNoGrant,
+		},
+		{
+			name: "revoked grant",
+			give: ExtendGrantOpts{
+				Request: access.Request{
+					ID:    "123",
+					Grant: &access.Grant{Start: start, End: end, Status: ah_types.GrantStatusREVOKED},
+				},
+				Duration: time.Hour,
+			},
+			wantErr: ErrGrantNotExtendable,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ah := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+			ah.EXPECT().PostGrantsExtendWithResponse(gomock.Any(), "123", ah_types.PostGrantsExtendJSONRequestBody{
+				End: iso8601.New(tc.wantEnd),
+			}).Return(&ah_types.PostGrantsExtendResponse{JSON200: &struct {
+				Grant ah_types.Grant "json:\"grant\""
+			}{Grant: ah_types.Grant{
+				ID:     "123",
+				Start:  iso8601.New(start),
+				End:    iso8601.New(tc.wantEnd),
+				Status: ah_types.GrantStatusACTIVE,
+			}}}, nil).AnyTimes()
+
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestReviewers{})
+			db.MockQueryWithErr(&storage.GetAccessToken{}, ddb.ErrNoItems)
+
+			s := Granter{AHClient: ah, DB: db, Clock: clk}
+			got, err := s.ExtendGrant(context.Background(), tc.give)
+
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantEnd, got.Grant.End)
+			assert.Equal(t, &access.Timing{Duration: 3 * time.Hour, StartTime: &start}, got.OverrideTiming)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/segmentio/ksuid"
@@ -36,10 +37,13 @@ type AHClient interface {
 
 // Granter has logic to integrate with the Access Handler.
 type Granter struct {
-	AHClient           ahTypes.ClientWithResponsesInterface
-	DB                 ddb.Storage
-	Clock              clock.Clock
-	EventBus           *gevent.Sender
+	AHClient ahTypes.ClientWithResponsesInterface
+	DB       ddb.Storage
+	Clock    clock.Clock
+	EventBus gevent.EventBus
+	// Requests writes requests only if they haven't been updated since they were read.
+	// If it is nil, requests are written without checking for concurrent updates.
+	Requests           dbupdate.RequestWriter
 	accessTokenChecker accessTokenChecker
 }
 
@@ -47,8 +51,9 @@ type GranterOpts struct {
 	AHClient         ahTypes.ClientWithResponsesInterface
 	DB               ddb.Storage
 	Clock            clock.Clock
-	EventBus         *gevent.Sender
+	EventBus         gevent.EventBus
 	DeploymentConfig deploy.DeployConfigReader
+	Requests         dbupdate.RequestWriter
 }
 
 // New creates a new Granter service.
@@ -58,6 +63,7 @@ func New(opts GranterOpts) *Granter {
 		DB:       opts.DB,
 		Clock:    opts.Clock,
 		EventBus: opts.EventBus,
+		Requests: opts.Requests,
 		accessTokenChecker: registryAccessTokenChecker{
 			DeploymentConfig: opts.DeploymentConfig,
 			Registry:         providerregistry.Registry(),
@@ -75,7 +81,12 @@ type RevokeGrantOpts struct {
 	RevokerID string
 }
 
+// RevokeGrant revokes the grant of a request in the Access Handler.
+// Revoking a recurring request revokes the grants of each of its occurrences which are pending or active.
 func (g *Granter) RevokeGrant(ctx context.Context, opts RevokeGrantOpts) (*access.Request, error) {
+	if opts.Request.Grant == nil && opts.Request.Recurrence != nil && len(opts.Request.Recurrence.OccurrenceRequestIDs) > 0 {
+		return g.revokeRecurringGrants(ctx, opts)
+	}
 	if opts.Request.Grant == nil {
 		return nil, ErrNoGrant
 	}
@@ -93,20 +104,20 @@ func (g *Granter) RevokeGrant(ctx context.Context, opts RevokeGrantOpts) (*acces
 	}
 
 	if res.JSON200 != nil {
-		oldStatus := opts.Request.Grant.Status
-		opts.Request.Grant.Status = ahTypes.GrantStatusREVOKED
-		opts.Request.Grant.UpdatedAt = g.Clock.Now()
-		items, err := dbupdate.GetUpdateRequestItems(ctx, g.DB, opts.Request)
-		if err != nil {
-			return nil, err
-		}
-
-		//create a request event for audit loggging request change
-		requestEvent := access.NewGrantStatusChangeEvent(opts.Request.ID, opts.Request.Grant.UpdatedAt, &opts.RevokerID, oldStatus, opts.Request.Grant.Status)
-
-		items = append(items, &requestEvent)
-
-		err = g.DB.PutBatch(ctx, items...)
+		now := g.Clock.Now()
+		// the grant has been revoked, so the status change is reapplied if the request was updated concurrently.
+		err = dbupdate.RetryUpdateRequest(ctx, g.DB, g.Requests, &opts.Request, func(r *access.Request) ([]ddb.Keyer, error) {
+			if r.Grant == nil {
+				return nil, ErrNoGrant
+			}
+			oldStatus := r.Grant.Status
+			r.Grant.Status = ahTypes.GrantStatusREVOKED
+			r.Grant.UpdatedAt = now
+
+			//create a request event for audit loggging request change
+			requestEvent := access.NewGrantStatusChangeEvent(r.ID, now, &opts.RevokerID, oldStatus, r.Grant.Status)
+			return []ddb.Keyer{&requestEvent}, nil
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -137,6 +148,97 @@ func (g *Granter) RevokeGrant(ctx context.Context, opts RevokeGrantOpts) (*acces
 	return nil, errors.New("unhandled response code")
 }
 
+type ExtendGrantOpts struct {
+	Request access.Request
+	// Duration is the amount of time to move the end of the grant by.
+	Duration time.Duration
+	// ExtenderID is the ID of the user who approved the extension, or nil if it was approved automatically.
+	ExtenderID *string
+}
+
+// ExtendGrant moves the end time of the grant for a request later in the Access Handler.
+// The existing grant is rescheduled rather than creating a new grant, so access is not reprovisioned.
+// The updated timing is saved on the request and recorded in the audit trail.
+func (g *Granter) ExtendGrant(ctx context.Context, opts ExtendGrantOpts) (*access.Request, error) {
+	if opts.Request.Grant == nil {
+		return nil, ErrNoGrant
+	}
+	canExtend := opts.Request.Grant.Status == ahTypes.GrantStatusACTIVE || opts.Request.Grant.Status == ahTypes.GrantStatusPENDING
+	if !canExtend || opts.Request.Grant.End.Before(g.Clock.Now()) {
+		return nil, ErrGrantNotExtendable
+	}
+
+	end := opts.Request.Grant.End.Add(opts.Duration)
+	res, err := g.AHClient.PostGrantsExtendWithResponse(ctx, opts.Request.ID, ahTypes.PostGrantsExtendJSONRequestBody{
+		End: iso8601.New(end),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.JSON200 != nil {
+		now := g.Clock.Now()
+		start := res.JSON200.Grant.Start.Time
+		newTiming := access.Timing{
+			Duration:  res.JSON200.Grant.End.Sub(start),
+			StartTime: &start,
+		}
+		// access tokens expire at the end of the grant, so they need to be extended too.
+		atq := storage.GetAccessToken{RequestID: opts.Request.ID}
+		_, err = g.DB.Query(ctx, &atq)
+		if err != nil && err != ddb.ErrNoItems {
+			return nil, err
+		}
+		hasAccessToken := err == nil
+
+		// the grant has been extended, so the new timing is reapplied if the request was updated concurrently.
+		err = dbupdate.RetryUpdateRequest(ctx, g.DB, g.Requests, &opts.Request, func(r *access.Request) ([]ddb.Keyer, error) {
+			if r.Grant == nil {
+				return nil, ErrNoGrant
+			}
+			oldTiming := r.GetTiming()
+			r.OverrideTiming = &newTiming
+			r.Grant.End = res.JSON200.Grant.End.Time
+			r.Grant.UpdatedAt = now
+			r.UpdatedAt = now
+
+			var items []ddb.Keyer
+			if hasAccessToken {
+				atq.Result.End = r.Grant.End
+				items = append(items, atq.Result)
+			}
+			//create a request event for audit loggging the timing change
+			requestEvent := access.NewTimingChangeEvent(r.ID, now, opts.ExtenderID, oldTiming, newTiming)
+			items = append(items, &requestEvent)
+			return items, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &opts.Request, nil
+	}
+
+	if res.JSON400 != nil {
+		logger.Get(ctx).Errorw("Invalid request", "body", string(res.Body))
+
+		return nil, fmt.Errorf(*res.JSON400.Error)
+	}
+
+	if res.JSON404 != nil {
+		logger.Get(ctx).Errorw("Grant not found", "body", string(res.Body))
+
+		return nil, fmt.Errorf(*res.JSON404.Error)
+	}
+
+	if res.JSON500 != nil {
+		logger.Get(ctx).Errorw("Internal server error", "body", string(res.Body))
+
+		return nil, fmt.Errorf(*res.JSON500.Error)
+	}
+	logger.Get(ctx).Errorw("unhandled Access Handler response", "body", string(res.Body))
+	return nil, errors.New("unhandled response code")
+}
+
 // validate grant runs all the checks that will need to occur when creating a real grant to validate its success
 func (g *Granter) ValidateGrant(ctx context.Context, opts CreateGrantOpts) error {
 	req, err := g.prepareCreateGrantRequest(ctx, opts)
@@ -175,7 +277,149 @@ func (g *Granter) ValidateGrant(ctx context.Context, opts CreateGrantOpts) error
 
 // CreateGrant creates a Grant in the Access Handler, it does not update the approvals app database.
 // the returned Request will contain the newly created grant
+//
+// For recurring requests, a request is created and saved for each occurrence which hasn't ended, and each of them receives its own grant.
+// The returned Request won't have a grant, but will contain the IDs of the requests for each occurrence.
 func (g *Granter) CreateGrant(ctx context.Context, opts CreateGrantOpts) (*access.Request, error) {
+	if opts.Request.Recurrence != nil {
+		return g.createRecurringGrants(ctx, opts)
+	}
+	return g.createGrant(ctx, opts)
+}
+
+// createRecurringGrants creates a request with a grant for each upcoming occurrence of a recurring request.
+// The IDs of the occurrence requests are derived from the version of the recurring request, so if creating the grants
+// is retried, the occurrences which were already created are kept rather than granting access twice.
+//
+// If a grant can't be created, the grants of the occurrences created so far are revoked, so that
+// access isn't left in place while the recurring request isn't approved.
+func (g *Granter) createRecurringGrants(ctx context.Context, opts CreateGrantOpts) (res *access.Request, err error) {
+	parent := opts.Request
+	rec := *parent.Recurrence
+	rec.OccurrenceRequestIDs = nil
+	now := g.Clock.Now()
+
+	var occurrences []access.Request
+	defer func() {
+		if err != nil {
+			g.revokeOccurrences(ctx, parent, occurrences)
+		}
+	}()
+
+	for i, timing := range parent.Timings() {
+		_, end := timing.GetInterval()
+		if !end.After(now) {
+			continue
+		}
+		id := occurrenceRequestID(parent, i)
+		q := storage.GetRequest{ID: id}
+		_, err := g.DB.Query(ctx, &q)
+		if err == nil {
+			logger.Get(ctx).Infow("occurrence of recurring request was already created", "request.id", parent.ID, "occurrence.id", id)
+			rec.OccurrenceRequestIDs = append(rec.OccurrenceRequestIDs, id)
+			occurrences = append(occurrences, *q.Result)
+			continue
+		}
+		if err != ddb.ErrNoItems {
+			return nil, err
+		}
+		occurrence := access.Request{
+			ID:              id,
+			RequestedBy:     parent.RequestedBy,
+			Rule:            parent.Rule,
+			RuleVersion:     parent.RuleVersion,
+			SelectedWith:    parent.SelectedWith,
+			Status:          access.APPROVED,
+			Data:            parent.Data,
+			RequestedTiming: timing,
+			ApprovalMethod:  parent.ApprovalMethod,
+			SubmittedBy:     parent.SubmittedBy,
+			RecurrenceOf:    &parent.ID,
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		}
+		logger.Get(ctx).Infow("creating grant for occurrence of recurring request", "request.id", parent.ID, "occurrence.id", occurrence.ID, "occurrence.start", timing.StartTime)
+		updated, err := g.createGrant(ctx, CreateGrantOpts{Request: occurrence, AccessRule: opts.AccessRule})
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, *updated)
+
+		// audit log event
+		createdEvent := access.NewRecordedEvent(updated.ID, nil, now, map[string]string{
+			"event":        "request.occurrence_created",
+			"recurrenceOf": parent.ID,
+		})
+		err = g.DB.PutBatch(ctx, updated, &createdEvent)
+		if err != nil {
+			return nil, err
+		}
+		rec.OccurrenceRequestIDs = append(rec.OccurrenceRequestIDs, updated.ID)
+	}
+	if len(rec.OccurrenceRequestIDs) == 0 {
+		return nil, ErrNoUpcomingOccurrences
+	}
+
+	parent.Recurrence = &rec
+	return &parent, nil
+}
+
+// occurrenceRequestID returns the ID of the request for an occurrence of a recurring request.
+// The grants of occurrences are revoked if the recurring request fails to be granted, and grant IDs
+// can't be reused, so the ID includes the version of the recurring request which was approved.
+func occurrenceRequestID(parent access.Request, occurrence int) string {
+	return fmt.Sprintf("%s-%d-%d", parent.ID, parent.Version, occurrence)
+}
+
+// occurrenceRevokerID is recorded as the revoker of the grants of occurrences which are revoked
+// because the recurring request failed to be granted.
+const occurrenceRevokerID = "granted-approvals"
+
+// revokeOccurrences revokes the grants of the occurrences of a recurring request which failed to be granted.
+// Errors are logged, as the error which caused the grants to be revoked is returned to the caller.
+func (g *Granter) revokeOccurrences(ctx context.Context, parent access.Request, occurrences []access.Request) {
+	for _, occurrence := range occurrences {
+		logger.Get(ctx).Infow("revoking grant for occurrence of recurring request which failed to be granted", "request.id", parent.ID, "occurrence.id", occurrence.ID)
+		_, err := g.RevokeGrant(ctx, RevokeGrantOpts{Request: occurrence, RevokerID: occurrenceRevokerID})
+		if err != nil && err != ErrGrantInactive && err != ErrNoGrant {
+			logger.Get(ctx).Errorw("error revoking grant for occurrence of recurring request", "request.id", parent.ID, "occurrence.id", occurrence.ID, "error", err)
+		}
+	}
+}
+
+// revokeRecurringGrants revokes the grants of every occurrence of a recurring request which are pending or active.
+func (g *Granter) revokeRecurringGrants(ctx context.Context, opts RevokeGrantOpts) (*access.Request, error) {
+	now := g.Clock.Now()
+	revoked := 0
+	for _, id := range opts.Request.Recurrence.OccurrenceRequestIDs {
+		q := storage.GetRequest{ID: id}
+		_, err := g.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		occurrence := *q.Result
+		if occurrence.Grant == nil || occurrence.Grant.End.Before(now) {
+			continue
+		}
+		if occurrence.Grant.Status != ahTypes.GrantStatusACTIVE && occurrence.Grant.Status != ahTypes.GrantStatusPENDING {
+			continue
+		}
+		_, err = g.RevokeGrant(ctx, RevokeGrantOpts{Request: occurrence, RevokerID: opts.RevokerID})
+		if err != nil {
+			return nil, err
+		}
+		revoked++
+	}
+	if revoked == 0 {
+		return nil, ErrGrantInactive
+	}
+	return &opts.Request, nil
+}
+
+func (g *Granter) createGrant(ctx context.Context, opts CreateGrantOpts) (*access.Request, error) {
 	req, err := g.prepareCreateGrantRequest(ctx, opts)
 	if err != nil {
 		return nil, err
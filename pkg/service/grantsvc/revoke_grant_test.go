@@ -10,7 +10,10 @@ import (
 	ah_types "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
 
+	"github.com/common-fate/ddb/ddbmock"
 	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/storage"
 	"github.com/common-fate/iso8601"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -72,3 +75,42 @@ func TestAccessRevoke(t *testing.T) {
 	}
 
 }
+
+func TestRevokeRecurringGrants(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	parent := access.Request{
+		ID:     "req_parent",
+		Status: access.APPROVED,
+		Recurrence: &access.Recurrence{
+			Rule:                 "FREQ=WEEKLY;COUNT=2",
+			Occurrences:          []time.Time{now, now.Add(time.Hour * 24 * 7)},
+			OccurrenceRequestIDs: []string{"req_parent-0"},
+		},
+	}
+	occurrence := access.Request{
+		ID:     "req_parent-0",
+		Status: access.APPROVED,
+		Grant: &access.Grant{
+			Subject: "test@test.com",
+			Start:   now,
+			End:     now.Add(time.Hour),
+			Status:  ah_types.GrantStatusACTIVE,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	ah := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+	ah.EXPECT().PostGrantsRevokeWithResponse(gomock.Any(), "req_parent-0", ah_types.PostGrantsRevokeJSONRequestBody{RevokerId: "usr_admin"}).Return(&ah_types.PostGrantsRevokeResponse{JSON200: &struct {
+		Grant ah_types.Grant "json:\"grant\""
+	}{}}, nil).Times(1)
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetRequest{Result: &occurrence})
+	db.MockQuery(&storage.ListRequestReviewers{})
+
+	s := Granter{AHClient: ah, DB: db, Clock: clk, EventBus: gevent.NewInProcessBus()}
+	got, err := s.RevokeGrant(context.Background(), RevokeGrantOpts{Request: parent, RevokerID: "usr_admin"})
+	assert.NoError(t, err)
+	assert.Equal(t, &parent, got)
+}
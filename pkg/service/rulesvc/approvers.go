@@ -2,9 +2,12 @@ package rulesvc
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"sort"
 	"sync"
 
+	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/storage"
@@ -47,15 +50,26 @@ func (u *userMap) All() []string {
 // GetApprovers gets all the approvers for a rule, both those assigned as individuals and those
 // assigned via a group. It de-duplicates users, so if a user is assigned as an approver through
 // multiple groups they'll only be returned once.
+//
+// For rules with multi-stage approvals, the approvers for every stage are returned.
 func GetApprovers(ctx context.Context, db ddb.Storage, rule rule.AccessRule) ([]string, error) {
+	return getApprovers(ctx, db, rule.Approval.AllUsers(), rule.Approval.AllGroups())
+}
+
+// GetStageApprovers gets the approvers for a single stage of a multi-stage approval workflow.
+func GetStageApprovers(ctx context.Context, db ddb.Storage, stage rule.ApprovalStage) ([]string, error) {
+	return getApprovers(ctx, db, stage.Users, stage.Groups)
+}
+
+func getApprovers(ctx context.Context, db ddb.Storage, approverUsers []string, approverGroups []string) ([]string, error) {
 	users := newUserMap()
 
-	for _, u := range rule.Approval.Users {
+	for _, u := range approverUsers {
 		users.Add(u)
 	}
 
 	wg, gctx := errgroup.WithContext(ctx)
-	for _, g := range rule.Approval.Groups {
+	for _, g := range approverGroups {
 		id := g
 		wg.Go(func() error {
 			q := &storage.GetGroup{ID: id}
@@ -77,3 +91,27 @@ func GetApprovers(ctx context.Context, db ddb.Storage, rule rule.AccessRule) ([]
 	res := users.All()
 	return res, nil
 }
+
+// validateApproval checks the approval configuration of an access rule, including that each approval stage
+// has at least as many approvers as the approvals it requires, counting the members of approver groups.
+// returns apio.APIError so it will bubble up as a 400 error from api usage
+func (s *Service) validateApproval(ctx context.Context, a rule.Approval) error {
+	err := rule.ValidateApproval(a)
+	if err != nil {
+		return apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	for i, stage := range a.GetStages() {
+		if !stage.HasApprovers() || stage.RequiredApprovals() < 2 {
+			continue
+		}
+		approvers, err := GetStageApprovers(ctx, s.DB, stage)
+		if err != nil {
+			return err
+		}
+		if len(approvers) < stage.RequiredApprovals() {
+			err = fmt.Errorf("approval stage %d requires %d approvals but its users and groups only have %d approvers", i+1, stage.RequiredApprovals(), len(approvers))
+			return apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+	return nil
+}
@@ -91,3 +91,46 @@ func TestGetApprovers(t *testing.T) {
 	}
 
 }
+
+func TestValidateApproval(t *testing.T) {
+	type testcase struct {
+		name         string
+		give         rule.Approval
+		mockGetGroup *identity.Group
+		wantErr      string
+	}
+
+	testcases := []testcase{
+		{
+			name:         "group members meet the required approvals",
+			give:         rule.Approval{Users: []string{"usr_1"}, Groups: []string{"grp_1"}, MinApprovals: 2},
+			mockGetGroup: &identity.Group{Users: []string{"usr_2"}},
+		},
+		{
+			name:         "approvers in both the users and the group are only counted once",
+			give:         rule.Approval{Users: []string{"usr_1"}, Groups: []string{"grp_1"}, MinApprovals: 2},
+			mockGetGroup: &identity.Group{Users: []string{"usr_1"}},
+			wantErr:      "approval stage 1 requires 2 approvals but its users and groups only have 1 approvers",
+		},
+		{
+			name:    "stage without approvers",
+			give:    rule.Approval{Stages: []rule.ApprovalStage{{Name: "security"}}},
+			wantErr: `approval stage "security" must have at least one approver user or group`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetGroup{Result: tc.mockGetGroup})
+
+			s := Service{DB: db}
+			err := s.validateApproval(context.Background(), tc.give)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -2,6 +2,9 @@ package rulesvc
 
 import (
 	"context"
+	"errors"
+
+	"github.com/common-fate/apikit/logger"
 
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/access"
@@ -51,13 +54,10 @@ func (s *Service) ArchiveAccessRule(ctx context.Context, user *identity.User, in
 
 		for _, r := range q.Result {
 			if r.Rule == in.ID {
-				r.Status = access.CANCELLED
-				r.UpdatedAt = s.Clock.Now()
-				updateItems, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, r)
+				err = s.cancelPendingRequest(ctx, r)
 				if err != nil {
 					return nil, err
 				}
-				items = append(items, updateItems...)
 			}
 		}
 	}
@@ -68,3 +68,24 @@ func (s *Service) ArchiveAccessRule(ctx context.Context, user *identity.User, in
 	}
 	return &newVersion, nil
 }
+
+// errRequestNotPending is returned when a request which is being cancelled has been reviewed concurrently.
+var errRequestNotPending = errors.New("request is no longer pending")
+
+// cancelPendingRequest cancels a pending request for an archived access rule.
+// Requests which are reviewed concurrently are left as they are.
+func (s *Service) cancelPendingRequest(ctx context.Context, r access.Request) error {
+	err := dbupdate.RetryUpdateRequest(ctx, s.DB, s.Requests, &r, func(r *access.Request) ([]ddb.Keyer, error) {
+		if r.Status != access.PENDING {
+			return nil, errRequestNotPending
+		}
+		r.Status = access.CANCELLED
+		r.UpdatedAt = s.Clock.Now()
+		return nil, nil
+	})
+	if err == errRequestNotPending {
+		logger.Get(ctx).Infow("skipping cancelling request which is no longer pending", "request.id", r.ID, "status", r.Status)
+		return nil
+	}
+	return err
+}
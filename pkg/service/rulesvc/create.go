@@ -9,6 +9,7 @@ import (
 	"github.com/common-fate/apikit/logger"
 	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/policy"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/types"
 	"github.com/pkg/errors"
@@ -146,10 +147,57 @@ func (s *Service) CreateAccessRule(ctx context.Context, user *identity.User, in
 	if err != nil {
 		return nil, err
 	}
+	err = rule.ValidateTimeConstraints(in.TimeConstraints)
+	if err != nil {
+		return nil, apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	approval := rule.ApprovalFromAPI(in.Approval)
+	err = s.validateApproval(ctx, approval)
+	if err != nil {
+		return nil, err
+	}
+	if in.ReviewSla != nil {
+		err = rule.ValidateReviewSLA(*in.ReviewSla)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+	if in.Policy != nil {
+		err = policy.Validate(*in.Policy)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+	if in.OnCall != nil {
+		err = rule.ValidateOnCall(*in.OnCall)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+	if in.Ticket != nil {
+		err = rule.ValidateTicket(*in.Ticket)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+	if in.Recurrence != nil {
+		err = rule.ValidateRecurrence(*in.Recurrence)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+	var formFields []types.FormField
+	if in.FormFields != nil {
+		err = rule.ValidateFormFields(*in.FormFields)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+		formFields = *in.FormFields
+	}
 
 	rul := rule.AccessRule{
 		ID:          id,
-		Approval:    rule.Approval(in.Approval),
+		Approval:    approval,
 		Status:      rule.ACTIVE,
 		Description: in.Description,
 		Name:        in.Name,
@@ -162,6 +210,14 @@ func (s *Service) CreateAccessRule(ctx context.Context, user *identity.User, in
 		},
 		Target:          target,
 		TimeConstraints: in.TimeConstraints,
+		BreakGlass:      in.BreakGlass,
+		Limits:          in.Limits,
+		ReviewSLA:       in.ReviewSla,
+		Policy:          in.Policy,
+		OnCall:          in.OnCall,
+		FormFields:      formFields,
+		Ticket:          in.Ticket,
+		Recurrence:      in.Recurrence,
 		Version:         types.NewVersionID(),
 		Current:         true,
 	}
@@ -2,10 +2,12 @@ package rulesvc
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"testing"
 
 	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/ddb/ddbmock"
 	ssov2 "github.com/common-fate/granted-approvals/accesshandler/pkg/providers/aws/sso-v2"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers/testvault"
@@ -41,7 +43,7 @@ func TestCreateAccessRule(t *testing.T) {
 	mockRule := rule.AccessRule{
 		ID:          ruleID,
 		Version:     versionID,
-		Approval:    rule.Approval(in.Approval),
+		Approval:    rule.ApprovalFromAPI(in.Approval),
 		Status:      rule.ACTIVE,
 		Description: in.Description,
 		Name:        in.Name,
@@ -62,6 +64,10 @@ func TestCreateAccessRule(t *testing.T) {
 		TimeConstraints: in.TimeConstraints,
 		Current:         true,
 	}
+	minDuration := 7200
+	invalidTimeConstraints := types.CreateAccessRuleRequest{
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600, MinDurationSeconds: &minDuration},
+	}
 	cacheArgOptionsResponse := []cache.ProviderOption{}
 	cacheArgGroupOptionsResponse := []cache.ProviderArgGroupOption{}
 
@@ -80,6 +86,16 @@ func TestCreateAccessRule(t *testing.T) {
 				Type: "okta",
 			},
 		},
+		{
+			name:        "min duration exceeds max duration",
+			givenUserID: identity.User{ID: userID},
+			give:        invalidTimeConstraints,
+			wantErr:     apio.NewRequestError(errors.New("minimum duration seconds: 7200 exceeds the maximum duration seconds: 3600"), http.StatusBadRequest),
+			withProviderResponse: ahTypes.Provider{
+				Id:   in.Target.ProviderId,
+				Type: "okta",
+			},
+		},
 	}
 
 	for _, tc := range testcases {
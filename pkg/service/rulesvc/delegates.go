@@ -0,0 +1,78 @@
+package rulesvc
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"golang.org/x/sync/errgroup"
+)
+
+// GetDelegates gets the users who review requests on behalf of the approvers at the given time,
+// mapped to the approvers they are a delegate of.
+//
+// Delegations aren't transitive: only the delegates of the approvers themselves are returned.
+func GetDelegates(ctx context.Context, db ddb.Storage, approvers []string, now time.Time) (map[string][]string, error) {
+	var mu sync.Mutex
+	res := make(map[string][]string)
+
+	wg, gctx := errgroup.WithContext(ctx)
+	for _, a := range approvers {
+		approver := a
+		wg.Go(func() error {
+			q := &storage.ListDelegationsForDelegator{DelegatorID: approver}
+			_, err := db.Query(gctx, q)
+			if err != nil && err != ddb.ErrNoItems {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, d := range q.Result {
+				if d.IsActive(now) && !contains(res[d.DelegateID], approver) {
+					res[d.DelegateID] = append(res[d.DelegateID], approver)
+				}
+			}
+			return nil
+		})
+	}
+	err := wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// WithDelegates adds the active delegates of the approvers of a request to the list of approvers.
+// It returns the approvers along with their delegates, and the approvers who each delegate reviews on behalf of.
+//
+// Delegates who are approvers themselves review the request as themselves. The requester is never added as a delegate,
+// and delegations made by the requester are ignored, so that delegation can't be used to review your own request.
+func WithDelegates(ctx context.Context, db ddb.Storage, requestedBy string, approvers []string, now time.Time) ([]string, map[string][]string, error) {
+	var delegators []string
+	for _, u := range approvers {
+		if u != requestedBy {
+			delegators = append(delegators, u)
+		}
+	}
+	delegates, err := GetDelegates(ctx, db, delegators, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := make([]string, len(approvers))
+	copy(res, approvers)
+	delegatedBy := make(map[string][]string)
+	for d, by := range delegates {
+		if d == requestedBy || contains(approvers, d) {
+			continue
+		}
+		res = append(res, d)
+		delegatedBy[d] = by
+	}
+	// map iteration order is random, so sort the delegates to keep the reviewers of a request stable.
+	sort.Strings(res[len(approvers):])
+	return res, delegatedBy, nil
+}
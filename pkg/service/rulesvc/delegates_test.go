@@ -0,0 +1,125 @@
+package rulesvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDelegates(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type testcase struct {
+		name            string
+		giveApprovers   []string
+		giveDelegations []access.Delegation
+		want            map[string][]string
+	}
+
+	testcases := []testcase{
+		{
+			name:          "active delegation",
+			giveApprovers: []string{"usr_1"},
+			giveDelegations: []access.Delegation{
+				{DelegatorID: "usr_1", DelegateID: "usr_2", StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)},
+			},
+			want: map[string][]string{"usr_2": {"usr_1"}},
+		},
+		{
+			name:          "expired and upcoming delegations are ignored",
+			giveApprovers: []string{"usr_1"},
+			giveDelegations: []access.Delegation{
+				{DelegatorID: "usr_1", DelegateID: "usr_2", StartTime: now.Add(-2 * time.Hour), EndTime: now.Add(-time.Hour)},
+				{DelegatorID: "usr_1", DelegateID: "usr_3", StartTime: now.Add(time.Hour), EndTime: now.Add(2 * time.Hour)},
+			},
+			want: map[string][]string{},
+		},
+		{
+			name:          "overlapping delegations to the same delegate",
+			giveApprovers: []string{"usr_1"},
+			giveDelegations: []access.Delegation{
+				{DelegatorID: "usr_1", DelegateID: "usr_2", StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)},
+				{DelegatorID: "usr_1", DelegateID: "usr_2", StartTime: now, EndTime: now.Add(2 * time.Hour)},
+			},
+			want: map[string][]string{"usr_2": {"usr_1"}},
+		},
+		{
+			name: "no approvers",
+			want: map[string][]string{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListDelegationsForDelegator{Result: tc.giveDelegations})
+
+			got, err := GetDelegates(context.Background(), db, tc.giveApprovers, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWithDelegates(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type testcase struct {
+		name            string
+		giveApprovers   []string
+		giveDelegations []access.Delegation
+		wantApprovers   []string
+		wantDelegatedBy map[string][]string
+	}
+
+	testcases := []testcase{
+		{
+			name:          "delegate added",
+			giveApprovers: []string{"usr_1"},
+			giveDelegations: []access.Delegation{
+				{DelegatorID: "usr_1", DelegateID: "usr_2", StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)},
+			},
+			wantApprovers:   []string{"usr_1", "usr_2"},
+			wantDelegatedBy: map[string][]string{"usr_2": {"usr_1"}},
+		},
+		{
+			name:          "delegate who is an approver reviews as themselves",
+			giveApprovers: []string{"usr_1", "usr_2"},
+			giveDelegations: []access.Delegation{
+				{DelegatorID: "usr_1", DelegateID: "usr_2", StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)},
+			},
+			wantApprovers:   []string{"usr_1", "usr_2"},
+			wantDelegatedBy: map[string][]string{},
+		},
+		{
+			name:          "requester is never added as a delegate",
+			giveApprovers: []string{"usr_1"},
+			giveDelegations: []access.Delegation{
+				{DelegatorID: "usr_1", DelegateID: "usr_requester", StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)},
+			},
+			wantApprovers:   []string{"usr_1"},
+			wantDelegatedBy: map[string][]string{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListDelegationsForDelegator{Result: tc.giveDelegations})
+
+			gotApprovers, gotDelegatedBy, err := WithDelegates(context.Background(), db, "usr_requester", tc.giveApprovers, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantApprovers, gotApprovers)
+			assert.Equal(t, tc.wantDelegatedBy, gotDelegatedBy)
+		})
+	}
+}
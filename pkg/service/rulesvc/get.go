@@ -32,14 +32,14 @@ func canGet(user *identity.User, rule *rule.AccessRule, isAdmin bool) bool {
 		return true
 	}
 	// DE = User can see a rule they're an approver for
-	for _, au := range rule.Approval.Users {
+	for _, au := range rule.Approval.AllUsers() {
 		if au == user.ID {
 			return true
 		}
 	}
 	// DE = User can see a rule they're an approver of (via groups)
 	for _, group := range user.Groups {
-		for _, g := range rule.Approval.Groups {
+		for _, g := range rule.Approval.AllGroups() {
 			if g == group {
 				return true
 			}
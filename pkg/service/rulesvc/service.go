@@ -7,6 +7,7 @@ import (
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/pkg/cache"
+	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
 )
 
 // Service holds business logic relating to Access Rules.
@@ -15,6 +16,9 @@ type Service struct {
 	AHClient types.ClientWithResponsesInterface
 	DB       ddb.Storage
 	Cache    CacheService
+	// Requests writes requests only if they haven't been updated since they were read.
+	// If it is nil, requests are written without checking for concurrent updates.
+	Requests dbupdate.RequestWriter
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/cache.go -package=mocks . CacheService
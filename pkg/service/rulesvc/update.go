@@ -2,7 +2,10 @@ package rulesvc
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/policy"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/types"
 )
@@ -20,18 +23,72 @@ func (s *Service) UpdateRule(ctx context.Context, in *UpdateOpts) (*rule.AccessR
 	if err != nil {
 		return nil, err
 	}
+	err = rule.ValidateTimeConstraints(in.UpdateRequest.TimeConstraints)
+	if err != nil {
+		return nil, apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	approval := rule.ApprovalFromAPI(in.UpdateRequest.Approval)
+	err = s.validateApproval(ctx, approval)
+	if err != nil {
+		return nil, err
+	}
+	if in.UpdateRequest.ReviewSla != nil {
+		err = rule.ValidateReviewSLA(*in.UpdateRequest.ReviewSla)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+	if in.UpdateRequest.Policy != nil {
+		err = policy.Validate(*in.UpdateRequest.Policy)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+	if in.UpdateRequest.OnCall != nil {
+		err = rule.ValidateOnCall(*in.UpdateRequest.OnCall)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+	if in.UpdateRequest.Ticket != nil {
+		err = rule.ValidateTicket(*in.UpdateRequest.Ticket)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+	if in.UpdateRequest.Recurrence != nil {
+		err = rule.ValidateRecurrence(*in.UpdateRequest.Recurrence)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+	}
+	var formFields []types.FormField
+	if in.UpdateRequest.FormFields != nil {
+		err = rule.ValidateFormFields(*in.UpdateRequest.FormFields)
+		if err != nil {
+			return nil, apio.NewRequestError(err, http.StatusBadRequest)
+		}
+		formFields = *in.UpdateRequest.FormFields
+	}
 	// makes a copy of the existing version which will be mutated
 	newVersion := in.Rule
 
 	// fields to be updated
 	newVersion.Description = in.UpdateRequest.Description
 	newVersion.Name = in.UpdateRequest.Name
-	newVersion.Approval.Users = in.UpdateRequest.Approval.Users
-	newVersion.Approval.Groups = in.UpdateRequest.Approval.Groups
+	newVersion.Approval = approval
 	newVersion.Groups = in.UpdateRequest.Groups
 	newVersion.Metadata.UpdatedBy = in.UpdaterID
 	newVersion.Metadata.UpdatedAt = clk.Now()
 	newVersion.TimeConstraints = in.UpdateRequest.TimeConstraints
+	newVersion.BreakGlass = in.UpdateRequest.BreakGlass
+	newVersion.Limits = in.UpdateRequest.Limits
+	newVersion.ReviewSLA = in.UpdateRequest.ReviewSla
+	newVersion.Policy = in.UpdateRequest.Policy
+	newVersion.OnCall = in.UpdateRequest.OnCall
+	newVersion.FormFields = formFields
+	newVersion.Ticket = in.UpdateRequest.Ticket
+	newVersion.Recurrence = in.UpdateRequest.Recurrence
 	newVersion.Version = types.NewVersionID()
 	newVersion.Target = target
 
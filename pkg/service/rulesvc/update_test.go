@@ -45,7 +45,7 @@ func TestUpdateAccessRule(t *testing.T) {
 	*/
 	mockRule := rule.AccessRule{
 		ID:       ruleID,
-		Approval: rule.Approval(in.Approval),
+		Approval: rule.ApprovalFromAPI(in.Approval),
 		Status:   rule.ACTIVE,
 		Metadata: rule.AccessRuleMetadata{
 			CreatedAt: now,
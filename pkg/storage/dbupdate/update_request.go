@@ -0,0 +1,87 @@
+package dbupdate
+
+import (
+	"context"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+)
+
+// RequestWriter stores requests if they haven't been updated since they were read.
+// storage.RequestWriter implements this interface.
+type RequestWriter interface {
+	// PutRequest increments the version of the request and stores it.
+	// It returns storage.ErrVersionConflict if the request was updated since it was read.
+	PutRequest(ctx context.Context, r *access.Request) error
+}
+
+// maxUpdateAttempts is the number of times an update is applied to a request which
+// is being updated concurrently before giving up.
+const maxUpdateAttempts = 5
+
+// UpdateRequest stores an updated request along with the copies of it held by its reviewers, and any other items.
+//
+// The request is written with w, so storage.ErrVersionConflict is returned if the request was updated since it was read,
+// in which case none of the items are written. If w is nil, the request is written without checking its version.
+func UpdateRequest(ctx context.Context, db ddb.Storage, w RequestWriter, r *access.Request, items []ddb.Keyer, opts ...func(*UpdateRequestOpts)) error {
+	var o UpdateRequestOpts
+	for _, opt := range opts {
+		opt(&o)
+	}
+	// reviewers are loaded before the request is written, so that the request isn't
+	// left updated without its reviewers if they can't be loaded.
+	if o.Reviewers == nil {
+		rq := storage.ListRequestReviewers{RequestID: r.ID}
+		_, err := db.Query(ctx, &rq)
+		if err != nil {
+			return err
+		}
+		o.Reviewers = rq.Result
+	}
+
+	var err error
+	if w == nil {
+		r.Version++
+		err = db.Put(ctx, r)
+	} else {
+		err = w.PutRequest(ctx, r)
+	}
+	if err != nil {
+		return err
+	}
+
+	// the reviewers hold a copy of the request at the version which was just written.
+	for _, rv := range o.Reviewers {
+		rvc := rv
+		rvc.Request = *r
+		items = append(items, &rvc)
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return db.PutBatch(ctx, items...)
+}
+
+// RetryUpdateRequest applies update to the request and stores it with UpdateRequest, along with the items returned by update.
+// If the request was updated since it was read, the latest version of the request is read and update is applied to it again.
+// update is expected to check that the change it makes is still valid for the latest version of the request,
+// and can return an error to stop the update.
+func RetryUpdateRequest(ctx context.Context, db ddb.Storage, w RequestWriter, r *access.Request, update func(r *access.Request) ([]ddb.Keyer, error)) error {
+	for attempt := 1; ; attempt++ {
+		items, err := update(r)
+		if err != nil {
+			return err
+		}
+		err = UpdateRequest(ctx, db, w, r, items)
+		if err != storage.ErrVersionConflict || attempt == maxUpdateAttempts {
+			return err
+		}
+		q := storage.GetRequest{ID: r.ID}
+		_, err = db.Query(ctx, &q)
+		if err != nil {
+			return err
+		}
+		*r = *q.Result
+	}
+}
@@ -0,0 +1,87 @@
+package dbupdate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+// versionedWriter is a RequestWriter which stores a single request, checking its version.
+type versionedWriter struct {
+	stored access.Request
+	puts   int
+}
+
+func (w *versionedWriter) PutRequest(ctx context.Context, r *access.Request) error {
+	w.puts++
+	if r.Version != w.stored.Version {
+		return storage.ErrVersionConflict
+	}
+	r.Version++
+	w.stored = *r
+	return nil
+}
+
+func TestRetryUpdateRequest(t *testing.T) {
+	errStop := errors.New("stop")
+	type testcase struct {
+		name string
+		// stored is the latest version of the request, which has been updated since it was read.
+		stored     access.Request
+		update     func(r *access.Request) error
+		wantStatus access.Status
+		wantPuts   int
+		wantErr    error
+	}
+	testcases := []testcase{
+		{
+			name:   "update is applied to the latest version",
+			stored: access.Request{ID: "req", Status: access.PENDING, Version: 3},
+			update: func(r *access.Request) error {
+				r.Status = access.CANCELLED
+				return nil
+			},
+			wantStatus: access.CANCELLED,
+			wantPuts:   2,
+		},
+		{
+			name:   "update can stop when the latest version has changed",
+			stored: access.Request{ID: "req", Status: access.APPROVED, Version: 3},
+			update: func(r *access.Request) error {
+				if r.Status != access.PENDING {
+					return errStop
+				}
+				r.Status = access.CANCELLED
+				return nil
+			},
+			wantStatus: access.APPROVED,
+			wantPuts:   1,
+			wantErr:    errStop,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			stored := tc.stored
+			db.MockQuery(&storage.GetRequest{Result: &stored})
+			db.MockQuery(&storage.ListRequestReviewers{})
+			w := &versionedWriter{stored: tc.stored}
+
+			// the request was read before it was updated concurrently.
+			r := access.Request{ID: "req", Status: access.PENDING, Version: 2}
+			err := RetryUpdateRequest(context.Background(), db, w, &r, func(r *access.Request) ([]ddb.Keyer, error) {
+				return nil, tc.update(r)
+			})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantPuts, w.puts)
+			assert.Equal(t, tc.wantStatus, w.stored.Status)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+func TestGetExclusiveRuleSet(t *testing.T) {
+	db := newTestingStorage(t)
+
+	s := rule.ExclusiveRuleSet{
+		ID:        types.NewExclusiveRuleSetID(),
+		Name:      "prod deploy and approve",
+		RuleIDs:   []string{"rul_deploy", "rul_approve"},
+		CreatedBy: "usr_admin",
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	ddbtest.PutFixtures(t, db, &s)
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &GetExclusiveRuleSet{ID: s.ID},
+			Want:  &GetExclusiveRuleSet{ID: s.ID, Result: &s},
+		},
+		{
+			Name:    "set not found",
+			Query:   &GetExclusiveRuleSet{ID: types.NewExclusiveRuleSetID()},
+			WantErr: ddb.ErrNoItems,
+		},
+	}
+
+	ddbtest.RunQueryTests(t, db, tc)
+}
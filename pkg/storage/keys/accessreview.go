@@ -3,11 +3,17 @@ package keys
 const AccessReviewKey = "ACCESS_REVIEW#"
 
 type accessReviewKeys struct {
-	PK1 func(reviewerID string) string
-	SK1 func(requestID, reviewID string) string
+	PK1           func(reviewerID string) string
+	SK1           func(requestID, reviewID string) string
+	GSI1PK        string
+	GSI1SK        func(requestID, reviewID string) string
+	GSI1SKRequest func(requestID string) string
 }
 
 var AccessReview = accessReviewKeys{
-	PK1: func(reviewerID string) string { return AccessReviewKey + reviewerID },
-	SK1: func(requestID, reviewID string) string { return requestID + "#" + reviewID },
+	PK1:           func(reviewerID string) string { return AccessReviewKey + reviewerID },
+	SK1:           func(requestID, reviewID string) string { return requestID + "#" + reviewID },
+	GSI1PK:        AccessReviewKey,
+	GSI1SK:        func(requestID, reviewID string) string { return requestID + "#" + reviewID },
+	GSI1SKRequest: func(requestID string) string { return requestID + "#" },
 }
@@ -0,0 +1,19 @@
+package keys
+
+const DelegationKey = "DELEGATION#"
+
+type delegationKeys struct {
+	PK1          string
+	SK1          func(delegatorID string, delegationID string) string
+	SK1Delegator func(delegatorID string) string
+	GSI1PK       func(delegateID string) string
+	GSI1SK       func(delegationID string) string
+}
+
+var Delegation = delegationKeys{
+	PK1:          DelegationKey,
+	SK1:          func(delegatorID, delegationID string) string { return delegatorID + "#" + delegationID },
+	SK1Delegator: func(delegatorID string) string { return delegatorID + "#" },
+	GSI1PK:       func(delegateID string) string { return DelegationKey + delegateID },
+	GSI1SK:       func(delegationID string) string { return delegationID },
+}
@@ -0,0 +1,13 @@
+package keys
+
+const ExclusiveRuleSetKey = "EXCLUSIVE_RULE_SET#"
+
+type exclusiveRuleSetKeys struct {
+	PK1 string
+	SK1 func(setID string) string
+}
+
+var ExclusiveRuleSet = exclusiveRuleSetKeys{
+	PK1: ExclusiveRuleSetKey,
+	SK1: func(setID string) string { return setID },
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+)
+
+// ListDelegationsForDelegate lists the delegations which have been made to a user.
+type ListDelegationsForDelegate struct {
+	DelegateID string
+	Result     []access.Delegation `ddb:"result"`
+}
+
+func (l *ListDelegationsForDelegate) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              aws.String(keys.IndexNames.GSI1),
+		KeyConditionExpression: aws.String("GSI1PK = :pk"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.Delegation.GSI1PK(l.DelegateID)},
+		},
+	}
+	return &qi, nil
+}
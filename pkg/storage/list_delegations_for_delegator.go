@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+)
+
+// ListDelegationsForDelegator lists the delegations which an approver has made.
+type ListDelegationsForDelegator struct {
+	DelegatorID string
+	Result      []access.Delegation `ddb:"result"`
+}
+
+func (l *ListDelegationsForDelegator) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :sk)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.Delegation.PK1},
+			":sk": &types.AttributeValueMemberS{Value: keys.Delegation.SK1Delegator(l.DelegatorID)},
+		},
+	}
+	return &qi, nil
+}
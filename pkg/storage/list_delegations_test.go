@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+func TestListDelegations(t *testing.T) {
+	s := newTestingStorage(t)
+
+	delegator := types.NewUserID()
+	delegate := types.NewUserID()
+	now := time.Now().UTC().Truncate(time.Second)
+	d1 := access.Delegation{ID: types.NewDelegationID(), DelegatorID: delegator, DelegateID: delegate, StartTime: now, EndTime: now.Add(time.Hour), CreatedAt: now}
+	ddbtest.PutFixtures(t, s, []*access.Delegation{&d1})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "for delegator",
+			Query: &ListDelegationsForDelegator{DelegatorID: delegator},
+			Want:  &ListDelegationsForDelegator{DelegatorID: delegator, Result: []access.Delegation{d1}},
+		},
+		{
+			Name:  "for delegate",
+			Query: &ListDelegationsForDelegate{DelegateID: delegate},
+			Want:  &ListDelegationsForDelegate{DelegateID: delegate, Result: []access.Delegation{d1}},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+)
+
+type ListExclusiveRuleSets struct {
+	Result []rule.ExclusiveRuleSet `ddb:"result"`
+}
+
+func (l *ListExclusiveRuleSets) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		KeyConditionExpression: aws.String("PK = :pk"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.ExclusiveRuleSet.PK1},
+		},
+	}
+	return &qi, nil
+}
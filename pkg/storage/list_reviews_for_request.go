@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+)
+
+// ListReviewsForRequest lists the reviews which have been made on a request.
+type ListReviewsForRequest struct {
+	RequestID string
+	Result    access.Reviews `ddb:"result"`
+}
+
+func (l *ListReviewsForRequest) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              &keys.IndexNames.GSI1,
+		KeyConditionExpression: aws.String("GSI1PK = :pk1 AND begins_with(GSI1SK, :sk1)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.AccessReview.GSI1PK},
+			":sk1": &types.AttributeValueMemberS{Value: keys.AccessReview.GSI1SKRequest(l.RequestID)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+func TestListReviewsForRequest(t *testing.T) {
+	s := newTestingStorage(t)
+
+	reqID := types.NewRequestID()
+	r1 := access.Review{ID: types.NewRequestReviewID(), RequestID: reqID, ReviewerID: "a", Decision: access.DecisionApproved}
+	r2 := access.Review{ID: types.NewRequestReviewID(), RequestID: reqID, ReviewerID: "b", Decision: access.DecisionDECLINED}
+	other := access.Review{ID: types.NewRequestReviewID(), RequestID: types.NewRequestID(), ReviewerID: "a", Decision: access.DecisionApproved}
+	ddbtest.PutFixtures(t, s, []*access.Review{&r1, &r2, &other})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &ListReviewsForRequest{RequestID: reqID},
+			Want:  &ListReviewsForRequest{RequestID: reqID, Result: access.Reviews{r1, r2}},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
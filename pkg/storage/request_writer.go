@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+)
+
+// ErrVersionConflict is returned when a request has been updated since it was read.
+var ErrVersionConflict = errors.New("the request has been updated since it was read")
+
+// RequestWriter writes Access Requests only if they haven't been updated since they were read.
+// ddb.Storage doesn't support condition expressions, so this uses the DynamoDB client directly.
+type RequestWriter struct {
+	Client *dynamodb.Client
+	Table  string
+}
+
+// NewRequestWriter creates a RequestWriter for the table, using the default AWS configuration.
+func NewRequestWriter(ctx context.Context, table string) (*RequestWriter, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestWriter{Client: dynamodb.NewFromConfig(cfg), Table: table}, nil
+}
+
+// PutRequest increments the version of the request and stores it, if the stored
+// request is still at the version it was read at. Otherwise ErrVersionConflict is returned.
+func (w *RequestWriter) PutRequest(ctx context.Context, r *access.Request) error {
+	expected := r.Version
+	r.Version++
+
+	item, err := attributevalue.MarshalMap(r)
+	if err != nil {
+		return err
+	}
+	keys, err := r.DDBKeys()
+	if err != nil {
+		return err
+	}
+	v := reflect.ValueOf(keys)
+	for i := 0; i < v.NumField(); i++ {
+		if val := v.Field(i).String(); val != "" {
+			item[v.Type().Field(i).Name] = &types.AttributeValueMemberS{Value: val}
+		}
+	}
+
+	// requests saved before versions were added don't have a version attribute.
+	_, err = w.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           &w.Table,
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(#version) OR #version = :version"),
+		ExpressionAttributeNames: map[string]string{
+			"#version": "version",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":version": &types.AttributeValueMemberN{Value: strconv.Itoa(expected)},
+		},
+	})
+	var ccf *types.ConditionalCheckFailedException
+	if errors.As(err, &ccf) {
+		r.Version = expected
+		return ErrVersionConflict
+	}
+	if err != nil {
+		r.Version = expected
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+// Package sweeper enforces the review SLA of access rules on pending requests.
+// Requests which aren't reviewed in time are reminded, escalated to backup approvers, and expired.
+package sweeper
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/eventputter.go -package=mocks . EventPutter
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
+}
+
+// Sweeper checks PENDING requests against the review SLA of their access rule.
+type Sweeper struct {
+	DB          ddb.Storage
+	Clock       clock.Clock
+	EventPutter EventPutter
+	// Requests writes requests only if they haven't been updated since they were read.
+	// If it is nil, requests are written without checking for concurrent updates.
+	Requests dbupdate.RequestWriter
+}
+
+// Sweep checks every pending request. Errors sweeping an individual request
+// are logged and the sweeper moves on to the next request.
+func (s *Sweeper) Sweep(ctx context.Context) error {
+	log := logger.Get(ctx)
+	log.Info("starting pending request sweep")
+
+	// access rules are cached for the duration of the sweep, as many requests usually share a rule.
+	rules := map[string]*rule.AccessRule{}
+
+	var next string
+	for {
+		q := storage.ListRequestsForStatus{Status: access.PENDING}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		res, err := s.DB.Query(ctx, &q, opts...)
+		if err != nil && err != ddb.ErrNoItems {
+			return err
+		}
+
+		for _, req := range q.Result {
+			err = s.sweepRequest(ctx, req, rules)
+			if err != nil {
+				log.Errorw("failed to sweep request", "request.id", req.ID, "error", err)
+			}
+		}
+
+		if res == nil || res.NextPage == "" {
+			break
+		}
+		next = res.NextPage
+	}
+
+	log.Info("completed pending request sweep")
+	return nil
+}
+
+// sweepRequest applies the review SLA to a single request, skipping the request if it has been
+// reviewed or cancelled since it was listed.
+func (s *Sweeper) sweepRequest(ctx context.Context, req access.Request, rules map[string]*rule.AccessRule) error {
+	log := logger.Get(ctx).With("request.id", req.ID)
+
+	// requests are listed from an index which is eventually consistent, so the request is read again.
+	q := storage.GetRequest{ID: req.ID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return err
+	}
+	req = *q.Result
+	if req.Status != access.PENDING {
+		log.Infow("skipping request which is no longer pending", "status", req.Status)
+		return nil
+	}
+
+	err = s.applySLA(ctx, req, rules)
+	if err == storage.ErrVersionConflict {
+		// the request was updated while it was being swept, such as by a review.
+		// It is checked again in the next sweep if it is still pending.
+		log.Infow("skipping request which was updated while it was being swept")
+		return nil
+	}
+	return err
+}
+
+// applySLA applies the review SLA to a pending request. A request which has passed the expiry
+// threshold is expired. Otherwise, the request is escalated and reviewers are reminded once each threshold has passed.
+func (s *Sweeper) applySLA(ctx context.Context, req access.Request, rules map[string]*rule.AccessRule) error {
+	r, ok := rules[req.Rule]
+	if !ok {
+		q := storage.GetAccessRuleCurrent{ID: req.Rule}
+		_, err := s.DB.Query(ctx, &q)
+		if err != nil {
+			return err
+		}
+		r = q.Result
+		rules[req.Rule] = r
+	}
+	if r.ReviewSLA == nil {
+		return nil
+	}
+	sla := *r.ReviewSLA
+	now := s.Clock.Now()
+	age := now.Sub(req.CreatedAt)
+
+	if d, ok := rule.SLAThreshold(sla.ExpireAfterSeconds); ok && age >= d {
+		return s.expire(ctx, req, now)
+	}
+	if d, ok := rule.SLAThreshold(sla.EscalateAfterSeconds); ok && age >= d && req.EscalatedAt == nil {
+		return s.escalate(ctx, req, *r, sla, now)
+	}
+	if d, ok := rule.SLAThreshold(sla.ReminderAfterSeconds); ok && age >= d && req.ReminderSentAt == nil {
+		return s.remind(ctx, req, now)
+	}
+	return nil
+}
+
+// expire closes the request with the EXPIRED status.
+func (s *Sweeper) expire(ctx context.Context, req access.Request, now time.Time) error {
+	logger.Get(ctx).Infow("expiring request", "request.id", req.ID)
+
+	originalStatus := req.Status
+	req.Status = access.EXPIRED
+	req.UpdatedAt = now
+
+	requestEvent := access.NewStatusChangeEvent(req.ID, now, nil, originalStatus, req.Status)
+	err := dbupdate.UpdateRequest(ctx, s.DB, s.Requests, &req, []ddb.Keyer{&requestEvent})
+	if err != nil {
+		return err
+	}
+	return s.EventPutter.Put(ctx, gevent.RequestExpired{Request: req})
+}
+
+// escalate adds the escalation approvers as reviewers for the stage which the request is waiting on.
+func (s *Sweeper) escalate(ctx context.Context, req access.Request, r rule.AccessRule, sla types.ReviewSLA, now time.Time) error {
+	log := logger.Get(ctx).With("request.id", req.ID)
+
+	var stage rule.ApprovalStage
+	if sla.EscalationUsers != nil {
+		stage.Users = *sla.EscalationUsers
+	}
+	if sla.EscalationGroups != nil {
+		stage.Groups = *sla.EscalationGroups
+	}
+	approvers, err := rulesvc.GetStageApprovers(ctx, s.DB, stage)
+	if err != nil {
+		return err
+	}
+
+	// escalation approvers who are away may have delegated their reviews to another user.
+	approvers, delegatedBy, err := rulesvc.WithDelegates(ctx, s.DB, req.RequestedBy, approvers, now)
+	if err != nil {
+		return err
+	}
+
+	q := storage.ListRequestReviewers{RequestID: req.ID}
+	_, err = s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return err
+	}
+	reviewers := q.Result
+
+	var added []string
+	for _, u := range approvers {
+		if u == req.RequestedBy {
+			continue
+		}
+		found := false
+		for i := range reviewers {
+			if reviewers[i].ReviewerID == u {
+				found = true
+				if reviewers[i].ApprovalStage != req.ApprovalStage {
+					// the escalation approver was a reviewer for an earlier stage.
+					reviewers[i].ApprovalStage = req.ApprovalStage
+					reviewers[i].DelegatedBy = delegatedBy[u]
+					added = append(added, u)
+				}
+			}
+		}
+		if !found {
+			reviewers = append(reviewers, access.Reviewer{ReviewerID: u, Request: req, ApprovalStage: req.ApprovalStage, DelegatedBy: delegatedBy[u]})
+			added = append(added, u)
+		}
+	}
+	if len(added) == 0 {
+		log.Warnw("no escalation approvers could be added to the request", "rule.id", r.ID)
+	} else {
+		log.Infow("escalating request", "reviewers", added)
+	}
+
+	req.EscalatedAt = &now
+	req.UpdatedAt = now
+	requestEvent := access.NewRecordedEvent(req.ID, nil, now, map[string]string{
+		"event":       gevent.RequestEscalatedType,
+		"escalatedTo": strings.Join(added, ","),
+	})
+	err = dbupdate.UpdateRequest(ctx, s.DB, s.Requests, &req, []ddb.Keyer{&requestEvent}, dbupdate.WithReviewers(reviewers))
+	if err != nil {
+		return err
+	}
+	return s.EventPutter.Put(ctx, gevent.RequestEscalated{Request: req, ReviewerIDs: added})
+}
+
+// remind emits an event which causes the notifiers to remind the reviewers about the request.
+func (s *Sweeper) remind(ctx context.Context, req access.Request, now time.Time) error {
+	logger.Get(ctx).Infow("reminding reviewers", "request.id", req.ID)
+
+	req.ReminderSentAt = &now
+	req.UpdatedAt = now
+	err := dbupdate.UpdateRequest(ctx, s.DB, s.Requests, &req, nil)
+	if err != nil {
+		return err
+	}
+	return s.EventPutter.Put(ctx, gevent.RequestReminder{Request: req})
+}
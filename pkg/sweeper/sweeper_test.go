@@ -0,0 +1,188 @@
+package sweeper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/sweeper/mocks"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSweep(t *testing.T) {
+	type testcase struct {
+		name      string
+		sla       *types.ReviewSLA
+		age       time.Duration
+		reminded  bool
+		escalated bool
+		// currentStatus is the status of the request when it is swept, if it changed after the request was listed.
+		currentStatus access.Status
+		// conflict is true if the request is updated by a review while it is being swept.
+		conflict   bool
+		wantEvent  gevent.EventTyper
+		wantStatus access.Status
+	}
+
+	clk := clock.NewMock()
+	clk.Add(24 * time.Hour)
+	now := clk.Now()
+
+	reminder := 600
+	escalate := 1800
+	expire := 3600
+	sla := &types.ReviewSLA{
+		ReminderAfterSeconds: &reminder,
+		EscalateAfterSeconds: &escalate,
+		ExpireAfterSeconds:   &expire,
+		EscalationUsers:      &[]string{"backup", "requestor"},
+		EscalationGroups:     &[]string{"oncall"},
+	}
+
+	testcases := []testcase{
+		{
+			name: "rule without review sla",
+			age:  48 * time.Hour,
+		},
+		{
+			name: "within sla",
+			sla:  sla,
+			age:  time.Minute,
+		},
+		{
+			name:       "reminder",
+			sla:        sla,
+			age:        15 * time.Minute,
+			wantEvent:  gevent.RequestReminder{},
+			wantStatus: access.PENDING,
+		},
+		{
+			name:     "reminder already sent",
+			sla:      sla,
+			age:      15 * time.Minute,
+			reminded: true,
+		},
+		{
+			name:       "escalate",
+			sla:        sla,
+			age:        45 * time.Minute,
+			reminded:   true,
+			wantEvent:  gevent.RequestEscalated{ReviewerIDs: []string{"backup", "oncall-user"}},
+			wantStatus: access.PENDING,
+		},
+		{
+			name:      "already escalated",
+			sla:       sla,
+			age:       45 * time.Minute,
+			reminded:  true,
+			escalated: true,
+		},
+		{
+			name:       "expire",
+			sla:        sla,
+			age:        2 * time.Hour,
+			reminded:   true,
+			escalated:  true,
+			wantEvent:  gevent.RequestExpired{},
+			wantStatus: access.EXPIRED,
+		},
+		{
+			name:          "request approved after it was listed is skipped",
+			sla:           sla,
+			age:           2 * time.Hour,
+			currentStatus: access.APPROVED,
+		},
+		{
+			name:     "request reviewed while it is swept is skipped",
+			sla:      sla,
+			age:      2 * time.Hour,
+			conflict: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := access.Request{
+				ID:          "req_1",
+				RequestedBy: "requestor",
+				Rule:        "rul_1",
+				Status:      access.PENDING,
+				CreatedAt:   now.Add(-tc.age),
+			}
+			past := now.Add(-time.Minute)
+			if tc.reminded {
+				req.ReminderSentAt = &past
+			}
+			if tc.escalated {
+				req.EscalatedAt = &past
+			}
+
+			current := req
+			if tc.currentStatus != "" {
+				current.Status = tc.currentStatus
+			}
+
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestsForStatus{Status: access.PENDING, Result: []access.Request{req}})
+			db.MockQuery(&storage.GetRequest{Result: &current})
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: &rule.AccessRule{ID: "rul_1", ReviewSLA: tc.sla}})
+			db.MockQuery(&storage.GetGroup{Result: &identity.Group{ID: "oncall", Users: []string{"oncall-user"}}})
+			db.MockQuery(&storage.ListRequestReviewers{Result: []access.Reviewer{{ReviewerID: "approver", Request: req}}})
+			db.MockQuery(&storage.ListDelegationsForDelegator{})
+
+			ctrl := gomock.NewController(t)
+			ep := mocks.NewMockEventPutter(ctrl)
+			var got gevent.EventTyper
+			if tc.wantEvent != nil {
+				ep.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, e gevent.EventTyper) error {
+					got = e
+					return nil
+				})
+			}
+
+			s := Sweeper{DB: db, Clock: clk, EventPutter: ep, Requests: conflictWriter{conflict: tc.conflict}}
+			err := s.Sweep(context.Background())
+			assert.NoError(t, err)
+
+			if tc.wantEvent == nil {
+				return
+			}
+			assert.Equal(t, tc.wantEvent.EventType(), got.EventType())
+			var gotReq access.Request
+			switch e := got.(type) {
+			case gevent.RequestReminder:
+				gotReq = e.Request
+				assert.Equal(t, &now, gotReq.ReminderSentAt)
+			case gevent.RequestEscalated:
+				gotReq = e.Request
+				assert.Equal(t, tc.wantEvent.(gevent.RequestEscalated).ReviewerIDs, e.ReviewerIDs)
+				assert.Equal(t, &now, gotReq.EscalatedAt)
+			case gevent.RequestExpired:
+				gotReq = e.Request
+			}
+			assert.Equal(t, tc.wantStatus, gotReq.Status)
+		})
+	}
+}
+
+// conflictWriter is a RequestWriter which fails if the request is updated concurrently.
+type conflictWriter struct {
+	conflict bool
+}
+
+func (w conflictWriter) PutRequest(ctx context.Context, r *access.Request) error {
+	if w.conflict {
+		return storage.ErrVersionConflict
+	}
+	r.Version++
+	return nil
+}
@@ -0,0 +1,46 @@
+package ticket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// doJSON makes a request to a ticketing system and decodes the JSON response into dest.
+// If body is not nil it is sent as JSON. ErrTicketNotFound is returned if the response is a 404.
+func doJSON(ctx context.Context, client *http.Client, method string, url string, header http.Header, body interface{}, dest interface{}) error {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, r)
+	if err != nil {
+		return err
+	}
+	req.Header = header
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrTicketNotFound
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		// include the start of the body, which usually contains the error message from the API.
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("request to %s failed with status %d: %s", req.URL.Path, res.StatusCode, string(b))
+	}
+	return json.NewDecoder(res.Body).Decode(dest)
+}
@@ -0,0 +1,91 @@
+package ticket
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/pkg/errors"
+)
+
+type Jira struct {
+	// baseURL is the URL of the Jira site, such as https://acme.atlassian.net.
+	baseURL  gconfig.StringValue
+	username gconfig.StringValue
+	apiToken gconfig.SecretStringValue
+	client   *http.Client
+}
+
+func (s *Jira) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.StringField("baseUrl", &s.baseURL, "the URL of your Jira site, such as https://acme.atlassian.net"),
+		gconfig.StringField("username", &s.username, "the email address of the Jira user which the API token belongs to"),
+		gconfig.SecretStringField("apiToken", &s.apiToken, "the Jira API token", gconfig.WithNoArgs("/granted/secrets/tickets/jira/token")),
+	}
+}
+
+func (s *Jira) Init(ctx context.Context) error {
+	s.client = newHTTPClient()
+	return nil
+}
+
+func (s *Jira) TestConfig(ctx context.Context) error {
+	var res struct{}
+	err := doJSON(ctx, s.client, http.MethodGet, s.url()+"/rest/api/3/myself", s.header(), nil, &res)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the current user while testing jira configuration")
+	}
+	return nil
+}
+
+type jiraIssueResponse struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary string `json:"summary"`
+		Status  struct {
+			Name string `json:"name"`
+		} `json:"status"`
+		Assignee *struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"assignee"`
+	} `json:"fields"`
+}
+
+// GetTicket looks up a Jira issue by its key, such as "OPS-123".
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-get
+func (s *Jira) GetTicket(ctx context.Context, ticketID string) (*Ticket, error) {
+	q := url.Values{}
+	q.Set("fields", "summary,status,assignee")
+
+	var res jiraIssueResponse
+	err := doJSON(ctx, s.client, http.MethodGet, s.url()+"/rest/api/3/issue/"+url.PathEscape(ticketID)+"?"+q.Encode(), s.header(), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticket{
+		ID:      res.Key,
+		URL:     s.url() + "/browse/" + res.Key,
+		Summary: res.Fields.Summary,
+		Status:  res.Fields.Status.Name,
+	}
+	if res.Fields.Assignee != nil {
+		t.AssigneeEmail = res.Fields.Assignee.EmailAddress
+	}
+	return &t, nil
+}
+
+func (s *Jira) url() string {
+	return strings.TrimSuffix(s.baseURL.Get(), "/")
+}
+
+func (s *Jira) header() http.Header {
+	h := http.Header{}
+	creds := base64.StdEncoding.EncodeToString([]byte(s.username.Get() + ":" + s.apiToken.Get()))
+	h.Set("Authorization", "Basic "+creds)
+	h.Set("Accept", "application/json")
+	return h
+}
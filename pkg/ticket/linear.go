@@ -0,0 +1,126 @@
+package ticket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+	"github.com/pkg/errors"
+)
+
+const LinearBaseURL = "https://api.linear.app"
+
+type Linear struct {
+	apiKey gconfig.SecretStringValue
+	// apiURL is used to override the Linear API, for example to use a proxy.
+	apiURL gconfig.OptionalStringValue
+	client *http.Client
+}
+
+func (s *Linear) Config() gconfig.Config {
+	return gconfig.Config{
+		gconfig.SecretStringField("apiKey", &s.apiKey, "the Linear API key", gconfig.WithNoArgs("/granted/secrets/tickets/linear/key")),
+		gconfig.OptionalStringField("apiUrl", &s.apiURL, "the Linear API URL", gconfig.WithDefaultFunc(func() string { return LinearBaseURL })),
+	}
+}
+
+func (s *Linear) Init(ctx context.Context) error {
+	s.client = newHTTPClient()
+	return nil
+}
+
+func (s *Linear) TestConfig(ctx context.Context) error {
+	var res linearResponse
+	err := s.query(ctx, `query { viewer { id } }`, nil, &res)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the current user while testing linear configuration")
+	}
+	return nil
+}
+
+type linearResponse struct {
+	Data struct {
+		Issue *struct {
+			Identifier string `json:"identifier"`
+			Title      string `json:"title"`
+			URL        string `json:"url"`
+			State      struct {
+				Name string `json:"name"`
+			} `json:"state"`
+			Assignee *struct {
+				Email string `json:"email"`
+			} `json:"assignee"`
+		} `json:"issue"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+const linearIssueQuery = `query Issue($id: String!) {
+  issue(id: $id) {
+    identifier
+    title
+    url
+    state { name }
+    assignee { email }
+  }
+}`
+
+// GetTicket looks up a Linear issue by its identifier, such as "ENG-123".
+//
+// https://developers.linear.app/docs/graphql/working-with-the-graphql-api
+func (s *Linear) GetTicket(ctx context.Context, ticketID string) (*Ticket, error) {
+	var res linearResponse
+	err := s.query(ctx, linearIssueQuery, map[string]interface{}{"id": ticketID}, &res)
+	if err != nil {
+		return nil, err
+	}
+	issue := res.Data.Issue
+	if issue == nil {
+		return nil, ErrTicketNotFound
+	}
+	t := Ticket{
+		ID:      issue.Identifier,
+		URL:     issue.URL,
+		Summary: issue.Title,
+		Status:  issue.State.Name,
+	}
+	if issue.Assignee != nil {
+		t.AssigneeEmail = issue.Assignee.Email
+	}
+	return &t, nil
+}
+
+// query runs a GraphQL query against the Linear API.
+// Linear returns errors in the body of the response rather than with an error status.
+func (s *Linear) query(ctx context.Context, query string, variables map[string]interface{}, res *linearResponse) error {
+	body := map[string]interface{}{"query": query, "variables": variables}
+	err := doJSON(ctx, s.client, http.MethodPost, s.baseURL()+"/graphql", s.header(), body, res)
+	if err != nil {
+		return err
+	}
+	if len(res.Errors) > 0 {
+		msg := res.Errors[0].Message
+		if strings.Contains(strings.ToLower(msg), "not found") {
+			return ErrTicketNotFound
+		}
+		return fmt.Errorf("linear query failed: %s", msg)
+	}
+	return nil
+}
+
+func (s *Linear) baseURL() string {
+	if s.apiURL.Get() != "" {
+		return strings.TrimSuffix(s.apiURL.Get(), "/")
+	}
+	return LinearBaseURL
+}
+
+func (s *Linear) header() http.Header {
+	h := http.Header{}
+	h.Set("Authorization", s.apiKey.Get())
+	return h
+}
@@ -0,0 +1,79 @@
+package ticket
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/fatih/color"
+)
+
+const (
+	SystemTypeJira   = "jira"
+	SystemTypeLinear = "linear"
+)
+
+type RegisteredSystem struct {
+	System      System
+	Description string
+	DocsID      string
+}
+
+type SystemRegistry struct {
+	Systems map[string]RegisteredSystem
+}
+
+func Registry() SystemRegistry {
+	return SystemRegistry{
+		Systems: map[string]RegisteredSystem{
+			SystemTypeJira: {
+				System:      &Jira{},
+				Description: "Jira",
+				DocsID:      "jira",
+			},
+			SystemTypeLinear: {
+				System:      &Linear{},
+				Description: "Linear",
+				DocsID:      "linear",
+			},
+		},
+	}
+}
+
+// Lookup a ticketing system by its type.
+func (r SystemRegistry) Lookup(systemType string) (*RegisteredSystem, error) {
+	s, ok := r.Systems[systemType]
+	if !ok {
+		return nil, fmt.Errorf("could not find ticketing system %s", systemType)
+	}
+	return &s, nil
+}
+
+func (r SystemRegistry) CLIOptions() []string {
+	var opts []string
+	for k, v := range r.Systems {
+		grey := color.New(color.FgHiBlack).SprintFunc()
+		id := "(" + k + ")"
+		opt := fmt.Sprintf("%s %s", v.Description, grey(id))
+		opts = append(opts, opt)
+	}
+	sort.Strings(opts)
+	return opts
+}
+
+func (r SystemRegistry) FromCLIOption(opt string) (key string, s RegisteredSystem, err error) {
+	re, err := regexp.Compile(`[\w ]+\((.*)\)`)
+	if err != nil {
+		return "", RegisteredSystem{}, err
+	}
+	got := re.FindStringSubmatch(opt)
+	if got == nil {
+		return "", RegisteredSystem{}, fmt.Errorf("couldn't extract ticketing system key: %s", opt)
+	}
+	key = got[1]
+	s, ok := r.Systems[key]
+	if !ok {
+		return "", RegisteredSystem{}, fmt.Errorf("couldn't find ticketing system with key: %s", key)
+	}
+	return key, s, nil
+}
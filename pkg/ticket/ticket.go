@@ -0,0 +1,91 @@
+// Package ticket verifies tickets in ticketing systems like Jira and Linear, which are linked to access requests as justification.
+package ticket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/common-fate/granted-approvals/pkg/gconfig"
+)
+
+// Ticket is an issue in a ticketing system.
+type Ticket struct {
+	// ID is the key of the ticket which is shown to users, such as "OPS-123".
+	ID      string
+	URL     string
+	Summary string
+	Status  string
+	// AssigneeEmail is empty if the ticket is unassigned,
+	// or if the ticketing system doesn't share the email address of the assignee.
+	AssigneeEmail string
+}
+
+// System is a ticketing system.
+type System interface {
+	// GetTicket looks up a ticket by its ID. ErrTicketNotFound is returned if the ticket doesn't exist.
+	GetTicket(ctx context.Context, ticketID string) (*Ticket, error)
+	gconfig.Configer
+	gconfig.Initer
+}
+
+// ErrTicketNotFound is returned if a ticket doesn't exist in the ticketing system,
+// or if the configured credentials can't see it.
+var ErrTicketNotFound = errors.New("ticket not found")
+
+// defaultTimeout is used for requests to ticketing systems, which are made while an access request is being created.
+const defaultTimeout = 10 * time.Second
+
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: defaultTimeout}
+}
+
+// SystemNotConfiguredError is returned when looking up a ticket for a system
+// which isn't included in the ticket configuration of the deployment.
+type SystemNotConfiguredError struct {
+	System string
+}
+
+func (e SystemNotConfiguredError) Error() string {
+	return fmt.Sprintf("ticketing system %s is not configured", e.System)
+}
+
+// Verifier looks up tickets in the ticketing systems which are configured for the deployment.
+type Verifier struct {
+	systems map[string]System
+}
+
+// NewVerifier loads and initialises each ticketing system in the ticket configuration.
+// The configuration is keyed by the system type, such as "jira".
+func NewVerifier(ctx context.Context, config deploy.FeatureMap) (*Verifier, error) {
+	v := Verifier{systems: make(map[string]System)}
+	for systemType, values := range config {
+		registered, err := Registry().Lookup(systemType)
+		if err != nil {
+			return nil, err
+		}
+		s := registered.System
+		err = s.Config().Load(ctx, &gconfig.MapLoader{Values: values})
+		if err != nil {
+			return nil, err
+		}
+		err = s.Init(ctx)
+		if err != nil {
+			return nil, err
+		}
+		v.systems[systemType] = s
+	}
+	return &v, nil
+}
+
+// GetTicket looks up a ticket in a ticketing system.
+func (v *Verifier) GetTicket(ctx context.Context, system string, ticketID string) (*Ticket, error) {
+	s, ok := v.systems[system]
+	if !ok {
+		return nil, SystemNotConfiguredError{System: system}
+	}
+	return s.GetTicket(ctx, ticketID)
+}
@@ -0,0 +1,108 @@
+package ticket
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJiraGetTicket(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "bot@acme.com", user)
+		assert.Equal(t, "secret", pass)
+		if r.URL.Path == "/rest/api/3/issue/OPS-404" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`))
+			return
+		}
+		assert.Equal(t, "/rest/api/3/issue/OPS-1", r.URL.Path)
+		assert.Equal(t, "summary,status,assignee", r.URL.Query().Get("fields"))
+		_, _ = w.Write([]byte(`{"key":"OPS-1","fields":{"summary":"Database failover","status":{"name":"In Progress"},"assignee":{"emailAddress":"alice@acme.com"}}}`))
+	}))
+	defer srv.Close()
+
+	v, err := NewVerifier(context.Background(), deploy.FeatureMap{
+		SystemTypeJira: {"baseUrl": srv.URL + "/", "username": "bot@acme.com", "apiToken": "secret"},
+	})
+	assert.NoError(t, err)
+
+	got, err := v.GetTicket(context.Background(), SystemTypeJira, "OPS-1")
+	assert.NoError(t, err)
+	assert.Equal(t, &Ticket{
+		ID:            "OPS-1",
+		URL:           srv.URL + "/browse/OPS-1",
+		Summary:       "Database failover",
+		Status:        "In Progress",
+		AssigneeEmail: "alice@acme.com",
+	}, got)
+
+	_, err = v.GetTicket(context.Background(), SystemTypeJira, "OPS-404")
+	assert.Equal(t, ErrTicketNotFound, err)
+}
+
+func TestLinearGetTicket(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/graphql", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("Authorization"))
+		var body struct {
+			Variables map[string]string `json:"variables"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		if body.Variables["id"] == "ENG-404" {
+			_, _ = w.Write([]byte(`{"errors":[{"message":"Entity not found: Issue"}],"data":null}`))
+			return
+		}
+		assert.Equal(t, "ENG-1", body.Variables["id"])
+		_, _ = w.Write([]byte(`{"data":{"issue":{"identifier":"ENG-1","title":"Rotate keys","url":"https://linear.app/acme/issue/ENG-1","state":{"name":"Todo"},"assignee":null}}}`))
+	}))
+	defer srv.Close()
+
+	v, err := NewVerifier(context.Background(), deploy.FeatureMap{
+		SystemTypeLinear: {"apiKey": "secret", "apiUrl": srv.URL},
+	})
+	assert.NoError(t, err)
+
+	got, err := v.GetTicket(context.Background(), SystemTypeLinear, "ENG-1")
+	assert.NoError(t, err)
+	assert.Equal(t, &Ticket{
+		ID:      "ENG-1",
+		URL:     "https://linear.app/acme/issue/ENG-1",
+		Summary: "Rotate keys",
+		Status:  "Todo",
+	}, got)
+
+	_, err = v.GetTicket(context.Background(), SystemTypeLinear, "ENG-404")
+	assert.Equal(t, ErrTicketNotFound, err)
+}
+
+func TestGetTicketErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errorMessages":["Unauthorized"]}`))
+	}))
+	defer srv.Close()
+
+	v, err := NewVerifier(context.Background(), deploy.FeatureMap{
+		SystemTypeJira: {"baseUrl": srv.URL, "username": "bot@acme.com", "apiToken": "wrong"},
+	})
+	assert.NoError(t, err)
+
+	_, err = v.GetTicket(context.Background(), SystemTypeJira, "OPS-1")
+	assert.ErrorContains(t, err, "401")
+
+	_, err = v.GetTicket(context.Background(), SystemTypeLinear, "ENG-1")
+	assert.Equal(t, SystemNotConfiguredError{System: SystemTypeLinear}, err)
+}
+
+func TestNewVerifierUnknownSystem(t *testing.T) {
+	_, err := NewVerifier(context.Background(), deploy.FeatureMap{"servicenow": {}})
+	assert.Error(t, err)
+}
@@ -40,6 +40,14 @@ const (
 	REVIEWED  ApprovalMethod = "REVIEWED"
 )
 
+// Defines values for FormFieldType.
+const (
+	Boolean FormFieldType = "boolean"
+	Number  FormFieldType = "number"
+	Select  FormFieldType = "select"
+	Text    FormFieldType = "text"
+)
+
 // Defines values for GrantStatus.
 const (
 	GrantStatusACTIVE  GrantStatus = "ACTIVE"
@@ -55,6 +63,19 @@ const (
 	IdpStatusARCHIVED IdpStatus = "ARCHIVED"
 )
 
+// Defines values for OnCallSource.
+const (
+	Opsgenie  OnCallSource = "opsgenie"
+	Pagerduty OnCallSource = "pagerduty"
+)
+
+// Defines values for PolicyDecision.
+const (
+	Approve PolicyDecision = "approve"
+	Deny    PolicyDecision = "deny"
+	Review  PolicyDecision = "review"
+)
+
 // Defines values for ProviderSetupStatus.
 const (
 	COMPLETE                       ProviderSetupStatus = "COMPLETE"
@@ -102,6 +123,7 @@ const (
 	RequestStatusAPPROVED  RequestStatus = "APPROVED"
 	RequestStatusCANCELLED RequestStatus = "CANCELLED"
 	RequestStatusDECLINED  RequestStatus = "DECLINED"
+	RequestStatusEXPIRED   RequestStatus = "EXPIRED"
 	RequestStatusPENDING   RequestStatus = "PENDING"
 )
 
@@ -111,6 +133,23 @@ const (
 	DECLINED ReviewDecision = "DECLINED"
 )
 
+// Defines values for TicketSystem.
+const (
+	Jira   TicketSystem = "jira"
+	Linear TicketSystem = "linear"
+)
+
+// Defines values for Weekday.
+const (
+	FRIDAY    Weekday = "FRIDAY"
+	MONDAY    Weekday = "MONDAY"
+	SATURDAY  Weekday = "SATURDAY"
+	SUNDAY    Weekday = "SUNDAY"
+	THURSDAY  Weekday = "THURSDAY"
+	TUESDAY   Weekday = "TUESDAY"
+	WEDNESDAY Weekday = "WEDNESDAY"
+)
+
 // Access Rule contains information for an end user to make a request for access.
 type AccessRule struct {
 	Description string `json:"description"`
@@ -132,15 +171,36 @@ type AccessRule struct {
 // AccessRuleDetail contains detailed information about a rule and is used in administrative apis.
 type AccessRuleDetail struct {
 	// Approver config for access rules
-	Approval    ApproverConfig `json:"approval"`
-	Description string         `json:"description"`
+	Approval ApproverConfig `json:"approval"`
+
+	// Break-glass configuration for an Access Rule. Break-glass lets eligible users bypass approval during an incident. Break-glass requests are approved automatically and must be reviewed by an approver afterwards.
+	BreakGlass  *BreakGlassConfig `json:"breakGlass,omitempty"`
+	Description string            `json:"description"`
+
+	// Fields which users fill in when requesting access.
+	FormFields *[]FormField `json:"formFields,omitempty"`
 
 	// The group IDs that the access rule applies to.
-	Groups    []string           `json:"groups"`
-	ID        string             `json:"id"`
-	IsCurrent bool               `json:"isCurrent"`
-	Metadata  AccessRuleMetadata `json:"metadata"`
-	Name      string             `json:"name"`
+	Groups    []string `json:"groups"`
+	ID        string   `json:"id"`
+	IsCurrent bool     `json:"isCurrent"`
+
+	// Limits on how many requests a user can make for an Access Rule.
+	Limits   *RequestLimits     `json:"limits,omitempty"`
+	Metadata AccessRuleMetadata `json:"metadata"`
+	Name     string             `json:"name"`
+
+	// Uses an on-call schedule to approve or route requests for an Access Rule. The on-call source must be configured for the deployment.
+	OnCall *OnCallConfig `json:"onCall,omitempty"`
+
+	// A CEL expression which decides whether requests are approved automatically, require review, or are denied.
+	Policy *string `json:"policy,omitempty"`
+
+	// Allows recurring requests for an Access Rule. Recurring requests are reviewed once, and grants are created for each occurrence up to the horizon.
+	Recurrence *RecurrenceConfig `json:"recurrence,omitempty"`
+
+	// Controls what happens when a pending request for an Access Rule isn't reviewed. Each duration is measured from when the request was made.
+	ReviewSla *ReviewSLA `json:"reviewSla,omitempty"`
 
 	// The status of an Access Rule.
 	Status AccessRuleStatus `json:"status"`
@@ -148,6 +208,9 @@ type AccessRuleDetail struct {
 	// A detailed target for an access rule
 	Target AccessRuleTargetDetail `json:"target"`
 
+	// Requires requests for an Access Rule to link a ticket, which is verified against a ticketing system. The ticketing system must be configured for the deployment.
+	Ticket *TicketConfig `json:"ticket,omitempty"`
+
 	// Time configuration for an Access Rule.
 	TimeConstraints TimeConstraints `json:"timeConstraints"`
 
@@ -204,17 +267,58 @@ type AccessRuleTargetDetailArguments_Groupings struct {
 // AccessToken defines model for AccessToken.
 type AccessToken = string
 
+// Restricts access to the configured windows. A grant must start and end within the allowed windows, evaluated in the configured timezone.
+type AllowedWindows struct {
+	// An IANA timezone name, such as Australia/Brisbane. Defaults to UTC.
+	Timezone *string      `json:"timezone,omitempty"`
+	Windows  []TimeWindow `json:"windows"`
+}
+
 // Describes whether a request has been approved automatically or from a review
 type ApprovalMethod string
 
+// The number of approvals received for an approval stage which requires more than one approval.
+type ApprovalProgress struct {
+	Approvals int `json:"approvals"`
+	Required  int `json:"required"`
+}
+
+// A stage in a multi-stage approval workflow.
+type ApprovalStage struct {
+	Groups []string `json:"groups"`
+
+	// The number of distinct approvals required to complete the stage. Defaults to 1.
+	MinApprovals *int    `json:"minApprovals,omitempty"`
+	Name         *string `json:"name,omitempty"`
+
+	// The user IDs of the approvers for the stage.
+	Users []string `json:"users"`
+}
+
 // Approver config for access rules
 type ApproverConfig struct {
-	Groups []string `json:"groups"`
+	// If true, a single declining review declines the request. If false, the request is declined once the required number of approvals can no longer be reached. Defaults to true.
+	DeclineVeto *bool    `json:"declineVeto,omitempty"`
+	Groups      []string `json:"groups"`
+
+	// The number of distinct approvals required to approve the request. Ignored if stages are set. Defaults to 1.
+	MinApprovals *int `json:"minApprovals,omitempty"`
+
+	// Ordered approval stages. When set, users and groups are ignored and each stage must approve the request in turn.
+	Stages *[]ApprovalStage `json:"stages,omitempty"`
 
 	// The user IDs of the approvers for the request.
 	Users []string `json:"users"`
 }
 
+// Break-glass configuration for an Access Rule. Break-glass lets eligible users bypass approval during an incident. Break-glass requests are approved automatically and must be reviewed by an approver afterwards.
+type BreakGlassConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// The group IDs whose members may use break-glass access. If empty, any user who can request the Access Rule may use it.
+	Groups *[]string `json:"groups,omitempty"`
+}
+
 // a request body for creating a Access Rule Target
 type CreateAccessRuleTarget struct {
 	ProviderId string                      `json:"providerId"`
@@ -237,11 +341,99 @@ type CreateAccessRuleTargetDetailArguments_Groupings struct {
 	AdditionalProperties map[string][]string `json:"-"`
 }
 
+// Request break-glass access, bypassing approval. The Access Rule must have break-glass enabled.
+type CreateRequestBreakGlass struct {
+	// The user must acknowledge that break-glass access will be reviewed after the fact.
+	Acknowledged  bool   `json:"acknowledged"`
+	Justification string `json:"justification"`
+}
+
+// The values of the form fields of the Access Rule, keyed by field ID.
+type CreateRequestFormData struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Makes the request on behalf of another user, or of every member of a group. Exactly one of userId or groupId must be provided. Only administrators may make requests on behalf of others.
+type CreateRequestOnBehalfOf struct {
+	// The ID of the group to request access for. A request is created for each active member of the group.
+	GroupId *string `json:"groupId,omitempty"`
+
+	// The ID of the user to request access for.
+	UserId *string `json:"userId,omitempty"`
+}
+
 // CreateRequestWith defines model for CreateRequestWith.
 type CreateRequestWith struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// A delegation of a user's reviews to another user for a period of time.
+type Delegation struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// The ID of the user who reviews requests on behalf of the delegator.
+	DelegateId string `json:"delegateId"`
+
+	// The ID of the user whose reviews are delegated.
+	DelegatorId string    `json:"delegatorId"`
+	EndTime     time.Time `json:"endTime"`
+	Id          string    `json:"id"`
+	StartTime   time.Time `json:"startTime"`
+}
+
+// A set of mutually exclusive Access Rules. A user can't hold grants from more than one of the Access Rules in the set at the same time.
+type ExclusiveRuleSet struct {
+	CreatedAt   time.Time `json:"createdAt"`
+	CreatedBy   string    `json:"createdBy"`
+	Description string    `json:"description"`
+	Id          string    `json:"id"`
+	Name        string    `json:"name"`
+
+	// The IDs of the mutually exclusive Access Rules.
+	RuleIds []string `json:"ruleIds"`
+}
+
+// A user holding overlapping grants from mutually exclusive Access Rules.
+type ExclusiveRuleViolation struct {
+	ExclusiveRuleSetId string `json:"exclusiveRuleSetId"`
+
+	// The IDs of the requests with overlapping grants.
+	RequestIds []string `json:"requestIds"`
+
+	// The IDs of the Access Rules of the requests.
+	RuleIds []string `json:"ruleIds"`
+	UserId  string   `json:"userId"`
+}
+
+// A field which users fill in when requesting access to an Access Rule, such as a ticket ID or change type.
+type FormField struct {
+	Description *string `json:"description,omitempty"`
+
+	// The key of the field in the form data of requests.
+	Id    string `json:"id"`
+	Label string `json:"label"`
+
+	// The allowed values of a select field.
+	Options *[]string `json:"options,omitempty"`
+
+	// A regular expression which the whole value of a text field must match.
+	Pattern  *string `json:"pattern,omitempty"`
+	Required *bool   `json:"required,omitempty"`
+
+	// The type of value which a form field accepts.
+	Type FormFieldType `json:"type"`
+}
+
+// The type of value which a form field accepts.
+type FormFieldType string
+
+// The value of a form field provided by the user when they made the request.
+type FormFieldValue struct {
+	Id    string `json:"id"`
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
 // A temporary assignment of a user to a principal.
 type Grant struct {
 	// The end time of the grant.
@@ -289,6 +481,35 @@ type LookupAccessRule struct {
 	SelectableWithOptionValues *[]KeyValue `json:"selectableWithOptionValues,omitempty"`
 }
 
+// Uses an on-call schedule to approve or route requests for an Access Rule. The on-call source must be configured for the deployment.
+type OnCallConfig struct {
+	// Requests are approved automatically if the requesting user is currently on call for the schedule.
+	AutoApprove *bool `json:"autoApprove,omitempty"`
+
+	// The users who are currently on call for the schedule are added as reviewers of the request.
+	RouteReview *bool `json:"routeReview,omitempty"`
+
+	// The ID of the schedule in the on-call source.
+	ScheduleId string `json:"scheduleId"`
+
+	// The on-call scheduling tool which a schedule belongs to.
+	Source OnCallSource `json:"source"`
+}
+
+// The on-call scheduling tool which a schedule belongs to.
+type OnCallSource string
+
+// The decision made by an Access Rule policy.
+type PolicyDecision string
+
+// Counts of a user's recent requests for an Access Rule, which are available to policies as the history variable.
+type PolicyHistory struct {
+	Approved  int `json:"approved"`
+	Cancelled int `json:"cancelled"`
+	Declined  int `json:"declined"`
+	Requests  int `json:"requests"`
+}
+
 // Provider
 type Provider struct {
 	Id   string `json:"id"`
@@ -392,6 +613,18 @@ type ProviderSetupValidation struct {
 // The status of the validation.
 type ProviderSetupValidationStatus string
 
+// Limits the number of requests a user can make for an Access Rule within a rolling window.
+type RateLimit struct {
+	MaxRequests   int `json:"maxRequests"`
+	WindowSeconds int `json:"windowSeconds"`
+}
+
+// Allows recurring requests for an Access Rule. Recurring requests are reviewed once, and grants are created for each occurrence up to the horizon.
+type RecurrenceConfig struct {
+	// How far ahead of the time the request is made occurrences may be scheduled, in seconds. Occurrences of unbounded recurrence rules are created up to the horizon.
+	MaxHorizonSeconds int `json:"maxHorizonSeconds"`
+}
+
 // A request to access something made by an end user in Granted.
 type Request struct {
 	AccessRuleId      string `json:"accessRuleId"`
@@ -400,29 +633,62 @@ type Request struct {
 	// Describes whether a request has been approved automatically or from a review
 	ApprovalMethod *ApprovalMethod `json:"approvalMethod,omitempty"`
 
+	// The number of approvals received for an approval stage which requires more than one approval.
+	ApprovalProgress *ApprovalProgress `json:"approvalProgress,omitempty"`
+
+	// Details of a break-glass request.
+	BreakGlass *RequestBreakGlass `json:"breakGlass,omitempty"`
+
+	// If the request is an extension, the ID of the request whose grant it extends.
+	ExtensionOf *string           `json:"extensionOf,omitempty"`
+	FormData    *[]FormFieldValue `json:"formData,omitempty"`
+
 	// A temporary assignment of a user to a principal.
-	Grant       *Grant    `json:"grant,omitempty"`
-	ID          string    `json:"id"`
-	Reason      *string   `json:"reason,omitempty"`
-	RequestedAt time.Time `json:"requestedAt"`
-	Requestor   string    `json:"requestor"`
+	Grant  *Grant  `json:"grant,omitempty"`
+	ID     string  `json:"id"`
+	Reason *string `json:"reason,omitempty"`
+
+	// The recurrence of a recurring request.
+	Recurrence *RequestRecurrence `json:"recurrence,omitempty"`
+
+	// If the request is an occurrence of a recurring request, the ID of the recurring request.
+	RecurrenceOf *string   `json:"recurrenceOf,omitempty"`
+	RequestedAt  time.Time `json:"requestedAt"`
+	Requestor    string    `json:"requestor"`
 
 	// The status of an Access Request.
-	Status    RequestStatus `json:"status"`
-	Timing    RequestTiming `json:"timing"`
-	UpdatedAt time.Time     `json:"updatedAt"`
+	Status RequestStatus `json:"status"`
+
+	// If the request was made on behalf of the requestor, the ID of the user who made it.
+	SubmittedBy *string `json:"submittedBy,omitempty"`
+
+	// The ticket linked to a request, as it was when the request was made.
+	Ticket    *RequestTicket `json:"ticket,omitempty"`
+	Timing    RequestTiming  `json:"timing"`
+	UpdatedAt time.Time      `json:"updatedAt"`
 }
 
 // Access Rule contains information for an end user to make a request for access.
 type RequestAccessRule struct {
-	Description string `json:"description"`
-	ID          string `json:"id"`
-	IsCurrent   bool   `json:"isCurrent"`
-	Name        string `json:"name"`
+	// Break-glass configuration for an Access Rule. Break-glass lets eligible users bypass approval during an incident. Break-glass requests are approved automatically and must be reviewed by an approver afterwards.
+	BreakGlass  *BreakGlassConfig `json:"breakGlass,omitempty"`
+	Description string            `json:"description"`
+
+	// Fields which users fill in when requesting access.
+	FormFields *[]FormField `json:"formFields,omitempty"`
+	ID         string       `json:"id"`
+	IsCurrent  bool         `json:"isCurrent"`
+	Name       string       `json:"name"`
+
+	// Allows recurring requests for an Access Rule. Recurring requests are reviewed once, and grants are created for each occurrence up to the horizon.
+	Recurrence *RecurrenceConfig `json:"recurrence,omitempty"`
 
 	// A detailed target for an access rule request
 	Target RequestAccessRuleTarget `json:"target"`
 
+	// Requires requests for an Access Rule to link a ticket, which is verified against a ticketing system. The ticketing system must be configured for the deployment.
+	Ticket *TicketConfig `json:"ticket,omitempty"`
+
 	// Time configuration for an Access Rule.
 	TimeConstraints TimeConstraints `json:"timeConstraints"`
 
@@ -454,29 +720,59 @@ type RequestArgument struct {
 	Title             string `json:"title"`
 }
 
+// Details of a break-glass request.
+type RequestBreakGlass struct {
+	Justification string `json:"justification"`
+
+	// true if the request is still waiting on an after-the-fact review by an approver.
+	ReviewRequired bool `json:"reviewRequired"`
+}
+
 // A request to access something made by an end user in Granted.
 type RequestDetail struct {
 	// Access Rule contains information for an end user to make a request for access.
 	AccessRule AccessRule `json:"accessRule"`
 
 	// Describes whether a request has been approved automatically or from a review
-	ApprovalMethod *ApprovalMethod         `json:"approvalMethod,omitempty"`
-	Arguments      RequestDetail_Arguments `json:"arguments"`
+	ApprovalMethod *ApprovalMethod `json:"approvalMethod,omitempty"`
+
+	// The number of approvals received for an approval stage which requires more than one approval.
+	ApprovalProgress *ApprovalProgress       `json:"approvalProgress,omitempty"`
+	Arguments        RequestDetail_Arguments `json:"arguments"`
+
+	// Details of a break-glass request.
+	BreakGlass *RequestBreakGlass `json:"breakGlass,omitempty"`
 
 	// true if the requesting user is a reviewer of this request.
 	CanReview bool `json:"canReview"`
 
+	// If the request is an extension, the ID of the request whose grant it extends.
+	ExtensionOf *string           `json:"extensionOf,omitempty"`
+	FormData    *[]FormFieldValue `json:"formData,omitempty"`
+
 	// A temporary assignment of a user to a principal.
-	Grant       *Grant    `json:"grant,omitempty"`
-	ID          string    `json:"id"`
-	Reason      *string   `json:"reason,omitempty"`
-	RequestedAt time.Time `json:"requestedAt"`
-	Requestor   string    `json:"requestor"`
+	Grant  *Grant  `json:"grant,omitempty"`
+	ID     string  `json:"id"`
+	Reason *string `json:"reason,omitempty"`
+
+	// The recurrence of a recurring request.
+	Recurrence *RequestRecurrence `json:"recurrence,omitempty"`
+
+	// If the request is an occurrence of a recurring request, the ID of the recurring request.
+	RecurrenceOf *string   `json:"recurrenceOf,omitempty"`
+	RequestedAt  time.Time `json:"requestedAt"`
+	Requestor    string    `json:"requestor"`
 
 	// The status of an Access Request.
-	Status    RequestStatus `json:"status"`
-	Timing    RequestTiming `json:"timing"`
-	UpdatedAt time.Time     `json:"updatedAt"`
+	Status RequestStatus `json:"status"`
+
+	// If the request was made on behalf of the requestor, the ID of the user who made it.
+	SubmittedBy *string `json:"submittedBy,omitempty"`
+
+	// The ticket linked to a request, as it was when the request was made.
+	Ticket    *RequestTicket `json:"ticket,omitempty"`
+	Timing    RequestTiming  `json:"timing"`
+	UpdatedAt time.Time      `json:"updatedAt"`
 }
 
 // RequestDetail_Arguments defines model for RequestDetail.Arguments.
@@ -489,6 +785,9 @@ type RequestEvent struct {
 	Actor     *string   `json:"actor,omitempty"`
 	CreatedAt time.Time `json:"createdAt"`
 
+	// The index of the approval stage the request moved from.
+	FromApprovalStage *int `json:"fromApprovalStage,omitempty"`
+
 	// The current state of the grant.
 	FromGrantStatus *RequestEventFromGrantStatus `json:"fromGrantStatus,omitempty"`
 
@@ -504,6 +803,9 @@ type RequestEvent struct {
 	RequestCreated *bool              `json:"requestCreated,omitempty"`
 	RequestId      string             `json:"requestId"`
 
+	// The index of the approval stage the request moved to.
+	ToApprovalStage *int `json:"toApprovalStage,omitempty"`
+
 	// The current state of the grant.
 	ToGrantStatus *RequestEventToGrantStatus `json:"toGrantStatus,omitempty"`
 
@@ -518,9 +820,44 @@ type RequestEventFromGrantStatus string
 // The current state of the grant.
 type RequestEventToGrantStatus string
 
+// Limits on how many requests a user can make for an Access Rule.
+type RequestLimits struct {
+	// The maximum number of active or upcoming grants a user can have for the Access Rule.
+	MaxActiveGrants *int `json:"maxActiveGrants,omitempty"`
+
+	// The maximum number of pending requests a user can have for the Access Rule.
+	MaxPendingRequests *int `json:"maxPendingRequests,omitempty"`
+
+	// Limits the number of requests a user can make for an Access Rule within a rolling window.
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+}
+
+// The recurrence of a recurring request.
+type RequestRecurrence struct {
+	// The IDs of the requests created for each occurrence once the recurring request is approved.
+	OccurrenceRequestIds *[]string `json:"occurrenceRequestIds,omitempty"`
+
+	// The start times of each occurrence.
+	Occurrences []time.Time `json:"occurrences"`
+
+	// The RRULE which the request repeats on.
+	Rule string `json:"rule"`
+}
+
 // The status of an Access Request.
 type RequestStatus string
 
+// The ticket linked to a request, as it was when the request was made.
+type RequestTicket struct {
+	Id      string `json:"id"`
+	Status  string `json:"status"`
+	Summary string `json:"summary"`
+
+	// The ticketing system which tickets linked to requests are verified against.
+	System TicketSystem `json:"system"`
+	Url    string       `json:"url"`
+}
+
 // RequestTiming defines model for RequestTiming.
 type RequestTiming struct {
 	DurationSeconds int `json:"durationSeconds"`
@@ -532,10 +869,93 @@ type RequestTiming struct {
 // A decision made on an Access Request.
 type ReviewDecision string
 
+// Controls what happens when a pending request for an Access Rule isn't reviewed. Each duration is measured from when the request was made.
+type ReviewSLA struct {
+	// The escalation approvers are added as reviewers of the request after this many seconds.
+	EscalateAfterSeconds *int `json:"escalateAfterSeconds,omitempty"`
+
+	// The IDs of groups whose members are escalation approvers.
+	EscalationGroups *[]string `json:"escalationGroups,omitempty"`
+
+	// The user IDs of the escalation approvers.
+	EscalationUsers *[]string `json:"escalationUsers,omitempty"`
+
+	// The request is closed with the EXPIRED status after this many seconds.
+	ExpireAfterSeconds *int `json:"expireAfterSeconds,omitempty"`
+
+	// Reviewers are reminded about the request after this many seconds.
+	ReminderAfterSeconds *int `json:"reminderAfterSeconds,omitempty"`
+}
+
+// A sample request to evaluate an Access Rule policy against.
+type TestAccessRulePolicyRequest struct {
+	// Counts of a user's recent requests for an Access Rule, which are available to policies as the history variable.
+	History *PolicyHistory `json:"history,omitempty"`
+
+	// The CEL expression to evaluate.
+	Policy string        `json:"policy"`
+	Timing RequestTiming `json:"timing"`
+
+	// The ID of the user making the sample request.
+	UserId string `json:"userId"`
+
+	// The selected arguments of the sample request.
+	With *TestAccessRulePolicyRequest_With `json:"with,omitempty"`
+}
+
+// The selected arguments of the sample request.
+type TestAccessRulePolicyRequest_With struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// TestAccessRulePolicyResponse defines model for TestAccessRulePolicyResponse.
+type TestAccessRulePolicyResponse struct {
+	// The decision made by an Access Rule policy.
+	Decision PolicyDecision `json:"decision"`
+}
+
+// Requires requests for an Access Rule to link a ticket, which is verified against a ticketing system. The ticketing system must be configured for the deployment.
+type TicketConfig struct {
+	// The ticket must be in one of these statuses, such as "In Progress". If empty, tickets in any status are allowed.
+	AllowedStatuses *[]string `json:"allowedStatuses,omitempty"`
+
+	// The ticket must be assigned to the requesting user.
+	RequireAssignee *bool `json:"requireAssignee,omitempty"`
+
+	// The ticketing system which tickets linked to requests are verified against.
+	System TicketSystem `json:"system"`
+}
+
+// The ticketing system which tickets linked to requests are verified against.
+type TicketSystem string
+
 // Time configuration for an Access Rule.
 type TimeConstraints struct {
+	// Restricts access to the configured windows. A grant must start and end within the allowed windows, evaluated in the configured timezone.
+	AllowedWindows *AllowedWindows `json:"allowedWindows,omitempty"`
+
+	// The duration in seconds used when a request doesn't specify a duration.
+	DefaultDurationSeconds *int `json:"defaultDurationSeconds,omitempty"`
+
 	// The maximum duration in seconds the access is allowed for.
 	MaxDurationSeconds int `json:"maxDurationSeconds"`
+
+	// How far ahead in seconds the start time of a scheduled request may be. If omitted, access can be scheduled any time in the future.
+	MaxScheduleAheadSeconds *int `json:"maxScheduleAheadSeconds,omitempty"`
+
+	// The minimum duration in seconds the access is allowed for.
+	MinDurationSeconds *int `json:"minDurationSeconds,omitempty"`
+}
+
+// A window of hours on days of the week, such as 09:00 to 17:00 on weekdays.
+type TimeWindow struct {
+	Days []Weekday `json:"days"`
+
+	// The hour of the day that the window ends at, exclusive. Use 24 for the end of the day.
+	EndHour int `json:"endHour"`
+
+	// The hour of the day that the window starts at, inclusive.
+	StartHour int `json:"startHour"`
 }
 
 // User defines model for User.
@@ -550,6 +970,9 @@ type User struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// Weekday defines model for Weekday.
+type Weekday string
+
 // With defines model for With.
 type With struct {
 	FieldDescription  *string `json:"fieldDescription,omitempty"`
@@ -580,6 +1003,11 @@ type CompleteProviderSetupResponse struct {
 	DeploymentConfigUpdateRequired bool `json:"deploymentConfigUpdateRequired"`
 }
 
+// CreateRequestResponse defines model for CreateRequestResponse.
+type CreateRequestResponse struct {
+	Requests []Request `json:"requests"`
+}
+
 // DeploymentVersionResponse defines model for DeploymentVersionResponse.
 type DeploymentVersionResponse struct {
 	// The deployment version. Will be a semver, such as "v0.9.0" for official releases, or "dev+GIT_HASH" for pre-release builds.
@@ -615,6 +1043,25 @@ type ListAccessRulesResponse struct {
 	Next        *string      `json:"next"`
 }
 
+// ListDelegationsResponse defines model for ListDelegationsResponse.
+type ListDelegationsResponse struct {
+	// Delegations which have been made to the current user.
+	DelegatedToMe []Delegation `json:"delegatedToMe"`
+
+	// Delegations which the current user has made.
+	Delegations []Delegation `json:"delegations"`
+}
+
+// ListExclusiveRuleSetsResponse defines model for ListExclusiveRuleSetsResponse.
+type ListExclusiveRuleSetsResponse struct {
+	ExclusiveRuleSets []ExclusiveRuleSet `json:"exclusiveRuleSets"`
+}
+
+// ListExclusiveRuleViolationsResponse defines model for ListExclusiveRuleViolationsResponse.
+type ListExclusiveRuleViolationsResponse struct {
+	Violations []ExclusiveRuleViolation `json:"violations"`
+}
+
 // ListGroupsResponse defines model for ListGroupsResponse.
 type ListGroupsResponse struct {
 	Groups []Group `json:"groups"`
@@ -656,20 +1103,59 @@ type ReviewResponse struct {
 // CreateAccessRuleRequest defines model for CreateAccessRuleRequest.
 type CreateAccessRuleRequest struct {
 	// Approver config for access rules
-	Approval    ApproverConfig `json:"approval"`
-	Description string         `json:"description"`
+	Approval ApproverConfig `json:"approval"`
+
+	// Break-glass configuration for an Access Rule. Break-glass lets eligible users bypass approval during an incident. Break-glass requests are approved automatically and must be reviewed by an approver afterwards.
+	BreakGlass  *BreakGlassConfig `json:"breakGlass,omitempty"`
+	Description string            `json:"description"`
+	FormFields  *[]FormField      `json:"formFields,omitempty"`
 
 	// The group IDs that the access rule applies to.
 	Groups []string `json:"groups"`
-	Name   string   `json:"name"`
+
+	// Limits on how many requests a user can make for an Access Rule.
+	Limits *RequestLimits `json:"limits,omitempty"`
+	Name   string         `json:"name"`
+
+	// Uses an on-call schedule to approve or route requests for an Access Rule. The on-call source must be configured for the deployment.
+	OnCall *OnCallConfig `json:"onCall,omitempty"`
+
+	// An optional CEL expression which decides whether requests are approved automatically, require review, or are denied. The expression must evaluate to "approve", "review" or "deny".
+	Policy *string `json:"policy,omitempty"`
+
+	// Allows recurring requests for an Access Rule. Recurring requests are reviewed once, and grants are created for each occurrence up to the horizon.
+	Recurrence *RecurrenceConfig `json:"recurrence,omitempty"`
+
+	// Controls what happens when a pending request for an Access Rule isn't reviewed. Each duration is measured from when the request was made.
+	ReviewSla *ReviewSLA `json:"reviewSla,omitempty"`
 
 	// a request body for creating a Access Rule Target
 	Target CreateAccessRuleTarget `json:"target"`
 
+	// Requires requests for an Access Rule to link a ticket, which is verified against a ticketing system. The ticketing system must be configured for the deployment.
+	Ticket *TicketConfig `json:"ticket,omitempty"`
+
 	// Time configuration for an Access Rule.
 	TimeConstraints TimeConstraints `json:"timeConstraints"`
 }
 
+// CreateDelegationRequest defines model for CreateDelegationRequest.
+type CreateDelegationRequest struct {
+	// The ID of the user who will review requests on behalf of the current user.
+	DelegateId string    `json:"delegateId"`
+	EndTime    time.Time `json:"endTime"`
+	StartTime  time.Time `json:"startTime"`
+}
+
+// CreateExclusiveRuleSetRequest defines model for CreateExclusiveRuleSetRequest.
+type CreateExclusiveRuleSetRequest struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+
+	// The IDs of the mutually exclusive Access Rules.
+	RuleIds []string `json:"ruleIds"`
+}
+
 // CreateGroupRequest defines model for CreateGroupRequest.
 type CreateGroupRequest struct {
 	Description *string `json:"description,omitempty"`
@@ -684,10 +1170,25 @@ type CreateProviderSetupRequest struct {
 
 // CreateRequestRequest defines model for CreateRequestRequest.
 type CreateRequestRequest struct {
-	AccessRuleId string             `json:"accessRuleId"`
-	Reason       *string            `json:"reason,omitempty"`
-	Timing       RequestTiming      `json:"timing"`
-	With         *CreateRequestWith `json:"with,omitempty"`
+	AccessRuleId string `json:"accessRuleId"`
+
+	// Request break-glass access, bypassing approval. The Access Rule must have break-glass enabled.
+	BreakGlass *CreateRequestBreakGlass `json:"breakGlass,omitempty"`
+
+	// The values of the form fields of the Access Rule, keyed by field ID.
+	FormData *CreateRequestFormData `json:"formData,omitempty"`
+
+	// Makes the request on behalf of another user, or of every member of a group. Exactly one of userId or groupId must be provided. Only administrators may make requests on behalf of others.
+	OnBehalfOf *CreateRequestOnBehalfOf `json:"onBehalfOf,omitempty"`
+	Reason     *string                  `json:"reason,omitempty"`
+
+	// An RRULE which repeats the request, such as "FREQ=WEEKLY;BYDAY=TU;COUNT=8". Each occurrence starts at the same time of day as the scheduled start time and lasts for the requested duration. FREQ (DAILY or WEEKLY), INTERVAL, BYDAY, COUNT and UNTIL are supported. Requires the Access Rule to allow recurring requests.
+	Recurrence *string `json:"recurrence,omitempty"`
+
+	// The ID of the ticket which justifies the request, such as a Jira issue key. Required if the Access Rule requires a ticket.
+	TicketId *string            `json:"ticketId,omitempty"`
+	Timing   RequestTiming      `json:"timing"`
+	With     *CreateRequestWith `json:"with,omitempty"`
 }
 
 // CreateUserRequest defines model for CreateUserRequest.
@@ -698,6 +1199,13 @@ type CreateUserRequest struct {
 	LastName  string              `json:"lastName"`
 }
 
+// ExtendRequestRequest defines model for ExtendRequestRequest.
+type ExtendRequestRequest struct {
+	// The number of seconds to extend the grant by.
+	ExtensionDurationSeconds int     `json:"extensionDurationSeconds"`
+	Reason                   *string `json:"reason,omitempty"`
+}
+
 // ProviderSetupStepCompleteRequest defines model for ProviderSetupStepCompleteRequest.
 type ProviderSetupStepCompleteRequest struct {
 	// Whether the step is complete or not.
@@ -743,6 +1251,9 @@ type AdminListAccessRulesParams struct {
 // AdminListAccessRulesParamsStatus defines parameters for AdminListAccessRules.
 type AdminListAccessRulesParamsStatus string
 
+// AdminTestAccessRulePolicyJSONBody defines parameters for AdminTestAccessRulePolicy.
+type AdminTestAccessRulePolicyJSONBody = TestAccessRulePolicyRequest
+
 // GetGroupsParams defines parameters for GetGroups.
 type GetGroupsParams struct {
 	// encrypted token containing pagination info
@@ -808,9 +1319,15 @@ type UserListRequestsUpcomingParams struct {
 // AdminCreateAccessRuleJSONRequestBody defines body for AdminCreateAccessRule for application/json ContentType.
 type AdminCreateAccessRuleJSONRequestBody CreateAccessRuleRequest
 
+// AdminTestAccessRulePolicyJSONRequestBody defines body for AdminTestAccessRulePolicy for application/json ContentType.
+type AdminTestAccessRulePolicyJSONRequestBody = AdminTestAccessRulePolicyJSONBody
+
 // AdminUpdateAccessRuleJSONRequestBody defines body for AdminUpdateAccessRule for application/json ContentType.
 type AdminUpdateAccessRuleJSONRequestBody CreateAccessRuleRequest
 
+// AdminCreateExclusiveRuleSetJSONRequestBody defines body for AdminCreateExclusiveRuleSet for application/json ContentType.
+type AdminCreateExclusiveRuleSetJSONRequestBody CreateExclusiveRuleSetRequest
+
 // CreateGroupJSONRequestBody defines body for CreateGroup for application/json ContentType.
 type CreateGroupJSONRequestBody CreateGroupRequest
 
@@ -826,9 +1343,15 @@ type CreateUserJSONRequestBody CreateUserRequest
 // UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
 type UpdateUserJSONRequestBody UpdateUserJSONBody
 
+// CreateDelegationJSONRequestBody defines body for CreateDelegation for application/json ContentType.
+type CreateDelegationJSONRequestBody CreateDelegationRequest
+
 // UserCreateRequestJSONRequestBody defines body for UserCreateRequest for application/json ContentType.
 type UserCreateRequestJSONRequestBody CreateRequestRequest
 
+// ExtendRequestJSONRequestBody defines body for ExtendRequest for application/json ContentType.
+type ExtendRequestJSONRequestBody ExtendRequestRequest
+
 // ReviewRequestJSONRequestBody defines body for ReviewRequest for application/json ContentType.
 type ReviewRequestJSONRequestBody ReviewRequest
 
@@ -1044,6 +1567,59 @@ func (a CreateAccessRuleTargetDetailArguments_Groupings) MarshalJSON() ([]byte,
 	return json.Marshal(object)
 }
 
+// Getter for additional properties for CreateRequestFormData. Returns the specified
+// element and whether it was found
+func (a CreateRequestFormData) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for CreateRequestFormData
+func (a *CreateRequestFormData) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for CreateRequestFormData to handle AdditionalProperties
+func (a *CreateRequestFormData) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for CreateRequestFormData to handle AdditionalProperties
+func (a CreateRequestFormData) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // Getter for additional properties for CreateRequestWith. Returns the specified
 // element and whether it was found
 func (a CreateRequestWith) Get(fieldName string) (value string, found bool) {
@@ -1203,6 +1779,59 @@ func (a RequestDetail_Arguments) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
+// Getter for additional properties for TestAccessRulePolicyRequest_With. Returns the specified
+// element and whether it was found
+func (a TestAccessRulePolicyRequest_With) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for TestAccessRulePolicyRequest_With
+func (a *TestAccessRulePolicyRequest_With) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for TestAccessRulePolicyRequest_With to handle AdditionalProperties
+func (a *TestAccessRulePolicyRequest_With) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for TestAccessRulePolicyRequest_With to handle AdditionalProperties
+func (a TestAccessRulePolicyRequest_With) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List Access Rules
@@ -1223,6 +1852,9 @@ type ServerInterface interface {
 	// Create Access Rule
 	// (POST /api/v1/admin/access-rules)
 	AdminCreateAccessRule(w http.ResponseWriter, r *http.Request)
+	// Test Access Rule Policy
+	// (POST /api/v1/admin/access-rules/test-policy)
+	AdminTestAccessRulePolicy(w http.ResponseWriter, r *http.Request)
 	// Get Access Rule
 	// (GET /api/v1/admin/access-rules/{ruleId})
 	AdminGetAccessRule(w http.ResponseWriter, r *http.Request, ruleId string)
@@ -1241,6 +1873,18 @@ type ServerInterface interface {
 	// Get deployment version details
 	// (GET /api/v1/admin/deployment/version)
 	AdminGetDeploymentVersion(w http.ResponseWriter, r *http.Request)
+	// List exclusive rule sets
+	// (GET /api/v1/admin/exclusive-rule-sets)
+	AdminListExclusiveRuleSets(w http.ResponseWriter, r *http.Request)
+	// Create an exclusive rule set
+	// (POST /api/v1/admin/exclusive-rule-sets)
+	AdminCreateExclusiveRuleSet(w http.ResponseWriter, r *http.Request)
+	// List exclusive rule set violations
+	// (GET /api/v1/admin/exclusive-rule-sets/violations)
+	AdminListExclusiveRuleViolations(w http.ResponseWriter, r *http.Request)
+	// Delete an exclusive rule set
+	// (DELETE /api/v1/admin/exclusive-rule-sets/{exclusiveRuleSetId})
+	AdminDeleteExclusiveRuleSet(w http.ResponseWriter, r *http.Request, exclusiveRuleSetId string)
 	// List groups
 	// (GET /api/v1/admin/groups)
 	GetGroups(w http.ResponseWriter, r *http.Request, params GetGroupsParams)
@@ -1307,6 +1951,15 @@ type ServerInterface interface {
 	// Update User
 	// (POST /api/v1/admin/users/{userId})
 	UpdateUser(w http.ResponseWriter, r *http.Request, userId string)
+	// List delegations
+	// (GET /api/v1/delegations)
+	ListDelegations(w http.ResponseWriter, r *http.Request)
+	// Create a delegation
+	// (POST /api/v1/delegations)
+	CreateDelegation(w http.ResponseWriter, r *http.Request)
+	// Delete a delegation
+	// (DELETE /api/v1/delegations/{delegationId})
+	DeleteDelegation(w http.ResponseWriter, r *http.Request, delegationId string)
 	// List my requests
 	// (GET /api/v1/requests)
 	UserListRequests(w http.ResponseWriter, r *http.Request, params UserListRequestsParams)
@@ -1334,6 +1987,9 @@ type ServerInterface interface {
 	// List request events
 	// (GET /api/v1/requests/{requestId}/events)
 	ListRequestEvents(w http.ResponseWriter, r *http.Request, requestId string)
+	// Extend a request
+	// (POST /api/v1/requests/{requestId}/extend)
+	ExtendRequest(w http.ResponseWriter, r *http.Request, requestId string)
 	// Review a request
 	// (POST /api/v1/requests/{requestId}/review)
 	ReviewRequest(w http.ResponseWriter, r *http.Request, requestId string)
@@ -1534,6 +2190,21 @@ func (siw *ServerInterfaceWrapper) AdminCreateAccessRule(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
+// AdminTestAccessRulePolicy operation middleware
+func (siw *ServerInterfaceWrapper) AdminTestAccessRulePolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminTestAccessRulePolicy(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // AdminGetAccessRule operation middleware
 func (siw *ServerInterfaceWrapper) AdminGetAccessRule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1688,6 +2359,77 @@ func (siw *ServerInterfaceWrapper) AdminGetDeploymentVersion(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
+// AdminListExclusiveRuleSets operation middleware
+func (siw *ServerInterfaceWrapper) AdminListExclusiveRuleSets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListExclusiveRuleSets(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminCreateExclusiveRuleSet operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateExclusiveRuleSet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminCreateExclusiveRuleSet(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminListExclusiveRuleViolations operation middleware
+func (siw *ServerInterfaceWrapper) AdminListExclusiveRuleViolations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListExclusiveRuleViolations(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminDeleteExclusiveRuleSet operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteExclusiveRuleSet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "exclusiveRuleSetId" -------------
+	var exclusiveRuleSetId string
+
+	err = runtime.BindStyledParameter("simple", false, "exclusiveRuleSetId", chi.URLParam(r, "exclusiveRuleSetId"), &exclusiveRuleSetId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclusiveRuleSetId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminDeleteExclusiveRuleSet(w, r, exclusiveRuleSetId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // GetGroups operation middleware
 func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2241,6 +2983,62 @@ func (siw *ServerInterfaceWrapper) UpdateUser(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
+// ListDelegations operation middleware
+func (siw *ServerInterfaceWrapper) ListDelegations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDelegations(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateDelegation operation middleware
+func (siw *ServerInterfaceWrapper) CreateDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDelegation(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteDelegation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "delegationId" -------------
+	var delegationId string
+
+	err = runtime.BindStyledParameter("simple", false, "delegationId", chi.URLParam(r, "delegationId"), &delegationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delegationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDelegation(w, r, delegationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // UserListRequests operation middleware
 func (siw *ServerInterfaceWrapper) UserListRequests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2501,6 +3299,32 @@ func (siw *ServerInterfaceWrapper) ListRequestEvents(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
+// ExtendRequest operation middleware
+func (siw *ServerInterfaceWrapper) ExtendRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExtendRequest(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // ReviewRequest operation middleware
 func (siw *ServerInterfaceWrapper) ReviewRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2725,6 +3549,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/admin/access-rules", wrapper.AdminCreateAccessRule)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/access-rules/test-policy", wrapper.AdminTestAccessRulePolicy)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/access-rules/{ruleId}", wrapper.AdminGetAccessRule)
 	})
@@ -2743,6 +3570,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/deployment/version", wrapper.AdminGetDeploymentVersion)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/exclusive-rule-sets", wrapper.AdminListExclusiveRuleSets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/exclusive-rule-sets", wrapper.AdminCreateExclusiveRuleSet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/exclusive-rule-sets/violations", wrapper.AdminListExclusiveRuleViolations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/admin/exclusive-rule-sets/{exclusiveRuleSetId}", wrapper.AdminDeleteExclusiveRuleSet)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/groups", wrapper.GetGroups)
 	})
@@ -2809,6 +3648,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/admin/users/{userId}", wrapper.UpdateUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/delegations", wrapper.ListDelegations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/delegations", wrapper.CreateDelegation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/delegations/{delegationId}", wrapper.DeleteDelegation)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/requests", wrapper.UserListRequests)
 	})
@@ -2836,6 +3684,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/requests/{requestId}/events", wrapper.ListRequestEvents)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/extend", wrapper.ExtendRequest)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/review", wrapper.ReviewRequest)
 	})
@@ -2855,141 +3706,221 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+3fbNrPgv4Ll3j19rCzJjpMm3rPnrmo7qb4msa8tJ3e/ul8LkZCEmiQYAJStZr1/",
-	"+x68SJAAKUqWk7Sbn+LYIDCYGcwLM4OPQUiSjKQo5Sw4+hhQ9CFHjP9IIozkL44pghyNwhAxdpHH6EIN",
-	"EH8KScpRKn+EWRbjEHJM0sEfjKTidyxcoASKnzJKMkS5nhFmGSVLGIuf/42iWXAU/NdBCcVAfccGIzkO",
-	"0WOSzvA8uO8FEWIhxZlYRXyM7mCSxSg4CkZRglMAJZCAE3B2w2HQCxJ49xqlc74Ijg6Gh897QQY5RzQN",
-	"joJf4N6fo71/Dvde9Pr/4+jb7365vv713//L9fXeb7//3+t8ODx4Nri+Tq+v2a//51//FvQCvsrEQoxT",
-	"nEpY5pTkmdxPBapgskBA/g2MTxjgC8gBXyADG81jBCSykAC0H/QCzFEi53GW0L+AlMKV+H8KE1Tdt9gn",
-	"gGLz1d0eDoe9IMGp+f/+dlv37ZtDOkd8He3qXDNRX4nvcYKOSco4hVjzXNtEk9rw+/ue5FFMURQc/WLI",
-	"0Cu5SuOpyi0F3C4AvxabJNM/UMiD+3uxiNrBKzH9w1m+xriPyZeGSZw1mtjhX+WSv/X3PDSvYVwu0Iq0",
-	"c0qWOEL0EvFdIC/T003kgr7zJkABZCYPmhktxABDHORZf1eSYC1qKpD6UFQTYcGPaI5TCfY8xxGKBMR5",
-	"JvYgpcWMUABBim6BOknAYLYfFMjW+N2BWC4O6ziqMdAjihOKIHv8Q8FxIn5aI2s0Didq8H0vuMUCmi6S",
-	"Tn/6XnxQ54oKYgtYWk/QFUP04RRFCcRSy84ITSAPjvRveuuEg4O/GaaMv91UsjyMMzCTWt3SjFNCYgRT",
-	"8ccYfmJ4ajQ1iCwRY8FUwt5A5IqAvOQoOyZCofMdGFehnskVlO8XiC+EXFwgwDjKAGbAjAaEgpTwfrlv",
-	"C9ehtL/ewTjXgiKKsJgTxueVpR0KuoJaTQWWci6AUo4oisB0JYHKGaLgdoHDBQgJpYhlJI2EGJcQS8Eo",
-	"4LaA1EjtBXd7c7Knf5nA7BcFw68NxCtwVNtbA7Uu0BKj252QJtGfPaasi1CImTY02qWd2NaJGX3fC4Sx",
-	"TXGEJttIyxqOCyi6qMFRCqA29r9hgErAhD6HqVF8erH+dTqxjGn1S6AkKghhCqYImF2kgq9wGsZ5JP5q",
-	"fm1Ga71r5piSaNW/TsczgLk4GSTBnKOoJwcRiuc4hXF9xVscx2LJnKGor1EguJYpgo9yvlCSXP3yAbxj",
-	"CcPmUy0PEGYCbdInwMLG5YQKVL6iMOUCSt8JFx+uI7fYiENl+WG7uKvT+gRxiGMG4JTk2jXK+QKlXKAC",
-	"RXIT0rrRh7RmTD4YkxHKYrISB1H5lVdZpPW32lQTgiFIYJrDGOTyA4FngwkjozSOwUi7IgyUi2nRl1MJ",
-	"Jfj297kavFcO6a+S+PfvxGQw5HgpFrENWh/pnEPXurcu5JnIM6GwDG4XKDU6QvB7eR4NVSp2q7RKTwoY",
-	"3iEqJMAOaLZUM/mNfwvHelwfvNcHEwKGkiWiPcDycAEgA9fBcth/0R9eB9K6JrMZDrE82TGCDLGeUIXX",
-	"QYSW//3VePLbT6PLn/TQjKI9PQpMcxxHrL/WTDCAdzsY9X0AnCrLTexJ4PaUUrILaYLEPB6VXTdy5LCO",
-	"AlwOBhTxnKYoAjNKEq246RKHSMI/jsQ556tj+yzsYD8VaSddduXCuDalBsCw73ocOF/0/Kt1wdKFRA6z",
-	"yWqJQbNSTVJItxbbokSi8jVmvAyvmFAZ2wEyU3Qnv0nzOIbTGAVHnObIY2gISa2UU8cIlkd5sKCnFuzE",
-	"ZSDGjAuMKE0XMXC7IFLvG/NB6j4r1KYVtosxpvTQLpivnLOCjNbQZvGNAsMb7etGh0ZncyPUnqqQYiH6",
-	"PQj77Kj67Egq+c9YpWKG4jhKUbALNJWh5U4YkuvuDjlFRPXBzFMx3XaBmKwyYWcEVeBYK5Zqi2zGGDjd",
-	"yyiZU8EdNVOJgSkSRpQKShqn19iMFaVS8pT2e06XYkO70PxLc9HTCXP28rvjMA3EDjhMw/dJ1Z5WKRsj",
-	"cS3jFRPvADE7cjsfYAus9yV3bR6cQ+Gki8NkmwksqAfctsLLBvKl2fCDoDIUqL3Iw26CTA8mGS3jVJ2Y",
-	"8r6T4aodwpk08QWk0h005pWKfeipZeSjVNqOz2bpTmHqcohrJrG89UgBSlUwQDjECbxB5XJqhJxGuGCt",
-	"V21r74g9PkL1O5rHvx08vz04RVN+8B/P05f/8Y+D6Ge4/3Jy+uI/h/9wptDRSHUPF4xPVCz7OKdUU9ON",
-	"v6y52N3yDvYxbl97zb74COQp/pCj0nuVDs0MIyoJJrSdRfs+kNEJzUeSGeTVB9M3XoUvf52+X6DUDMJM",
-	"B2CiHsD8GwbGJ4CiRDJRSFKGmTg0/et0rW+Oo6DczaaXxjZJhWzCXPFYyffOseoFjtXfcDbKEeUBieT/",
-	"UeRxHjVmYBpJ7DA5yDYo8BIBmGHPYfl0qRhfQv7EZzjZCeIwghx2P6tvzBdbyAXGIc838Kgu1fitJYrl",
-	"vH6VKw1yRdOk1z1TpeCZh8gfTZpWKfTGYs7aVZVEWTTi7rmqXrYJuPqCmvKiUH3148p7GhV63yDG4By1",
-	"jNCrFnfWYommo+iDQs/ihaJ+EVhs0wbeBsSezovnNxaxmjF9WRxMV9gpBqlddwlGDnoBSvNEADo6nozf",
-	"nQa9YHRx/NP43emJH5hLw2sOah1bwHPMFLMZ88sSuI7ayKzwaRfDuNHH9m9jUnB9M0YrAsizmUJfPuau",
-	"ygyRpjvxzaXpiM7zBGmhWLfMG7Co4WhBZhdx4IfCkQ3iYJ7GyFxlGxYdvz2/mgS94M3V68n48vT16fHE",
-	"8tpqeh+n89ZUgu463dnPsshT2DIsrSewIe1VNr0WzSXyfJkKjJMsxvOFxJ4wSQJ0uHgyZU8Wd+jD6k7C",
-	"o+adkBuk8l8qy6lf+ySiO/XqLoHPo5vFH4fDZx/U1Fr7vEF8QTz3nSfyf1PEwG1x82n8rgVkYIpQEW6P",
-	"AMw5EcZoCON4BQhV9z7Q3OHbIuxqcvZmNBkfB73g4vTd+PR9TYpV4eq2vWfPXyQxfw4/3KV3h9b2CkvV",
-	"lQzmokCnopSupBQKzJEKnmDsWqYsoiGutJcerbBsdbqiubhghS1T+NS9h16saNAdJBfY8RyfhsRdZy+w",
-	"kjkhgZcaVNldto9fSHO/tG24qXuIYPXvYWvxKrP26gK2AU+dMbpWzP4V5eRa/OxKPrpJl5vkpVVhtGfp",
-	"Bs9zAp+Eh7c/JPEPXMEjo/hemwolGaGQrgBkDM9Teb0vTL0itAVBRnEa4gzGrn+O0sgvRlAaAWH1GjEi",
-	"s0mkxVi4igfDg4O94bO9/SeT4ZOjJy+Ongz7Lw72/6m1mbSthWm7t6mBbdtJLmTjE18mtoSvjApUISW6",
-	"WqM9B5VxSHmjCU35Z8MHazHuQ+WjSSPfA5zWjeenb0/Gb18J+94Y+qcXF2cXSlWe/Xx6In7zn+fjC60z",
-	"Hdzkil/9vJJAHAMYRfJWSsNg2M9DGDdbuI0wNalQuLsGpJ5tpioa9iRfW8JCHR+PkFJXm+vKKRpCuM6v",
-	"E5RMhe7LK9EanHI0V9a8ibd0KICoO+3S67cXqGxP7MKzvXGUlZ5hYSgZF6/gBmuq8otuBhJ8Movo/g/z",
-	"cDE8hHInP6OVTHd1sXqD/I770gxvR4v43Ay2IC7W6yZbnzz9Y4ni/MXd/kF8INd4TchNnrXeKIAE8nAh",
-	"zFErSig0NiByjEk4xpL3VwBSVCbvGbvLlzbSa0xg2CxtgaEYhRxOYyTUzJkEqkyoru5mrA6ob0vC/i6n",
-	"AjOM4oj1VH6QVNIqI1UHjSvTaAxwYvJVxY8ZRTPxgRgoTr1K+NObV0zV6T6voPE6m8HCn8UiDoW7sUq2",
-	"uKMfhi/4wWx58Gdg59W7SC3yFR2SNsgKrouNOsT6uKn20duxUsXcbWh9pG7J3l8WmylOsY4uF/83+LyV",
-	"FquMpnf9RurVSsGBsvtfCr7ZmVTFTPEzjNuzk+3cf5lhrb/ypyRjdolC6vM8zJyqZMGeWp4HmQANmPwY",
-	"fBvjGyTjaudjcIOkhwpBBhm7JTT6zrtygxIQh1jMeQ6VtVkFSto7kC/EqbpdIIp07qOEwqSJM06ojC+n",
-	"BYQMJDCFc0SBhHT0/hJcXr4B55DCBHFEwaX4pt8t6OxXTCV5LKx62NXmjW4H8PYpXN7+icjtwfSPF4HL",
-	"Zw16BkfrLEebnn1fOLNQSe4sir88pSUdkegoMN+euuFntjyki2l0m81ucBU/KlPAo8gKm1mLb1MJSGbV",
-	"7CG+oCSfL9zSwVtCb2YxuRUTmJx/MFkgVtrjTOq/779PCf/+e7BCXOWbI9f9KKpjcASNWKgrhP5ACfUF",
-	"TKMY0QHJUAoz3F8lcWv09Lg+t8dp7VZ5NIMxQ70W47uaUKs04RZVRD0v5xbXU+OTwpQoqKjS4sFEKGgp",
-	"lyhMI5KAny+vxifS+1sSHIGMcJRyDKXqnsU45EyZL4Jv91iGQjzDKCrnHZ8wwyFNhQRghmPUb78lbLuM",
-	"KIuuNA/aDsvx2Zvz16cT4ai8G70en4wm47O3v70cjV9Lu9X8Tro047fjyXj0+rfjs7cvx6+uLtTY8dvf",
-	"zi/OXl2cXl5WJ7m8Oj49PWnyczjy3RmPUlneY8qGTIWbwFEk82bSeRHNLAxAUwXW72rlOFV7Z3rN5njL",
-	"umLlejWFfcb9gq+tEsJckNb8746CTw7x3lwqrNeOY8+VDh6hqQRdN3G5nyZPKFq++ID+fDF1xeUJhvOU",
-	"MI7D18QX2AUxmQu5T1eAoljmgenwin0YxfHX8LryLkZLFPtxKyaXf7aPwfjty7OgF7wfXbxVvK68dh/n",
-	"JmzePHGiLkXXE0oBqGZrwnYVTztB/ThlnOahgNoTqRTsoau6tkvJvbQmWOdB2Is1YaAC7kNNGQdCT11n",
-	"YThtjgDb6vKlrNQw3xTLXOeoqGG1+XpV0JvQaW9+Z9gsZKfr/yqZXbmAKqt/G6qWty6CLq60zDdRhzq7",
-	"Yv42lBU73MkRrBphddFXCjUA51AQ2bKjq4ZPg+pxkaiiC3pd1GCzZ5ByHOYxpBWjnRmIpLszAzBd2Wq2",
-	"MTerzSko91hW8/0eY8b3GCN78s7rd6/OjMl8S8FUFaUeqLubUlW1UyoQ2wq6vDo+Vj+VAeEmjeLT4IXC",
-	"rpOuiU0tptqWSa2y+DpTFsXSxASxGEkQXwgTJ4EREs6ZnedreSwtgbeG+8JywLvSRHJHOZff67Mei9Ey",
-	"eUHf87TX40BVLeELmPhyCMseKE3FBg9OitLzeKs+u+YNalJbSYPbdVXZRZqXj/3LPVpHQcNYxWSv3pPF",
-	"5R4bTOv4XBSBYSeYr//0NfX9C0h9d2jxNQP+E2XAu6egy1FpTkpcn8fXfFljJ1dsk0diwNTzeNModpcK",
-	"2bMA3iqTrw7uxgF+FZHvbiuVd1k+20hvkl3KC6uGoAVmRXSc0xxJW1FfcckDVDTZ0L0oyhQjWpfEllDZ",
-	"0Cky+/bB7GFtg9+OJhOb03l4+AO+nT/bt02m5tzVxzGcNruxfKil9ODDp1qpedg8hKkqlvPcwmgWstLo",
-	"BM6Kvjg6LRHRoquClWznstFXa+9vZ+3VkvtLXmqQvuvtPVUb7Wl10IT+Sm3F1iSeUZJI1rv8/NlHApbL",
-	"7ZhKfDrZjrHkPlQKX+S3LeWIlxDHOUUXzaeu4V6bopDQCEUFgd2WN+IvumPdLRTCRH2h4s9C8ujGUAXK",
-	"N75x0nzcuk09psEx5uRLYRNOtmQSTnbRkc6WGtLpKw+ie+AV0btp+Lv9p38+/RDGiEUfXtgafuOqn6LJ",
-	"nZ03f35+cabSwUoKHI/eHp++VpdtJ6fHr8dvq8n0VQA8tKiiyrUUdczwEoUkjZg/W04m80l55OwQM/L8",
-	"2XBfpmQyDpNM2ChXk2P5iz9Jiuw0wwfpgjqkLhImRid0oeUhIasP8ez53RQ+NQGuSptEr4ui/qZsM5J6",
-	"KOqnp59yleU8pJu4DmyNvXBSD/1qr6lWXValeQLvTlyyu5ybwDuc5AkwmBekZeoDO49O2FtxTG5Vhl1f",
-	"pZGKD4OjZ8Oew041snqAsZA0cXxRRztf6ZaGDR1x2zvcthQrb1pt7EajYcs6GQ55TtEDzLYyQfQRbS9f",
-	"91sDumWNWQ1xbaPLLUu5Yh2y5Y4XFNtEDELxi/+F7hQKYjhlfUxUKq6bGye/Bm8FDlIL2qNgwXnGjgYD",
-	"uIQcUtafY77Ip8Jl0A04+iFJBvlg//Bg//BgOPz35f88FLj9B2ELG5piwfbUvC0W/uHwYPjk2Qu1sKCH",
-	"KXjw3NecrHHxYzhFcYvzv+77Js+6c5aw8bkVIJ50qw3qMFzX2opIbBz9aEaNvLvx214dd73UWWPWrnFU",
-	"3/VZtsFNzB1Bf+D8aYiHT6NcNyrG6YyYzjFQVQMY7idJQlLwEsqmxzmNLf4L5d9mkCNxfJzeL25T09H5",
-	"OHDr9pgVSzwK9vtDxVQyGSw4Cp70h/1hIBsdLyQ5BjDDg+W+zh7bo6YpnDcO+ApxoU0qlXoAMjsK0peB",
-	"HKR0hrCFiz5Io0q3t0qH3oPhsEmgFuMGTX3wZM+bPEkgXenVKn3hZPB0zgT5T9MISBn3q/jGt/NBLHOg",
-	"GxGA0igjOOW6wabq8SszvslM9i5dWmUcCj3fmk4gIUmmOFW6WmaV6YwxEMb4Owdr5U5VWrakmc5GFZvx",
-	"Zr4WlT6rDAHcR31QctUA3rI9xkhf/ZUtSB5HYIoASkMivCU5HkxheMNiyBZg7zofDp8g8N8OZMJCcBR8",
-	"yBFdlcJUZyqVHZCMbeUu6r059W4B0QQzJu0NPqIpkEe1J2DWxa0UMZRMJeMBSmIEBDQKeHm/onu3Kfw1",
-	"QF5fpW8EQrmXTtDCWyaITfKUA6mRfYvpAdLXaZ7/V/+R6Nx9qlOk2Mnwd9N8HMFz9rMYdTg8XH9Cq31x",
-	"a+dSLl2/M5hCcTiISqHUdxyNZ/MjlfeE963iKdJ9rT2m9nV6nZ5qMaWSb0kar4Cs4eAEyLRFW3JU6lQg",
-	"UFVFpV9OZP4vMpcfsbwy4kR2Lra/jBDDc9UQTYnKokmq9zJqXFQlRgSx9BsOEoRkHgmT7oQK4bIegOCn",
-	"yeT8cLgP8hTmfEEo/hNFugGwjKmqHsCuRBYy8BWqXg49iPk2ugNsY7L9jZlsB6wp2MYigV9hOOJXHnWh",
-	"RsuTTs09dmlzqD59Lcd+HbMPisr0VrZ3a9irJ60PrtPJouAKITwrTXzHJ+zr+Wg8H0Vf5x0YLm6P6M/H",
-	"+XVjqWShz3cIhA7vZo1K6OvmqGtIiQlrtuM6Y+oljjmiVWafrmTJUpFnp9zrfoPSL5O2HevI30RonbmB",
-	"0pCuMpVNfSPzJGXeCk7nIFMNN02n+gaIUnTHTcuSjc2QjSzzWkvv7va5fr1CsBnx5bKp8LvbP8hD8Hrf",
-	"gzLm/COJVs1bsl5dHDQ9uXjv4Gh/Z9rS7UjuKktzCyElwHArubH/MLmhCeFXmoaKrYe6mzHnxko9pP5k",
-	"lkwX2nyhhox1sh5FgPeCLPfQUD18wup07Jhw5Se3mvNTnezPwz1DF5U/wghYYGoOq6HbsnMshqoOeks4",
-	"eEnyVI546ltqnHJEUxiDS0SFGSZZrsZqigo7kQADSMMFXqoK1cfiTq8+eQPpDau7pMIGVQBF/et0lK5A",
-	"hlL5dJVpDK7tUsxqfQ5U/lQI0xDFsc+ulHgZqcn//xVZBddtL+g0Divs15XbtHRpNivLLuEmf3SBGSd0",
-	"pd8BtWzADZXTO7P0IxhZOxIJbfqkjo9PqF82pO3go/7pvgOVdV1vWGzPf0vbkbhfDRCLYUqcfCJG6Xkn",
-	"Wlqk2Z7lysLugZV63spc3KoGtuoLGrnJebRtK0nR/PSbSy331TYTQe2iz8v7+CYTXmYfgKLncnXbr5B+",
-	"rGedP/6lO7+1J4c8Hm+BgQ19XWEPq1Ca7jNwTOYp5kRFwDJCYoDNW5kohVOv3WG9ob69tVx5gv0xXWD9",
-	"jtIX4vfuwFrRpCzaj3U7VYOPc/Wm3Xod5pQuKZbpNx64x1RSjeQTmsmRP3I0OGkUOV30hMbTA8W7efev",
-	"NSCxyZOBDva9zy5uJeHbH3D8fJwuUdSEjLV8b74csFUaSm/QKxkv8rSKdTEcFKgGUoUlMI0aCXAp5vfj",
-	"fXfG1IORKaAEBuQu+CsaC7VHzMthvkyNc+uvj38hbfdw73oR/VmI4WCuOzUGH8sOxu3hziJ/Y7pS6QSO",
-	"9LZ62j2aALdK5P5iNOiiLCrdpB+iL/z0HUA6bz5/c2EQy6taOr+UawE1YCqrFNQfrPiSVZTYyAojOmc7",
-	"YodNe4iNzC6+OFapnCZI50Dv9cvlmcFHSOfiP1b151rfUo9tDEydWyiQRVWyB13xWQJXKmIZLmR7OgIo",
-	"mlHEVD87+eue7NKoOpzpP/4OpHMFCrz1WxXJiM7PisLOVi8Pp6ZbSbm+fE7Lhsrg7RvTubLxClZ/5fP4",
-	"yoYy7S5f1wNRMmW52y/AHKsITHkMyhLbT3gO/EEZye67Ok/FQ7QdzB5dKQYpAozjOF7T47Gdu/XK2wYO",
-	"Gp7m9ZDR7WcoWw+aV243iCz8iOZYy49Ky0qzfyVM0jLZx+5N5AssVHCxfYCh9jhoa6ChHbP+Z0a3DBtU",
-	"SCFx5/ZkbMZZcL+GaUtNIP+vTcQI+ftmXaCELNWFqv3Eca23npCZagYVfosgh5ViSMxlspetStT4qNKt",
-	"sErsEznCJfamfN9AnQqe1Vrt25QFQW35cAoZdqu1Wmi/mbEt++pxN6qzHFp2+TBRrZlqp3LWZdmB3ent",
-	"kYFrEmvjGbjIU/n4RCUsYkVGdYt2eVN7S7G2M+q2ki4WrlbuMU4onCtzRLbpFVYK5jIdsXHZCDN73aJ4",
-	"ICKIgZTIThIeoapx+XAGrM/UxohmbP1x5I1c3Sb2qLdP/FTHttaB8tFdZrftZdc46EYb/2vIBNmudvBR",
-	"/DNOI3TXKiV8ldlIICNCd+JEqsJFfTDlLOpUyuptXdjq2XKxeJfNWkWwjyK28oaHYXRRZr1dtcPdl/k0",
-	"wVUGv+RoK4vL6ZJpjv+aTKiHK7yrNYS05I/VbnQnUsh4l59RSZlekKy5L2g1dz9zWlLnmbTd3gslplLY",
-	"dab7wXAIzn4GhhyyLYZOtKdIujtWe1KZBc9UOED9DEKYgikCM5KnsmE/TlmGQm4iU9bHUdGPs2x/Xu9C",
-	"/bt+NsAP6+FwWAKKa+9UhTBNCRewFN1LwbcCLboMuee8Y8Hclu9ivzg1Euc79zQZUnwaO+9dJbLhNgUo",
-	"mb6z1jXpaGsiRValB5Rk0F815slflCNahTRJMNdhSjGsKBFRq7A85myL5HhPW4Zqnw3TfePvkDRvUN3A",
-	"NP+b5BS8Op0UluMmbDH4WDRZ6ZAFVeZAlq0y/DkqZSumxy4ZW5/kdPi5YsuVvndb1slYLXAeYocVj5x6",
-	"CfwS8XBRK/byJuJc6T/8pfNwxCYaqHbhrXvbMiPHtPt7WEKObrexZbhM7fXx03EklH+/bBzT62SdOJVc",
-	"Mvgo/tGCdP3RVoN3YzFqexkangvjXCajq6QwVXPJFjhzixjlh34e68wYD32DudZ/w2o5U3vy9/4xSy+a",
-	"WLhSbvHX4F7NDuu5d611KG8VzKj+dTqRzTp1X07rhk+1j2JgRXJh4s2kQilKIdRdivibcB/U9/6C2r+Z",
-	"ackW5LZEA19AXr7nWu1xOiO0ByjU713AtOmrBWSqdRlfoISheIlY482mmrr9avPvZg1Lhk1WtgfjN70a",
-	"an0q/ds9PX2lqyrNIJDkjOu6gFWtFEA1QE7gTaX9cR9cFUXzVq252zfeLnyX7w+aOnnNCfZKFM0QRWmI",
-	"WB+cCfa5xQyZunZwODwsXWhTfNRe0155aHt7y0NP8CmMj2KNFvvDp93X2OceOTnIoGIbr7CMMMtiuALy",
-	"1BcVXj2A7jIs35JUaQpLciPbjhZCda0kPIeqY/pf2ere2Iv14j/PQmK6YLbSwCnHk3cyqnFBVBOu8oE5",
-	"1VY1Xun3FQGhMh0oymN1RKfyUlfIAfV2OU7BLOc5ResV2ZUB+isJN404FN056nFAo0LNq7JFh2n1aqxR",
-	"flJcS4EN1gdATGcRMQDrDlGFVVFPny9YSEBgGr1zq0GvhPDblHB0ZPrie00A07Otsux3jf1GvkZWvpTI",
-	"io+FTMlh57tM04DVuc8rjAY7B8NmQpICoWdKG0UeAxJL0UURIzkNkff2U5kPj3DtuXFqqAtI16tQbQLV",
-	"Xw/8EnlBCvMuTKCk/qehvlETj1yGqpb5EkWIZiCDhy+Lc5Tx2LHXwXYgNIaSpIsi/HQFhBv1L5TYCizg",
-	"0vTliISXHyMd1NRhT+0rq2b1nginXGFHGs0JFu02kPNZGPVYk2BzR8XmJvn0AfskxpY3DdZu0799Fmxl",
-	"li8lb9ocCWT29mXJEVq8vfPJ5Yjqje+RHsWjSKoTMChDisXzPzKyMkVOmz8nkqKTANTngjUxBeS2DJ71",
-	"7PwG3YJwTetAh4PVRh4QD6lOsFXOjJmi4dJKYXp7IYEVq5AbtBGr4B2xiurIa3voWgMpmMosF7TEJGfC",
-	"R9eOfB+czmZIOew4SVCEIUfxCviISG5Qu6b5y2uLC42u1MQwujKEur5Szxx0riEvK5vL2ElM5nPVx9rf",
-	"5fsV4m/QVhpglPNF9eK2Uy8bTyuLsvNv3VvviCf7mm+NQjW+fSM2ipu3z3Sp9RjNgGALMnuPdDEqoZCN",
-	"yNS0Zdf8o8EgJiGMF4Txo+fD58NACCINWtFzvwDxvlf8Tt2X3f96//8CAAD//+AScgp3wwAA",
+	"H4sIAAAAAAAC/+y9e3cbN7Ig/lXw69/dM8ksRcm2kol9z5y7jCQ7nNiWRw/7zh35JiAbJBE1G0wDLYnx",
+	"ej/7HhSe3UA/SFG2M5u/LLPxKBQKhapCPT4kU7ZcsZzkgifPPiQF+bUkXHzPUkrgh6OCYEFG0ynh/KzM",
+	"yJlqID9NWS5IDn/i1SqjUywoy/d/4SyXv/Hpgiyx/GtVsBUphB4Rr1YFu8GZ/PvfCjJLniX//76DYl/1",
+	"4/sjaEeKI5bP6Dz5OEgmBcHXLzLMeVff721L1zslfFrQlYRRdid3eLnKSPIsGaVLmiMMS0SCodNrgZNB",
+	"ssR3L0k+F4vk2eODw+8GyQoLQYo8eZb8E+/9Ntr7r4O9p4Phvz/76ut/Xl29/4//7+pq76ef/89VeXDw",
+	"+Nv9q6v86oq//9///W/JIBHrlZyIi4LmAMuMFcvnlGQprIQKsuxc0nPTJfloB8RFgdfy//OClSsYorLK",
+	"5GJBEHxD42OOxAILJBbErLUoM4Jg64hc+DAZOFACkOtTZnRJRSfUmlxeqsYfB0mOl6SKfoluhOUeVJF+",
+	"eHAwSJY0N/9/tN0OxNDP8iOcdRLgKbRyBLRiGZ2uQySPcsTgb5yho5OXiNytCsI5ZTm6XdDpAqVkSlPC",
+	"0e2CiAUpkD5kHOEC8C/JPEW4FGyJBZ3iLFsPoBEtCCrIDSW3A8QKaJ6SnJJ0iOTOehMtSy4QucFZiQWR",
+	"RHylzxm5SgboKlGjXCVymKskJfn6KhnGUFOQaVkUJJ+S7q01LR2K1DTnGe7uDA1fjmQvgYs5EV1d6pzo",
+	"QvWS/en0urv/BbRysAq6lJBzUWCad1PyRa35R1gu7FGaPPunOYIDx980sVc5j11tCMB7ux1s8guZiuTj",
+	"RzmJWvcxycgcGOz9OXCqxiLjNM4xxseIzYBRlJwU6HbB0C3NMk2KjnxZjiZkgbOZaa4IQkC3KHWRPJV4",
+	"lNNKFohF8ixJsSB7EhexDlzgQmzSpbYr3lL9wRwkrUg/uZtmJac3RNLbORG7QH3lEgrumAABhl+2M8ag",
+	"m2Tt45Q37S83O7YsRSn5DSJmqUidMCSXzNuvhCXNx+rj4/r9UNsGfRAMVK1IfyEP0sNjeoe3eWSP1BxN",
+	"t9d/uyl/Gu6976RimKAVaW8KdkNTUpwTsQvkrfRwFzBhjIYkKIaITGt573AiULka7kp+6kRNBdIYimqC",
+	"X/I9mdMcwJ6XNCWphLhcyTUA2c/kPYtycmvOgcHsMLHI1vjdgShsLzPFij+N9NNfjK4s18nURoI9xgJv",
+	"NMRz0wlksO/h7jidbTTEqesGlID5w5/vqkwUCH9nZ5cvT7SoV5AVwYIDfel7coB4OV0gzNFV8vzs5O9/",
+	"fXdy8uPLf/z79/84Hv3jrxeX/350evn64q/fXSVDdIKnC8SmZjoENxZHWmzneEmQvPbkwUvxWo4Jv08X",
+	"JC0zkqr2qgnOU5RhLhRJe/CQFKVlAQQ6RBIg9NXxaPzyH1I0VKB9PUDj1xcnZ29HLwcIwBwgABIGvXx9",
+	"MX4JsigvVytWCCmOnqkjqeDxbhDJEnCWMSk1yFXRfG7g4MOIvB+qHSC1dUsqqp3ehV9KLuiMkoZ9wOhv",
+	"tMCIcl4SdE3WFvwU0VmwgsIsDetJesK9lH/1U48uVOOPg+SWikVXp8p5eCc71Jliha9YWFovkEtOivsz",
+	"NLLENKuIauqXQdfdGGrItODi9aYX6/0YI+VgCvBknQljGcG5/JjhTwxPbU8NIh1iPJgc7A2bfHInSJ7u",
+	"7OIicjipeB5rTnJOpixvkjfzcjkhhTypXDWTXAGGSNVFXOBcoMkaDhbN6bJcJs++daeK5oLMSfGpGH4d",
+	"8U1rbUB1RRQ7F2R1xKSlQ+zAdDbVI4VofqdNC3AdCLJClCPTWjL2nAlPIfPIegr68FuclWoKnKZU2TLe",
+	"VKYODku4zWoodANjIZILIjnqZO1rkpI7T1lREL5icveZghhEMAn3MKkjdZDc7c3Znv5xiVf/VDC8b9gu",
+	"i6Pa2hp2SxkidrI1S93tIUWRlEwp1ypNt33l2LSW8tYNKQqakottLqZAq9bj9hG4R7mxcRV/4saGwGYI",
+	"5/aaVZMNr/ILzzipfkTq8kJTLI0NyKwil3RF82lWpvKr+dm01hK+GWPC0vXwKh/PEBXyZLAlFYKkA2jE",
+	"Cjqn0nZXmxFMHhOg3HSoUSCplqsNH5VioS5N9eM9aMe7d5pPNRwgyiXawFhKuSiwYMBWX0gGKqGMnXDZ",
+	"sWu75UKCXYaO7TdLfa+PicA04whPWKlNzaVYkFxIVJAUFgF6lD6kNbX13phMySpja3kQlZ3vcpVqUUkt",
+	"qgnBGC1xXuIMldBB4tlgwvAojWM00uY9jtxkmvXp+wF99fNcNd5zTYbrZfbz13IwPBX0Rk7iq86xrQsO",
+	"Xeva+mzPBZwJhWVpjc7NHSHp3Z1HsysVDTmm/957v4wq0PsVRE8dPkjUkGUH7ouWKawsdcoJGpm/4S7V",
+	"n6UuRaSSBscRHlQ0v7rF3NOwZkwZDI7tpr0lBQfr7b2RdqNGistaHlHqdkP0TnMyjDhZ3pDC10hvDoZP",
+	"hwdXCayMzWZ0SoEVZgRzwgfmveDmf74YX/z0w+j8B910VZA93QpNSpqlfNgpSRnA+3GS+joQzZVWIdck",
+	"cXtSFGwX7JfIcSIyTg161aznjQeNUUFEWeSSHAq21JJOcUOnBOAfpyQXVKyPfOaxg/VUrgewpir1OdR3",
+	"NADmvHfjIOgxiM/WB0tngBzub6t3b5iZaqwVtH3q815A5UvKhXsZMi/HfAfIzMkd9MnLLMOTjCTPRFGS",
+	"iGQmGUKVj3U8oUZuW54M1IS9qAxllAuJESUapBxeaqbYyVsgLHhvvYXjnlWMcXVx74L43Ji9mbqDQ4ER",
+	"e27uuQ+NhpCNUHuiHqftXRlB2GdH1WdHkqO/6ouRxpV7sOQ7EexgNJJesFcR9debTCuZC3xD0ISQHC1x",
+	"SowQV3+g7IVzN3gM56mbug9cdSjQAnMAcSfgxF8/AbZBDYd9BSNvDIXKyRrMwFGM6r2vv5vuggJIfcze",
+	"Z6YOTSfawqk2Ow+2v+K6nAgeR81byrKdHZEbO9h2mLHAdOLHm+m+iEFuLIsiECJ2gRHnFdULGzDv7tiq",
+	"dQi597VT0ZJ3gZhVZcDeCKrA0UkltUk2oxSa760KNi8I53WtlKMJkfqqemk29kWjnlfEUUdTWm88uZEL",
+	"2gU3ujEek5uorTD97ihMA7EDCtPwfVKB+eF1/x0gZkcWvntoEd1mu10rFm+wtIfKw+QrGPDqf3973Qb8",
+	"pVllxKjSFKm1wGE39vxdmaZ6E+XHXiqvAgssKMoSDpY3o5gpM7MeGozMTtwPPQ+8N2q5RkxryrScBOdI",
+	"vrIpaxVDS3xN3HSqBQwjpc9W/6lOd+mIdaHaryiznx5/d/v4hEzE479/lz//+98epz/iR88vTp7+58Hf",
+	"giH0w49yrkrGxzAmP1LyZvyNtsO5OACxn+Np3OX0fs6jg2Yr3giVOf21JM7uBaaQGSWFdeXw9n6IwBCs",
+	"6QiIAWyVXLsxWSvgVf5OWnx1I8q1rTsdICr+xKUrRUGWQERTlnPK5aEZXuWdVj2aJm41m/q8+lsqeRMV",
+	"isYc3QfHapAE9oKGs+FauAOSwv9JGjE7acxI5UZih0MjX6CQgite0chh+b3ENHQEIlSRqH7XeitcAWgm",
+	"Lck0V28HmosA5Vkm8ruMZfgMrGrL8IklETjt4XbnDsAr02ML/rjjOIlPFh3xpQQ2cIFFuYFN71y13/pm",
+	"8synnyUk4l/3VtM7Oegf5mFP6n1uP72hrXfgK48l1HxS1KPlSIRMsOpVI+Eayt2UI+te36+jrFOh9xXh",
+	"HM9JSws9q/UDjEZrtEChR4lCUdsqt0wfeB8Qf7gonl95m9WM6XN7nMObSRFIza9FEnIySEguHdr+mYyO",
+	"LsZvT5JBMjo7+mH89uQ4Dsy5obUAtYEkGjlmitiM8O/djoHQsvKe/fqoZY0WnvgyLizVN2O0wrYii7HS",
+	"2kOuynndNjm/bc6DR8W8XBLNFOt6YQMWNRwtyOzDDuJQBLxBHsyTjBifNUOi49dvLi+SQfLq8uXF+Pzk",
+	"5cnRRfI+QonABmk+b/UZ7C+ABeu5sQ6JWz6n6gF8SAeVRXei2SEv5pLIBVtldL4A7En5MSGHiycT/mRx",
+	"R35d3wE8atwLdk2UT3FlOvVzjCOGQ6/vlvi79Hrxy+HBt7+qoaVPPUnf0TxltxGWdEbkgFMpRVkdQFgf",
+	"zbIgKbpVfaWji3LAhRhSFUEgdSBpN5AEqX3psJrRdBvYaNPUeNt5Y0tW/hvLSagpmS/RSIrx6PXI9kXy",
+	"WnWuKqNSXpsZxfvfF5RPcE6G6JjMcJkJWN3lxZGczVOBgg4xlnrrMNhLdZH3k8J6JwmaoX1Kq25b7CBr",
+	"seIVEQuWxl4U5f8mnrzszDnyKRGePONSsxSSwREFGy9M/266vDh9NboYHyWD5Ozk7fjkXe16qsLVj26/",
+	"/e7pMhPf4V/v8rtDRbd6mDfauN/lMI6tt11BpoTekNTeAfqLpNg5seE3OlZjyQoidcQcSUoybZsVd5/D",
+	"VNzNnedg/Wv9Fd2O5P0eQaBdecvmnwstXNXvQ7VWqdmjZZkJuqd+sLi4ZcX1LGO34UIjb2GdXHlJ85GP",
+	"n7aNSikXNJ+Kyo45D0rri64c1PG8dnofVXz/H8Vc/0Pl9VwqalSskzZDegi0/OTHwGLjLmQVEQXgBiaE",
+	"BpO7RnqECtQON5KAtRCFNKC/G297Z8IFcYhH7LjTjObkLREsHG08Q/AQgTDiNJ9nBKnmKkwLeITuXwml",
+	"GqLxDM1wxsnA/xlRbpqniOVTYj8CGcROtfRWyhnKWD4nhfRRLAiW0WxV6pAwxp2bPzNZq19JDTfznOlY",
+	"MiAkZcngRGxM8qp7CONpkUJsRZUH8iECXZgTMdD2OnmPKxQBDFRDJn+WeFb91MUfWQpc7GWR97brVak7",
+	"gvp7nUqD4Ic7l/bYRQ5mYH4NVgEt9uaySc1rUV9ZFfuG3zojgiOS0TmdZERv3WS9kp/sFqdlob20aT4F",
+	"40l1jB5mM9h32OyJsZypAJ2Ky+BMkOIWF2nEzE1y+XiZxi2a/ey2twvGCVoSecCkQ9FaLhdNvIVoW7Jk",
+	"MWS5EusBwvnaZaKQHMPQZz1U0wxH70MkZpEedQR7H6GPhvQkATpwJSoFSAOMFsrU5a/GKtBxBbfBqfc+",
+	"umx8DVtrtBB8WtdpG/DUG6Odmu3vUTXtxM+uVNKmiP6IAqmJNDiZA82agGCNYA35iCpHseRCO2F6I+iz",
+	"FZHDp9c5u81IOidpy/2gbirXVj0DhTDa4C3L5YCtAcOY4WlDLKQOG1cOAp8rzrcKxKCKmYBOwp1sPEj1",
+	"HAzNpyPqB6rDOvXtLC0paKYeCFkQMz+QUfXqaoE2aHw87ATstJIQogrAK3xdlUCruYdwzkAVljQCb0HS",
+	"1/CGFGt90UAbdQkN0ckdngqpDefE+LaMU9lproIX7A2p2Vg6RKd5tlavZNazDO4acKeIp0MCgHiDHtad",
+	"zgCaSSnRrFfTtYwr6ohMgvgy4q3cjjdsUpR6JoKKgxOl6CiVejvcRQzvOm6xkOPGJoRR+rHK7xh+Mj28",
+	"/csy+4tQrNLzs45aps1XRVwl98JbQb73iVKnlVmRgrIUcCrfOgLiqDzY9EuQtUUuLwNjcx4vPSiLJ/Gy",
+	"X/vPyYmdVT3TKqDT3SQJo3FJ6L65w+AJ0F/soDud2MDbQ49Ze7QUofzAQT1m+SHgideVrwuNFM6nOP+T",
+	"QAuWpcq4y5Xlr2oWCxk3N7ZcOV0968xO6LX9dbHmz9J3p41p6POkQWsV9oCIog/EBrbqo2WUfAIC6SIi",
+	"58sfe4iHsBOW2cj5DK+kGFqllG6MtMdnNKgnmun02RTLn6QKEQF0Mx+fvqRQOQ01SDab0d2sXQlGAszZ",
+	"zhWEVRPoxWjD7XuEQpzXVYQolJzW39dL3XBVoc/lV9LZmORFUKDpAudzlTGu0820OyEibbh0rsnaCqew",
+	"Fs3JQFKVb/vya0PqqW8PY9n53ntZ+t7/OZqOI8MTksUytwUtVYbYBvIzb2tOxsaIk4xMhVrLZnRnVxLu",
+	"ckHmZYaL0AlLYup2wTIt5ysQBLnTACiZeInFdNHgXBW8k3h6lfqlp0sgJPKLclGFbD2cdwJs11ai705l",
+	"qFau8IE9DQcofqWIxjyXSdQkg0RtUjJIlMU4Gdhlx+ADECLYsw0gVU0cSG9bPMiMilLLskOA9Nc69LFq",
+	"Oq0ev4YL1dJ18OXGgNhDdjIbpvrEMKIWHNk2COeJUbAgyxUrcLFGmHM6zyFDgJXAgSuhVUHzKV3F3vtI",
+	"3sA+IBeVTq1nc1JVH5QfHzx+vHfw7d6jJxcHT549efrsycHw6eNH/5UM+sg+Lb5OvstKmzTt59kE+Bwv",
+	"rkLKdAbz9hRrIL82ejOZPIKfAR+8xc/KxJvKNhHg9PF8c/L6ePz6RTJwPlcnZ2enZ+px+/THk2P5y3++",
+	"GZ/pV+4AN6UixTityFRsCKcphKfVNOTIxoTJ8No2pnaSrOehAWngewypPQT9wz9g6vhEzxUrV53JcvvK",
+	"3crGcMTKipdz5M22R3rbungMHMSfoLI8uYrI8sbpyjnpWdcG421nqcEbyvXo59KAn8zS4tFf5tPFwSGG",
+	"lfxI1pZvV7F6Tdb34aGye4R52vn6GTaefPPLDcnKp3ePHmePYY6XjF2Xq9bQInXVk7TiXa/EcGhjJBWV",
+	"LnMNSr0B3b7XxTJPDBpzIGyW+UBdvNKYLG08pwCUS2IXPnIvSHRJ0mPGDaUNmgOVYgTkKWVI1tEjlWE0",
+	"BgQzOcLkn6uCgNhsBc+a8Nz3IdXucZd66eHPI5Fgh/uRympxV/x68FQ8nt08/g2mqrj2B4i95ATykbF8",
+	"T74v2jy0/ss4K1DBSuFpc7HHUMlV7SisLKbEWmE9rzJDWH7yl4CgSsH0Y27jo0brGymtqHxS4TGZ1/TV",
+	"A6ZjBKAagMzC488KsH4VF9D8tKGSt0i4uqdR4KdS7sPG5EgKexN5wl4IjBmj23ZnZ9OEX92fqBqgPvWL",
+	"FzlXbYPrTv1cAdSj7ApBRi6AytjRBdaIVW6wYCyzcr9d9oRIrxQrXOnLZIXnpEhLcDpiKz4nOSUhgOdm",
+	"FQGK3kBwzLGXxjGWckN99fJtVN7WVICND5Wm5cQEqsBtmlec3WsTN4L2A+WCFZHwHbiFec3aPSW5aDva",
+	"A4NXSbE3mEL0M3BKORmV/EO96yzUtOgGF1S2aXLVi/viDZIpzqcky5o+G7ekZjc/E4re4eZnmw4cQN7w",
+	"PiAB8g1qI3T7plEFMF9QX93NaNs9FLS6Jv3GCZbhfaEFfxWX/O7cQODEJR3+Zv9vLq5bcBmACMS+fUCB",
+	"qWTTVUfemq92Ir5Sfqrr9rSn3vQT20L6UN0rzmEpPyfTgojmMVU+Xn9obbHiYPeRndFXGZWh2jkavRkr",
+	"2xY8IGHOb1mRfh2dudEMrsZ8g8UiBAoUSywW8lDeLkihXTQVFOYZXdItxFTlFkL5AppLZogA0tG7c3R+",
+	"/gq9wQVeEkEKdC77DPu9ssQ1ALc9HlYj5OrTRj9J5/YbfHP7G2G3jye/PE1COmsQ6GnnpenvZ/SKvOky",
+	"8UTyJvdEYqApxNbUDz+zm8NiMUlvV7NrWsWPys0Q0RiscUKLC6agBptV87WIRcHK+SKswGF8l+UAJqGt",
+	"FAy5M3wowe3Pf86Z+POf0ZoI/dgdeZkyS6apfQepS97DfSU9L3CeZqTYZyuS4xWVmVpbI4aO6mNHbLD9",
+	"0morF9oWK0fVjVCpHFukyI5bzW1I5vjYiph2F1XOV3QhNSHgSwXOU7ZEP55fjo9BwL9hNEUrJkguKAYd",
+	"aZZBxAnoiZJu9/iKTOmMktSNK19aNIU0ZcmVLw8N8mWvADyXUVzToC8pHZ2+evPy5EJahN6OXo6PRxfj",
+	"09c/PR+NX54ce7+B7Wj8enwxHr386ej09fPxi8sz1Xb8+qc3Z6cvzk7Oz6uDnF8enZwcNxmUBIk5R8ra",
+	"cTekMDmxTfp2iaMUfIBkImp3FSlN2zjT9/bLDVLSn+o5mx3eumr+1FMF+2c8zvjastbqj3VDZ0/GJ5Rd",
+	"PRKtq7BeO46DkDtEmKZidP3Y5aN8+aQgN09/Jb89nYTs8pjiec64oNOXLObSjzI2l3y/WKOCZODNo+3Y",
+	"/mFENxbekN9l5IZkcdzKweGzfwzGr5+fJoPk3ejstaJ1ZR6NUe6Sz5sHXqpA4O6NUgCq0ZqwXcXTTlA/",
+	"zrkoyql9e6tiTZKHTlm+XRK0c2+ALlONP1kTBirg3leUCSCMFC2wgtPmCPClrggboTXMN3lsdSkqqllt",
+	"vEEV9CZ0+ovfGTYt7wwNjYpnV2LzXGmLhpIcW1f4sNF+pk/aI4m8Hb8NZXaFOzmCVSGszvocU0N4juUm",
+	"V2JJfcGn4eoJkajMuHreJn/iFS4EncJruC+0cwMRqDvStXTtX7ONyWPalAK3Rucj8XNGudjjnO2B9e/n",
+	"6J2ZsfmWjKnKSiNQ9xelqteOu0B8Kej88uhI/eVe3ppulNgNbi/s+tY1kalHVNsS6RkWBBLrhEiAn5Vd",
+	"yoWCuQAb62KnHIBDq5eJm8aoYFmmionIiN+QVJf47swzPrWHg6lBvFJLrcWSanj2Z6oP5WHZYSVipQoy",
+	"44QHWnqy8Eixt6jZ/yxshgvPaZ/lUzLQQWw4118Dh2evXp7ymQZ7IivobzFJaYnvflDfGmtW/cBu0QwX",
+	"CC8ITl2BuSWpBzuCfdZNr5zCJ15BPrCY6KJXQ3TqtZSe5/mElXkKRSfsCiCIs7LO6JqW+E7t/JNH3zz5",
+	"9kAXi9Q/fXvQixxqaPCJoL7PUVqw+RFDLyOFISh2AtvN2ZLIEzH3Tdo2MaGn8Lc8EDY4FLoGb52GEbYK",
+	"wur7xDHq1l5/P269zwi2/Uap3qIVN20dstNZ4xunR5sSwaaHitJ1F5JppjyzlccCFap9tJBHtd7nZjne",
+	"Gh4yB8nc+Pi0J2XGKmVuzIYby7vmKsTdKwWYrnBjO1S6994BjzXBu0nAF8OdqTVodLcj/P5JlvQ40eon",
+	"fbOXaVS51GW8nKjyWt+vO7F0iw0TrYcgWNDqGLJhDNCPxhHUL/2ZLXIGjbcv2rmLjFcxqchtjychaRir",
+	"RDCol/wMuaIPZoXVG8eMJg7/GXPQ/pEc8wvIVLlp0sj7JVrsl/gwIE0/M+8fmQ+/uHy+ISvpw2+ak9x1",
+	"54Vr9jjzI8e3CZI3YOpxojHiu0utN/AA3iozXB3cjR/PvciFXvzLOeRFw3DUIvk5eN01PAhQF8AtipKA",
+	"HUb76cEBsklYdBFDlz+hqF9nfhjCZgZHs+4YzBHSNvjtaY7g82I+PfwLvZ1/+0iZI7pj8k11TRAlJ2Hu",
+	"j/ACDSLaI9za1L+NV8g0+K/JtlzI/bnFFPAujXe5CrHfEwuyJ0PsTf6ganqRHibKegB8DcIQ9e3B77pR",
+	"c47Jh9FWN3Nn/RLU03szRlWKPrIF91R8pzhv8p6MUKfvsYmtc6QtHNjqHPmHiv2Hiv2Hiv2Hit2lYteS",
+	"SzsG1SCtdSvZJzdR2QxPmygnHnq/KXXKUO+OpJPyQYjmKbmrJmaziTd9WluCP70cdJiEFmg1HXCb888f",
+	"4SVhOd/u+MmuF9vRMaxD5ShpTKOGc/Ec06wsyFkzo6VN4fVTVqQktfQUViaWX7TdQtWsVj2U64lyh6+i",
+	"fGNnM00Orcu0Ye3RZQi2c6pUbvwhTQr2pVCkYFvSo2BbUWMLPwQbYjQVRYVf9dN17h5989s3v04zwtNf",
+	"n/q6zktbAyX6/MpytGC30s14vcnja/TJbwR+orDTDZusX9T8LKXQB7EClaspW3oZMjwgIMVZzIzTmdxz",
+	"ie/ekFwm4fBfgPsAtlLdokjZHp7Cfw9vJSPb0M/0VN3T5pvurCIhhsv13kLj8ly4v04EPNsiu0jbe7KX",
+	"xLYGBUifOgBks/QMbnjeFXwNwNZgqkx2vzjrWIqUOEhnZ5cvT7xUEQYJBVkRDEe12wOwUIKTv/yQr3jU",
+	"0UxCG9fh0IRzlXvsevTmzdmpigp2nPto9Pro5KVyBT4+OXo5fh1w7Sq8zbU6qpJvFFadJSWj+bVx+bQ6",
+	"C+ZSXZQXtEnnEEj0vbM5OC0j/FQul7iIhynzNRdk2c+Sfq7aSkm9yLptbHpoHeUtuzhQLLwhtjUymynD",
+	"3YM1G6f2JPNcPqLZll2+sOpuUc6++/bgkTqUAi9X0gp0eXFkixX4Uf730krqkMaQsKzGSrXdvYeMrX/N",
+	"Zt/dTfA32u0JNJXmAMRRLfyQ5ZGTFD9H9sRUgK5MFz0opmJWJOQwFwXL5BnAAi3wakVyfSBw/RaMuWFR",
+	"LrOgGXeiITqRrNRgGBx4COYqtLhgy01OGuFTnGFBRjNBikZHInnIdUs5oUtp3SuA1+YspVwJQcaRKBm0",
+	"e34NEjfpi5aszPo21AnCq5mZJYQx0De769wIl/0zf+9g2rsVLXrsjZ9GM2OcqIInAIRm+eY+2X4rCrKU",
+	"ikrRDs2ZpQHlAAd9Ul2Ychc08bF+JOWBizDSi8oLnIpbbXH14qZssrWhm7Iw8aBl4+QbnqiFiztufTGr",
+	"RNK2VBuU+1urN+hB12DS2s421T+D6hJfg4a9IDXMDeO1aTZMghrOrx7NJCUZu5SBp3H+pqTeCs8Dl5lO",
+	"o8tj9m3E05vWXOXmoJCFvbK6KcTeOMEFaz50wq0BiQHuew9Es0zQgvA231dJi1Lus7nyTKA85fKFXwXN",
+	"GYd400aSjpKcVMaM+q9b58xQ6eeULNuklajJ7BTUTyHKjdxNuPNwv0rGOTIPTFeJn9BfjQWBgMDDNI8t",
+	"bCa8zdi93t4RJAQjpBf8KnuYErsjr0cNyTO2kIjjkq9PfD4xNRLbuZ26aWkeHWhFTWPZ6RcVL+s6mflC",
+	"3S+0wMkgyWhOcBECe27E92BrLkI/mhq8dEm6q2M0kahX76z1ebPaGjgjFF45DvWAEJ1OQLS+2yrBjxY8",
+	"zWWXMgLypYpyXSNse/YQC5b4rhcwxvoTA8pLskS5OTomJ3f3/OfaS30k3dx7OsPXZq/miXPJU1JnfAWP",
+	"eDj8TL1ZDQzM0lzlO8sDL4CxTOrOUpRF1XIVXwvN++GS5g+Ay9Cx/rhZh7sI/KUi591WmIsIXCpwQ2J7",
+	"wcoCjKQpXttb/ZaQa8eCD54+OziAWkN/kX/IbJ+EXMv24QmTv/b38VHDRGXvPP2BlQ25DCXMBtQUr10V",
+	"b70sIrcCi4HLOjxEl5ygx4f2IiN56o1QiYV4fNijoFIhtgcPuisAaW4ArEDwpJ1W67KIxLkPlUNfjWY0",
+	"QUTIRSpWobCkMg3GLs8ZLbh43ZQpZJt6Wo05RFvmWdGpPNv3eBR3ifse8HnYJGx0SHOgew/GdqnVd+Gw",
+	"zBRsVldynaNFQf1NTKbyh/9F7hQKMjzhQ8qU8SxMpQO90WuJg9yD9lmyEGLFn+3v4xsscMGHcyoW5aTk",
+	"pJiyHJxDp2y5X+4/Onz86PDxwcF/3Pz1UOL2b4wvfGjshO2ZfLaY+C+Hjw+efPtUTSz3w7AZL5njq9PX",
+	"x6N/JIPk4vLkXP317uT4tfn74ofLM/3n87Ox+uN8dHF5pv+8hN7ejpgpIrKMqUERCS497vCZbM6fq7wK",
+	"u/o3uSr2zh1pnBgbU/BuUBoj9FX0XDw3didtTS1MG16Le65aNausmqb1VZ+uNggbvWPkF1p+M6UH36Sl",
+	"JEqIbJ8xHT0vsMoRa84eWy5Zjp5jQbRZ21H/FL7NpO2BslBZ1y6FyFYmlImfkrBwJPecs58lj4YHiqgg",
+	"c43M9jk8GB4kkAN8Aduxj1d0/+aRTnWzp2pEPvuQRB2rXxAhRZ9KQUlI6O7cH4fgGUuUgCOtHslLyoXk",
+	"a06BVj6zSn2GyR4fHDSxc9tuX47jjWH174/+MwXMVsnRL3GE51xu/0meSmmhSN7LPrGV72eQGbMRASRP",
+	"V4xCSjtRFjmsXOUBhQDgjNx4yX0Ver4C1YBCGP6E5kqwBDOiTm+Dphn9OsCaW6lK1gl7plNnycVE03TZ",
+	"/M/rFUF0SIbIUdU+vuUynnyovvIFK7NUytcknzJpS4T2aIKn1zzDfIH2ZGGqJwT9j8eJpOnkWfJrSeD9",
+	"RVOzTquiLlyfB4eTRsO8o0sgxZKCNe6ciFGRIziqAwmzzr9ZEE6WEyA8GT2tykAr4CFuRxdgUvhrgLw+",
+	"y9AwBLeWXtDiWygoxspcIJo2TKYbjNPW8d/Hj4S+AXV+w0x7Pe//ot1+3Hi9xPIg72uYkyRgPKc/ylaH",
+	"B4fdJ/SkKFjRdC5h6noQxgRzCONWEdQ6RqfpbH5Q1S0+trKnVPvAR4wGV/lVfqLZlDJxMKjbpTNOgjd6",
+	"tdaNl70YxwpmwV86miSDGBzBoL6Y3zMl0pIELgSKVdpSmtHonrHNVW/sB0tChLaiSX0RTL18gDD64eLi",
+	"zeHBI1TmuBQQ/E1SROQmqBAIyaKUrazKWyQPfEGq0Tb3Ir6NQrHaiOzRxkS2A9KUZONtQfzCCNgvHHV5",
+	"jbqTXpjoSidzQPXktmPfRez79omrlezDirjVkybDwS4Wliok88zkTclm9nntj/PReD5Gdg/uL7jYsao0",
+	"/Fkovy4sORL6fIdA3uH9pFGAvi6OhoKUHLAmO3YJU89pJkhRJXYZ5eMnBVLK/bDh0ncZ5gLpyHp/2roD",
+	"fYQjkk+L9UqlfrsmuYmmlkb9FZ4buRJUjzhEObkTF7LrNmLIRpK5CqXaQj6HrVJkxmLPycphuMbZ4hte",
+	"r5LrXFe/Z+m6eUmmCSVhtWPzUvkxwNGjnd2WbjaFxdhlafymgQMcbMU3Ht2Pb+iNiF+aZhdbD/W+IFzs",
+	"ubf5+Iaf6Nd43u4sIB8WKq/VA8SZM87qxvo5QUDABpqQGSsIoipaEd/E7gFYSeztN6SmnWx+2/O4Mi08",
+	"mJTW+sLdIrB9FvKTwFbIwe7KZjTYS6EIXx4jRPLJpOk+/OELFaY97v4gQsQgWZWRPYRsCQEH6ZtFIb7d",
+	"asxPdbt8Huo5CFH5PU6RB6amsBq6PVnbI6hqo9dMoOcyt5ls8U1sqnEuSJHjDJ2TQqoCQHI1UlO7cK9b",
+	"yGlZxXRBVUmYB6PO6BX3ChfXvG4WkXqQAigdXuWjfB2GdCjdiPJaBSaVFMHWmGig35Ea/P9dlmWpbntG",
+	"p3FYIb++1Ka5S7Nqc2a1dN3U1j/RhcKdHrLh5fTWTP0Agv6OWELbfVLHxye8Xzbc2/0P+q+PPXZZJ8Kf",
+	"2uXFfZ56bu4fAohHMA4nn4hQBtGBbryt2Z7knJfmvpdPqpW4hJc+38u81khNx3aKdmpq36FglJbdcouy",
+	"gKYuKXfXfW49geAg7nEi2i1GXNd/kHsvf95js720FJSoPFja8QpNiLglJA+KmjcYl+oV2Lfnr8FIbYYU",
+	"u3h1/3MiYjhrt6dwlYVcGm67irpLC/HIxpL+SUCV+GpZeAYFgrDv/VszGud6AwTS+jmXD3jgaINsAT4d",
+	"fw5vlLqyO8JqJjURtgVFpFhUsWEWBBXkF3BrH7ZZhyJV87eU4usjfQpLUQD97i1FMZsP5LSpE92W53T/",
+	"xpSj7zqyrgIiUFxz5LUiwnYyDujOOUTqUWzpR20lkq3DVUP4l44O7ssa3roV74RBuPE2YxPoxgdkq837",
+	"QGoUqC0pKYkXKDiG3xW3aWW+Q3RyR5WrvZfWO2cyuGnWdqzVDNFjHSL6QV65FQS9D0kfCSTE8j1lCOfC",
+	"2WTzAv9mHfYXYPoFES/Ml9ZHlC/9xUKtou3YWAxs+EAhDUjQ11x2R2yeU8HUzbmS1UTpTJueSS7fVyMU",
+	"rcYylay3vJig+6e4jRScX8pjxQ7Ue72VBv+dHFLRyv4H+LfNpmzk8iALsiKZYeOBe0itrnH7Tn+s4UUK",
+	"7NAaHTfK6H3YmsbTPXmZSpUr1q0WfB/TLmDWdK0GGwXYH+tmR7VWm/Oc6EhfAKUDipqQ0Un3puc+X+fT",
+	"5pe8szKvYl02RxbVEKZCljhPGzfgXI6/yVX+WZApoUQG5D74s6Ur290cXLOYe+0b7+vDexG6pMT9vQc/",
+	"y2YEmOu/G/sfzJ9d74MrW+FwrXxAA+7tVU1+MAbu9uT3tgd9Lgu3F/e8L+L7u4+LefP5mxNtM8LF/Bzm",
+	"QqrBxITqy+7uQcZLzd1ICiM54W7IYdMqtSOzii+OVCqnCRdzpNf65dLM/gdczOV/vBzoncZY3bbxJeeN",
+	"hwJIyaCC+U03XUJqimVY7BBdMFSQWUG4SksCPw+gDriqoas//oxAuUIWb8PWi2RUzE9tevNWLY/mph6e",
+	"mx8qcvlQGbz9ydRGb/Sb071iGp/LB96u8vU9EI4o3Wq/AHGswjDhGLhE85/wHMRfMYDcd3WeiGizQ1Qv",
+	"Dm0QxgXRqeXbq4i3U7eeeVvDQaXQYKsBIayYDfbvlcmq3t+y8D2Z05yHRdHN+hUzyZ2Htl/9MmZYqOBi",
+	"ewNDBRcdhoZ2zNZG8s/hPU8U4C6s+t2Ms+RjB9Huf6j8v8PweUZkhllw8qD5ntn8GmEAz0yNiTTLUIoF",
+	"ruTcpQLeX/yrRLVP0apxs5VBMtzsTem+YXcajJ/Ny4QY8rYgBoUMv5hv7S28mbA9+ephF6rdAltWeT9W",
+	"rYlqp3w2JNl9v5bwAwPXxNbGM3RW5pDTo2IW8SyjAyVVg2vTbUG1nFGXlXSWnmriGC5YgedKHIEnIywg",
+	"XT1qmzal3J/XRnymjHD5CCHrfkSYqsbl/QmwPlIbIZq2CKOgLH1vVbeJPOoFuj/Vsa3VOH9wlTksrN7X",
+	"DrrRwn8fPIELIn+X/4xl+vRWLhHLrktWOu+6fO5YOb1AjaJOJWTz0qlzIku2k/dZrJc65UHYVtmQm1et",
+	"La0x/pC6z6EUR4XAZQn1bSSuoA67Of4drsP3v/AuOzbS4z9eQfudcCGjXX7GS8pUG+fNleerAZfOJGhU",
+	"knIFsts7eYmpuEMdnvj44ACd/ojMdkD1BR0dWShXFq8APoQucmUOUH+boJaZ9JwEC2TOV0T5xFRrs6PU",
+	"Vny3Pjg/q7W4Wuk/qxr3DbAeHhw4QBWMbqlTnMsX+glx9fHRVxItOnPNwEOej7XKIHK9NDcc5+vwNJmt",
+	"+DRy3tuKZSPMSeeIvvetW3ip/FssRV54LoZt0L0anUy80u2tTJotqdBmStnMxvWqWXiZCb5FRGMkxXM1",
+	"V7pX9b+xwMXvL+bRIL2BfP7BygK9OLmwMuQmBLL/wZba6OFA7MIHXLrWuHunS7f60BH/3f7Bh5/Lylyp",
+	"A7llmLNXCOU+ElnJ297+nhMxXXjMQLWOSNCX+sPv2iNHLqJh186iaQu29M0xZRrv55qjc7VtaThTa314",
+	"xxyA8l/PL0cjv5OdApXsf1A5qT/2EyJtAusdyI5acsaG5qZZmSrHRulCo5PpL+gqzEEBHeM01pswqpnX",
+	"Ns/dWEuf5uUrrKUCf9DY5CYSPv3xd0e9mhy6qVdadue9XKONHVi39orwmLJkQHoLXapiANaweCeoDTUh",
+	"RFf2UDa1Zfwt5dj13vohxRuj7RUlrUwVv6+jh0+PT3iAjj+ZqhpQ3x9Lsx4p4It5liUFZSqRLDiIe5qM",
+	"RFZGXP4aVsJ9xGYzOlVJpt6ZBh7okDUY3NYH/hcCv5tKH3492tzFejZsab2AbdNF5dC8/XXlxvgUl5YH",
+	"8SeKavB2qjNhoGvK9z+4//R2f3ddug7rEDkSVsX6/WIwHoUAMcLxxVlBcLpWx1gRlmAhZShoapTxiZ3k",
+	"O3HeRw738b+1KN6plQMfMq2GMpUWcSfP86xQicE5WrNSMpYZiO+1cyy/TbHZyXj2qX9ZlZ7Lio0e2epQ",
+	"nAg/m7FigAoMjBkCyhp6mbMif1lykt0Q3uhbooZudy75V7NCyCZoufZtSBtcoa9kDU3cVgMfbjw5Cle1",
+	"M2y1g2rGHqiNoMvb2PEgebspd+VStAmGltV5/XxxNDe+8o4S/JkKMiOqfuAQnUryuaWcmHRw6PDg0Bkx",
+	"Tb4EmwpOPbBrOtO21bA4dkVecK77AwnmhIlFtNy8FLugVCP8DyB39QR0Y12PZdNVXkSmU3uxUFn8vDos",
+	"ppgXFYue4EDCvgmxeQB1cFw9FUUtTR/ItFQnY+Wa4anN1PPUULpW6YPRqFJ1q157U7ZRIqxkb2pVtlWl",
+	"fEl7ej917/vGsK3EIluV8h6OL7WR2sWUVrtV5EbbX2EuGq+1lPJVhtcIuLJNGjJAqj5aOtCOfDfs2sOu",
+	"KpPZfme9wQDj79oatbF1N4p/E6DauQdh0d48NWctrV1+uLACY7bWaoU8pa5UimBoAm5Pkk/boimuYEr7",
+	"9l0aoP/Ywk0t8TbpaP2lzIg4OasU7GP6BjHCCVxBcKGi7ocBkzDV57VW/qsHmFkSkhDApTZZh6rHVzkT",
+	"5BkqTI2yiIhmUtFXpv26MY3qHy8OX8qLQ4yETBab3t4+RkoIPF6sUOd7KfpEqOu1OxkSjgHLgHUVhLOy",
+	"mJKof5CSLB7AMWjj4IkQkL7OQqorqi3ii6QFYOZ9iAAafqLdN9fEA2c2UtN8iSxEE5DBw5dFOUp47Jk+",
+	"bzsQGp9YONEKhgIifA23l5i2kRl9QRly1WOffg7UVg3wbYpQ4xHMsKMbLXhE2e0Dx2ch1CO9BZsrKj41",
+	"kRuSC/5JhK3o44be4BMFxj2lTzXKlxJZZI4EMWv7svgIuRMkTz8LHzkz5gmZvEWQ3JSEVtUMcK68sQLe",
+	"YsvyGgWOFVojg6vQ5tjyR632nTOQswtWzhcuMRHWBZ3QLSuuZxm7RZiHZpZ3piS9G5y6/EUDWxHRlOBU",
+	"Tf3sOrK9jE9JUYYFKZQ1SW0D0bm9lDFJniVyNyVEPR8GhUfDZF8h/zyBce9hdqkM8Cmeorz821+IC8Vn",
+	"4uwK8/fl7IrzfqbzrZ5FghNsdGGsLZDucadqTZ2QoDpJYMnWbrCquzwPtEDs1j1eDHwPX105paPiSXCI",
+	"1ELucYiqA2zlNW6GaHDWUpjenlSoIhV2TTYiFbojUlGFxHwLnDVhS5icnze5oazk2do0S4foBHKUSYMc",
+	"XS5JSrEg2RrFNpFdk3ZJ8ncvDZ5pdOXGRtmXIJTb1pJ0ioDx3D7ONpqx+VyV34sXJ3xBxCuylYQ3KsWi",
+	"6rDYK/1xJPupK1hWt8b1xJPv3tYhMBvbXSM2rMfZZ3Lmeoj80bgFmYMHcggEKCB3vRrWFft8tr+fsSnO",
+	"FoyLZ98dfHeQfHxvQbOlQi2IHwf2N2BLycf3H//vANi5PUyWKQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -39,3 +39,11 @@ func NewHistoryID() string {
 func NewProviderSetupID() string {
 	return newResourceID("pse")
 }
+
+func NewDelegationID() string {
+	return newResourceID("del")
+}
+
+func NewExclusiveRuleSetID() string {
+	return newResourceID("ers")
+}
@@ -30,6 +30,8 @@ export const RequestsFilterMenu: React.FC<{
           ? "Approved only"
           : status === "CANCELLED"
           ? "Cancelled only"
+          : status === "EXPIRED"
+          ? "Expired only"
           : "All"}
       </MenuButton>
       <MenuList>
@@ -51,6 +53,9 @@ export const RequestsFilterMenu: React.FC<{
               case "can":
                 onChange(RequestStatus.CANCELLED);
                 break;
+              case "exp":
+                onChange(RequestStatus.EXPIRED);
+                break;
               default:
                 onChange(undefined);
             }
@@ -61,6 +66,7 @@ export const RequestsFilterMenu: React.FC<{
           <MenuItemOption value="den">Declined only</MenuItemOption>
           <MenuItemOption value="apr">Approved only</MenuItemOption>
           <MenuItemOption value="can">Cancelled only</MenuItemOption>
+          <MenuItemOption value="exp">Expired only</MenuItemOption>
         </MenuOptionGroup>
       </MenuList>
     </Menu>
@@ -16,6 +16,12 @@ import type {
   AccessRuleDetail,
   ErrorResponseResponse,
   CreateAccessRuleRequestBody,
+  TestAccessRulePolicyResponse,
+  TestAccessRulePolicyRequest,
+  ListExclusiveRuleSetsResponseResponse,
+  ExclusiveRuleSet,
+  CreateExclusiveRuleSetRequestBody,
+  ListExclusiveRuleViolationsResponseResponse,
   DeploymentVersionResponseResponse,
   ListRequestsResponseResponse,
   AdminListRequestsParams,
@@ -110,6 +116,22 @@ export const adminCreateAccessRule = (
     }
   
 
+/**
+ * Evaluates an Access Rule policy against a sample request, so that the policy can be tested before it is saved.
+ * @summary Test Access Rule Policy
+ */
+export const adminTestAccessRulePolicy = (
+    testAccessRulePolicyRequest: TestAccessRulePolicyRequest,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<TestAccessRulePolicyResponse>(
+      {url: `/api/v1/admin/access-rules/test-policy`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: testAccessRulePolicyRequest
+    },
+      options);
+    }
+  
+
 /**
  * Get an Access Rule.
  * @summary Get Access Rule
@@ -247,6 +269,116 @@ export const useAdminGetAccessRuleVersion = <TError = ErrorType<ErrorResponseRes
   }
 }
 
+/**
+ * Lists the separation-of-duties constraints between Access Rules.
+ * @summary List exclusive rule sets
+ */
+export const adminListExclusiveRuleSets = (
+    
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ListExclusiveRuleSetsResponseResponse>(
+      {url: `/api/v1/admin/exclusive-rule-sets`, method: 'get'
+    },
+      options);
+    }
+  
+
+export const getAdminListExclusiveRuleSetsKey = () => [`/api/v1/admin/exclusive-rule-sets`];
+
+    
+export type AdminListExclusiveRuleSetsQueryResult = NonNullable<Awaited<ReturnType<typeof adminListExclusiveRuleSets>>>
+export type AdminListExclusiveRuleSetsQueryError = ErrorType<unknown>
+
+export const useAdminListExclusiveRuleSets = <TError = ErrorType<unknown>>(
+  options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof adminListExclusiveRuleSets>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getAdminListExclusiveRuleSetsKey() : null);
+  const swrFn = () => adminListExclusiveRuleSets(requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
+/**
+ * Creates a set of mutually exclusive Access Rules.
+
+A user can't hold grants from more than one of the Access Rules in the set at the same time. Requests which would overlap a grant from a conflicting Access Rule are rejected.
+ * @summary Create an exclusive rule set
+ */
+export const adminCreateExclusiveRuleSet = (
+    createExclusiveRuleSetRequestBody: CreateExclusiveRuleSetRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ExclusiveRuleSet>(
+      {url: `/api/v1/admin/exclusive-rule-sets`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: createExclusiveRuleSetRequestBody
+    },
+      options);
+    }
+  
+
+/**
+ * Lists users who hold active or upcoming grants from mutually exclusive Access Rules at the same time, such as grants approved before the exclusive rule set was created.
+ * @summary List exclusive rule set violations
+ */
+export const adminListExclusiveRuleViolations = (
+    
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ListExclusiveRuleViolationsResponseResponse>(
+      {url: `/api/v1/admin/exclusive-rule-sets/violations`, method: 'get'
+    },
+      options);
+    }
+  
+
+export const getAdminListExclusiveRuleViolationsKey = () => [`/api/v1/admin/exclusive-rule-sets/violations`];
+
+    
+export type AdminListExclusiveRuleViolationsQueryResult = NonNullable<Awaited<ReturnType<typeof adminListExclusiveRuleViolations>>>
+export type AdminListExclusiveRuleViolationsQueryError = ErrorType<unknown>
+
+export const useAdminListExclusiveRuleViolations = <TError = ErrorType<unknown>>(
+  options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof adminListExclusiveRuleViolations>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getAdminListExclusiveRuleViolationsKey() : null);
+  const swrFn = () => adminListExclusiveRuleViolations(requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
+/**
+ * Deletes a separation-of-duties constraint. Existing grants are not affected.
+ * @summary Delete an exclusive rule set
+ */
+export const adminDeleteExclusiveRuleSet = (
+    exclusiveRuleSetId: string,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<void>(
+      {url: `/api/v1/admin/exclusive-rule-sets/${exclusiveRuleSetId}`, method: 'delete'
+    },
+      options);
+    }
+  
+
 /**
  * Returns the version information
  * @summary Get deployment version details
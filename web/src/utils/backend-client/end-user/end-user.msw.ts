@@ -39,6 +39,8 @@ export const getReviewRequestMock = () => ({request: faker.helpers.arrayElement(
 
 export const getCancelRequestMock = () => ({})
 
+export const getExtendRequestMock = () => ({id: faker.random.word(), requestor: faker.random.word(), status: faker.helpers.arrayElement(Object.values(RequestStatus)), reason: faker.helpers.arrayElement([faker.random.word(), undefined]), timing: {durationSeconds: faker.datatype.number({min: undefined, max: undefined}), startTime: faker.helpers.arrayElement([faker.random.word(), undefined])}, requestedAt: faker.random.word(), accessRuleId: faker.random.word(), accessRuleVersion: faker.random.word(), updatedAt: faker.random.word(), grant: faker.helpers.arrayElement([{status: faker.helpers.arrayElement(['PENDING','ACTIVE','ERROR','REVOKED','EXPIRED']), subject: faker.internet.email(), provider: faker.random.word(), start: faker.random.word(), end: faker.random.word()}, undefined]), approvalMethod: faker.helpers.arrayElement([faker.helpers.arrayElement(Object.values(ApprovalMethod)), undefined]), extensionOf: faker.helpers.arrayElement([faker.random.word(), undefined])})
+
 export const getGetAccessInstructionsMock = () => ({instructions: faker.helpers.arrayElement([faker.random.word(), undefined])})
 
 export const getGetAccessTokenMock = () => (faker.random.word())
@@ -106,6 +108,12 @@ ctx.json(getReviewRequestMock()),
           ctx.status(200, 'Mocked status'),
 ctx.json(getCancelRequestMock()),
         )
+      }),rest.post('*/api/v1/requests/:requestId/extend', (_req, res, ctx) => {
+        return res(
+          ctx.delay(1000),
+          ctx.status(200, 'Mocked status'),
+ctx.json(getExtendRequestMock()),
+        )
       }),rest.post('*/api/v1/requests/:requestid/revoke', (_req, res, ctx) => {
         return res(
           ctx.delay(1000),
@@ -17,16 +17,21 @@ import type {
   ListAccessRuleApproversResponseResponse,
   ListRequestsResponseResponse,
   UserListRequestsParams,
-  Request,
+  CreateRequestResponseResponse,
   CreateRequestRequestBody,
+  Request,
   RequestDetail,
   ListRequestEventsResponseResponse,
   ReviewResponseResponse,
   ReviewRequestBody,
   CancelRequest200,
+  ExtendRequestRequestBody,
   AccessToken,
   User,
-  AuthUserResponseResponse
+  AuthUserResponseResponse,
+  ListDelegationsResponseResponse,
+  Delegation,
+  CreateDelegationRequestBody
 } from '.././types'
 import type {
   AccessInstructions
@@ -211,12 +216,14 @@ export const useUserListRequests = <TError = ErrorType<unknown>>(
  * Make a request to access something.
 
 Users must specify an Access Rule when making a request. Users are authorized to make a request if they are in a group that the Access Rule references. Otherwise, a HTTP 404 response will be returned.
+
+Requests can be made on behalf of another user or a group, if both the requesting user and each user that access is requested for are in a group that the Access Rule references. The requesting user must share one of these groups with each user that access is requested for, or be able to approve requests for the Access Rule. Administrators can request access on behalf of any user. A request is created for each user, and all of the created requests are returned.
  * @summary Create a request
  */
 export const userCreateRequest = (
     createRequestRequestBody: CreateRequestRequestBody,
  options?: SecondParameter<typeof customInstance>) => {
-      return customInstance<Request>(
+      return customInstance<CreateRequestResponseResponse>(
       {url: `/api/v1/requests`, method: 'post',
       headers: {'Content-Type': 'application/json', },
       data: createRequestRequestBody
@@ -338,6 +345,25 @@ export const cancelRequest = (
     }
   
 
+/**
+ * Request an extension to the grant of an access request which is pending or active.
+
+Creates an extension request which goes through the same approval workflow as the Access Rule. When the extension is approved, the end time of the existing grant is moved later. The extended grant must not exceed the maximum duration of the Access Rule.
+ * @summary Extend a request
+ */
+export const extendRequest = (
+    requestId: string,
+    extendRequestRequestBody: ExtendRequestRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<Request>(
+      {url: `/api/v1/requests/${requestId}/extend`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: extendRequestRequestBody
+    },
+      options);
+    }
+  
+
 /**
  * Admins and approvers can revoke access previously approved. Effective immediately 
  * @summary Revoke an active request
@@ -512,6 +538,77 @@ export const useGetMe = <TError = ErrorType<void>>(
   }
 }
 
+/**
+ * Lists the delegations which the current user has made, and the delegations which have been made to them.
+ * @summary List delegations
+ */
+export const listDelegations = (
+    
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<ListDelegationsResponseResponse>(
+      {url: `/api/v1/delegations`, method: 'get'
+    },
+      options);
+    }
+  
+
+export const getListDelegationsKey = () => [`/api/v1/delegations`];
+
+    
+export type ListDelegationsQueryResult = NonNullable<Awaited<ReturnType<typeof listDelegations>>>
+export type ListDelegationsQueryError = ErrorType<unknown>
+
+export const useListDelegations = <TError = ErrorType<unknown>>(
+  options?: { swr?:SWRConfiguration<Awaited<ReturnType<typeof listDelegations>>, TError> & { swrKey?: Key, enabled?: boolean }, request?: SecondParameter<typeof customInstance> }
+
+  ) => {
+
+  const {swr: swrOptions, request: requestOptions} = options ?? {}
+
+  const isEnabled = swrOptions?.enabled !== false
+    const swrKey = swrOptions?.swrKey ?? (() => isEnabled ? getListDelegationsKey() : null);
+  const swrFn = () => listDelegations(requestOptions);
+
+  const query = useSwr<Awaited<ReturnType<typeof swrFn>>, TError>(swrKey, swrFn, swrOptions)
+
+  return {
+    swrKey,
+    ...query
+  }
+}
+
+/**
+ * Delegates the current user's reviews to another user for a period of time, for example while they are out of office.
+
+While the delegation is active, the delegate is added as a reviewer on requests which the current user is a reviewer of.
+ * @summary Create a delegation
+ */
+export const createDelegation = (
+    createDelegationRequestBody: CreateDelegationRequestBody,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<Delegation>(
+      {url: `/api/v1/delegations`, method: 'post',
+      headers: {'Content-Type': 'application/json', },
+      data: createDelegationRequestBody
+    },
+      options);
+    }
+  
+
+/**
+ * Deletes a delegation which the current user has made. Delegates remain reviewers on requests they have already been added to.
+ * @summary Delete a delegation
+ */
+export const deleteDelegation = (
+    delegationId: string,
+ options?: SecondParameter<typeof customInstance>) => {
+      return customInstance<void>(
+      {url: `/api/v1/delegations/${delegationId}`, method: 'delete'
+    },
+      options);
+    }
+  
+
 /**
  * Returns an access request.
  * @summary Get a request
@@ -10,6 +10,13 @@ import type { ApproverConfig } from './approverConfig';
 import type { AccessRuleMetadata } from './accessRuleMetadata';
 import type { AccessRuleTargetDetail } from './accessRuleTargetDetail';
 import type { TimeConstraints } from './timeConstraints';
+import type { BreakGlassConfig } from './breakGlassConfig';
+import type { RequestLimits } from './requestLimits';
+import type { ReviewSLA } from './reviewSLA';
+import type { OnCallConfig } from './onCallConfig';
+import type { FormField } from './formField';
+import type { TicketConfig } from './ticketConfig';
+import type { RecurrenceConfig } from './recurrenceConfig';
 
 /**
  * AccessRuleDetail contains detailed information about a rule and is used in administrative apis.
@@ -29,5 +36,15 @@ When a rule is updated, it's ID remains consistent.
   metadata: AccessRuleMetadata;
   target: AccessRuleTargetDetail;
   timeConstraints: TimeConstraints;
+  breakGlass?: BreakGlassConfig;
+  limits?: RequestLimits;
+  reviewSla?: ReviewSLA;
+  /** A CEL expression which decides whether requests are approved automatically, require review, or are denied. */
+  policy?: string;
+  onCall?: OnCallConfig;
+  /** Fields which users fill in when requesting access. */
+  formFields?: FormField[];
+  ticket?: TicketConfig;
+  recurrence?: RecurrenceConfig;
   isCurrent: boolean;
 }
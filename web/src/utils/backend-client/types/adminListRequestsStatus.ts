@@ -15,4 +15,5 @@ export const AdminListRequestsStatus = {
   DECLINED: 'DECLINED',
   CANCELLED: 'CANCELLED',
   PENDING: 'PENDING',
+  EXPIRED: 'EXPIRED',
 } as const;
@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+import type { TimeWindow } from './timeWindow';
+
+/**
+ * Restricts access to the configured windows. A grant must start and end within the allowed windows, evaluated in the configured timezone.
+ */
+export interface AllowedWindows {
+  /** An IANA timezone name, such as Australia/Brisbane. Defaults to UTC. */
+  timezone?: string;
+  windows: TimeWindow[];
+}
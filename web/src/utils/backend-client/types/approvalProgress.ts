@@ -0,0 +1,15 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The number of approvals received for an approval stage which requires more than one approval.
+ */
+export interface ApprovalProgress {
+  approvals: number;
+  required: number;
+}
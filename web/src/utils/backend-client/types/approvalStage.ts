@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * A stage in a multi-stage approval workflow.
+ */
+export interface ApprovalStage {
+  name?: string;
+  /** The user IDs of the approvers for the stage. */
+  users: string[];
+  groups: string[];
+  /** The number of distinct approvals required to complete the stage. Defaults to 1. */
+  minApprovals?: number;
+}
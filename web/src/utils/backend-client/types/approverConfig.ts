@@ -5,6 +5,7 @@
  * Granted Approvals API
  * OpenAPI spec version: 1.0
  */
+import type { ApprovalStage } from './approvalStage';
 
 /**
  * Approver config for access rules
@@ -13,4 +14,10 @@ export interface ApproverConfig {
   /** The user IDs of the approvers for the request. */
   users: string[];
   groups: string[];
+  /** The number of distinct approvals required to approve the request. Ignored if stages are set. Defaults to 1. */
+  minApprovals?: number;
+  /** If true, a single declining review declines the request. If false, the request is declined once the required number of approvals can no longer be reached. Defaults to true. */
+  declineVeto?: boolean;
+  /** Ordered approval stages. When set, users and groups are ignored and each stage must approve the request in turn. */
+  stages?: ApprovalStage[];
 }
@@ -0,0 +1,16 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Break-glass configuration for an Access Rule. Break-glass lets eligible users bypass approval during an incident. Break-glass requests are approved automatically and must be reviewed by an approver afterwards.
+ */
+export interface BreakGlassConfig {
+  enabled: boolean;
+  /** The group IDs whose members may use break-glass access. If empty, any user who can request the Access Rule may use it. */
+  groups?: string[];
+}
@@ -8,6 +8,13 @@
 import type { ApproverConfig } from './approverConfig';
 import type { CreateAccessRuleTarget } from './createAccessRuleTarget';
 import type { TimeConstraints } from './timeConstraints';
+import type { BreakGlassConfig } from './breakGlassConfig';
+import type { RequestLimits } from './requestLimits';
+import type { ReviewSLA } from './reviewSLA';
+import type { OnCallConfig } from './onCallConfig';
+import type { FormField } from './formField';
+import type { TicketConfig } from './ticketConfig';
+import type { RecurrenceConfig } from './recurrenceConfig';
 
 export type CreateAccessRuleRequestBody = {
   /** The group IDs that the access rule applies to. */
@@ -17,4 +24,13 @@ export type CreateAccessRuleRequestBody = {
   description: string;
   target: CreateAccessRuleTarget;
   timeConstraints: TimeConstraints;
+  breakGlass?: BreakGlassConfig;
+  limits?: RequestLimits;
+  reviewSla?: ReviewSLA;
+  /** An optional CEL expression which decides whether requests are approved automatically, require review, or are denied. The expression must evaluate to "approve", "review" or "deny". */
+  policy?: string;
+  onCall?: OnCallConfig;
+  formFields?: FormField[];
+  ticket?: TicketConfig;
+  recurrence?: RecurrenceConfig;
 };
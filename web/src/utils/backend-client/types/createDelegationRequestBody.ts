@@ -0,0 +1,14 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+export type CreateDelegationRequestBody = {
+  /** The ID of the user who will review requests on behalf of the current user. */
+  delegateId: string;
+  startTime: string;
+  endTime: string;
+};
@@ -0,0 +1,14 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+export type CreateExclusiveRuleSetRequestBody = {
+  name: string;
+  description?: string;
+  /** The IDs of the mutually exclusive Access Rules. */
+  ruleIds: string[];
+};
@@ -0,0 +1,16 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Request break-glass access, bypassing approval. The Access Rule must have break-glass enabled.
+ */
+export interface CreateRequestBreakGlass {
+  justification: string;
+  /** The user must acknowledge that break-glass access will be reviewed after the fact. */
+  acknowledged: boolean;
+}
@@ -0,0 +1,12 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The values of the form fields of the Access Rule, keyed by field ID.
+ */
+export interface CreateRequestFormData {[key: string]: unknown}
@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Makes the request on behalf of another user, or of every member of a group. Exactly one of userId or groupId must be provided. Only administrators may make requests on behalf of others.
+ */
+export interface CreateRequestOnBehalfOf {
+  /** The ID of the user to request access for. */
+  userId?: string;
+  /** The ID of the group to request access for. A request is created for each active member of the group. */
+  groupId?: string;
+}
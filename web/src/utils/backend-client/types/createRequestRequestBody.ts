@@ -7,10 +7,20 @@
  */
 import type { RequestTiming } from './requestTiming';
 import type { CreateRequestWith } from './createRequestWith';
+import type { CreateRequestBreakGlass } from './createRequestBreakGlass';
+import type { CreateRequestFormData } from './createRequestFormData';
+import type { CreateRequestOnBehalfOf } from './createRequestOnBehalfOf';
 
 export type CreateRequestRequestBody = {
   accessRuleId: string;
   reason?: string;
   timing: RequestTiming;
   with?: CreateRequestWith;
+  breakGlass?: CreateRequestBreakGlass;
+  formData?: CreateRequestFormData;
+  /** The ID of the ticket which justifies the request, such as a Jira issue key. Required if the Access Rule requires a ticket. */
+  ticketId?: string;
+  onBehalfOf?: CreateRequestOnBehalfOf;
+  /** An RRULE which repeats the request, such as "FREQ=WEEKLY;BYDAY=TU;COUNT=8". Each occurrence starts at the same time of day as the scheduled start time and lasts for the requested duration. FREQ (DAILY or WEEKLY), INTERVAL, BYDAY, COUNT and UNTIL are supported. Requires the Access Rule to allow recurring requests. */
+  recurrence?: string;
 };
@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * A delegation of a user's reviews to another user for a period of time.
+ */
+export interface Delegation {
+  id: string;
+  /** The ID of the user whose reviews are delegated. */
+  delegatorId: string;
+  /** The ID of the user who reviews requests on behalf of the delegator. */
+  delegateId: string;
+  startTime: string;
+  endTime: string;
+  createdAt: string;
+}
@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * A set of mutually exclusive Access Rules. A user can't hold grants from more than one of the Access Rules in the set at the same time.
+ */
+export interface ExclusiveRuleSet {
+  id: string;
+  name: string;
+  description: string;
+  /** The IDs of the mutually exclusive Access Rules. */
+  ruleIds: string[];
+  createdBy: string;
+  createdAt: string;
+}
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * A user holding overlapping grants from mutually exclusive Access Rules.
+ */
+export interface ExclusiveRuleViolation {
+  exclusiveRuleSetId: string;
+  userId: string;
+  /** The IDs of the requests with overlapping grants. */
+  requestIds: string[];
+  /** The IDs of the Access Rules of the requests. */
+  ruleIds: string[];
+}
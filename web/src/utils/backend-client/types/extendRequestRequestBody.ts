@@ -0,0 +1,13 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+export type ExtendRequestRequestBody = {
+  /** The number of seconds to extend the grant by. */
+  extensionDurationSeconds: number;
+  reason?: string;
+};
@@ -0,0 +1,24 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+import type { FormFieldType } from './formFieldType';
+
+/**
+ * A field which users fill in when requesting access to an Access Rule, such as a ticket ID or change type.
+ */
+export interface FormField {
+  /** The key of the field in the form data of requests. */
+  id: string;
+  label: string;
+  description?: string;
+  type: FormFieldType;
+  required?: boolean;
+  /** A regular expression which the whole value of a text field must match. */
+  pattern?: string;
+  /** The allowed values of a select field. */
+  options?: string[];
+}
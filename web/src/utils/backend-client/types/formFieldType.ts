@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The type of value which a form field accepts.
+ */
+export type FormFieldType = typeof FormFieldType[keyof typeof FormFieldType];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const FormFieldType = {
+  text: 'text',
+  select: 'select',
+  number: 'number',
+  boolean: 'boolean',
+} as const;
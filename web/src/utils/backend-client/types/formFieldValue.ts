@@ -0,0 +1,16 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The value of a form field provided by the user when they made the request.
+ */
+export interface FormFieldValue {
+  id: string;
+  label: string;
+  value: string;
+}
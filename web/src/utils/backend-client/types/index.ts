@@ -13,6 +13,7 @@ export * from './accessRuleStatus';
 export * from './accessRuleMetadata';
 export * from './requestAccessRuleTarget';
 export * from './approverConfig';
+export * from './approvalStage';
 export * from './timeConstraints';
 export * from './group';
 export * from './provider';
@@ -29,6 +30,7 @@ export * from './requestArgument';
 export * from './accessToken';
 export * from './withOption';
 export * from './approvalMethod';
+export * from './approvalProgress';
 export * from './createRequestWith';
 export * from './with';
 export * from './providerSetup';
@@ -65,6 +67,7 @@ export * from './identityConfigurationResponseResponse';
 export * from './createUserRequestBody';
 export * from './createAccessRuleRequestBody';
 export * from './listRequestsResponseResponse';
+export * from './createRequestResponseResponse';
 export * from './createProviderSetupRequestBody';
 export * from './createRequestRequestBody';
 export * from './providerSetupStepCompleteRequestBody';
@@ -78,6 +81,7 @@ export * from './userListRequestsUpcomingParams';
 export * from './adminListRequestsParams';
 export * from './adminListAccessRulesStatus';
 export * from './cancelRequest200';
+export * from './extendRequestRequestBody';
 export * from './adminListRequestsStatus';
 export * from './requestDetail';
 export * from './listAccessRulesDetailResponseResponse';
@@ -91,3 +95,37 @@ export * from './userListRequestsPastParams';
 export * from './requestStatus';
 export * from './listGroupsResponseResponse';
 export * from './request';
+export * from './breakGlassConfig';
+export * from './createRequestBreakGlass';
+export * from './requestBreakGlass';
+export * from './allowedWindows';
+export * from './timeWindow';
+export * from './weekday';
+export * from './requestLimits';
+export * from './rateLimit';
+export * from './reviewSLA';
+export * from './policyDecision';
+export * from './policyHistory';
+export * from './testAccessRulePolicyRequest';
+export * from './testAccessRulePolicyRequestWith';
+export * from './testAccessRulePolicyResponse';
+export * from './onCallSource';
+export * from './onCallConfig';
+export * from './delegation';
+export * from './listDelegationsResponseResponse';
+export * from './createDelegationRequestBody';
+export * from './exclusiveRuleSet';
+export * from './exclusiveRuleViolation';
+export * from './listExclusiveRuleSetsResponseResponse';
+export * from './listExclusiveRuleViolationsResponseResponse';
+export * from './createExclusiveRuleSetRequestBody';
+export * from './formFieldType';
+export * from './formField';
+export * from './formFieldValue';
+export * from './createRequestFormData';
+export * from './ticketSystem';
+export * from './ticketConfig';
+export * from './requestTicket';
+export * from './createRequestOnBehalfOf';
+export * from './recurrenceConfig';
+export * from './requestRecurrence';
@@ -0,0 +1,15 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+import type { Delegation } from './delegation';
+
+export type ListDelegationsResponseResponse = {
+  /** Delegations which the current user has made. */
+  delegations: Delegation[];
+  /** Delegations which have been made to the current user. */
+  delegatedToMe: Delegation[];
+};
@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+import type { OnCallSource } from './onCallSource';
+
+/**
+ * Uses an on-call schedule to approve or route requests for an Access Rule. The on-call source must be configured for the deployment.
+ */
+export interface OnCallConfig {
+  source: OnCallSource;
+  /** The ID of the schedule in the on-call source. */
+  scheduleId: string;
+  /** Requests are approved automatically if the requesting user is currently on call for the schedule. */
+  autoApprove?: boolean;
+  /** The users who are currently on call for the schedule are added as reviewers of the request. */
+  routeReview?: boolean;
+}
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The on-call scheduling tool which a schedule belongs to.
+ */
+export type OnCallSource = typeof OnCallSource[keyof typeof OnCallSource];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const OnCallSource = {
+  pagerduty: 'pagerduty',
+  opsgenie: 'opsgenie',
+} as const;
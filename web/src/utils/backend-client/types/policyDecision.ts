@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The decision made by an Access Rule policy.
+ */
+export type PolicyDecision = typeof PolicyDecision[keyof typeof PolicyDecision];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const PolicyDecision = {
+  approve: 'approve',
+  review: 'review',
+  deny: 'deny',
+} as const;
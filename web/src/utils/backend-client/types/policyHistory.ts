@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Counts of a user's recent requests for an Access Rule, which are available to policies as the history variable.
+ */
+export interface PolicyHistory {
+  requests: number;
+  approved: number;
+  declined: number;
+  cancelled: number;
+}
@@ -0,0 +1,15 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Limits the number of requests a user can make for an Access Rule within a rolling window.
+ */
+export interface RateLimit {
+  maxRequests: number;
+  windowSeconds: number;
+}
@@ -0,0 +1,15 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Allows recurring requests for an Access Rule. Recurring requests are reviewed once, and grants are created for each occurrence up to the horizon.
+ */
+export interface RecurrenceConfig {
+  /** How far ahead of the time the request is made occurrences may be scheduled, in seconds. Occurrences of unbounded recurrence rules are created up to the horizon. */
+  maxHorizonSeconds: number;
+}
@@ -9,6 +9,11 @@ import type { RequestStatus } from './requestStatus';
 import type { RequestTiming } from './requestTiming';
 import type { Grant } from './grant';
 import type { ApprovalMethod } from './approvalMethod';
+import type { ApprovalProgress } from './approvalProgress';
+import type { RequestBreakGlass } from './requestBreakGlass';
+import type { FormFieldValue } from './formFieldValue';
+import type { RequestTicket } from './requestTicket';
+import type { RequestRecurrence } from './requestRecurrence';
 
 /**
  * A request to access something made by an end user in Granted.
@@ -25,4 +30,15 @@ export interface Request {
   updatedAt: string;
   grant?: Grant;
   approvalMethod?: ApprovalMethod;
+  approvalProgress?: ApprovalProgress;
+  breakGlass?: RequestBreakGlass;
+  /** If the request is an extension, the ID of the request whose grant it extends. */
+  extensionOf?: string;
+  formData?: FormFieldValue[];
+  ticket?: RequestTicket;
+  /** If the request was made on behalf of the requestor, the ID of the user who made it. */
+  submittedBy?: string;
+  recurrence?: RequestRecurrence;
+  /** If the request is an occurrence of a recurring request, the ID of the recurring request. */
+  recurrenceOf?: string;
 }
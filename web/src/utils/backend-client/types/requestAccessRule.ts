@@ -7,6 +7,10 @@
  */
 import type { RequestAccessRuleTarget } from './requestAccessRuleTarget';
 import type { TimeConstraints } from './timeConstraints';
+import type { BreakGlassConfig } from './breakGlassConfig';
+import type { FormField } from './formField';
+import type { TicketConfig } from './ticketConfig';
+import type { RecurrenceConfig } from './recurrenceConfig';
 
 /**
  * Access Rule contains information for an end user to make a request for access.
@@ -21,5 +25,10 @@ When a rule is updated, it's ID remains consistent.
   description: string;
   target: RequestAccessRuleTarget;
   timeConstraints: TimeConstraints;
+  breakGlass?: BreakGlassConfig;
+  /** Fields which users fill in when requesting access. */
+  formFields?: FormField[];
+  ticket?: TicketConfig;
+  recurrence?: RecurrenceConfig;
   isCurrent: boolean;
 }
@@ -0,0 +1,16 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Details of a break-glass request.
+ */
+export interface RequestBreakGlass {
+  justification: string;
+  /** true if the request is still waiting on an after-the-fact review by an approver. */
+  reviewRequired: boolean;
+}
@@ -10,7 +10,12 @@ import type { RequestTiming } from './requestTiming';
 import type { AccessRule } from './accessRule';
 import type { Grant } from './grant';
 import type { ApprovalMethod } from './approvalMethod';
+import type { ApprovalProgress } from './approvalProgress';
 import type { RequestDetailArguments } from './requestDetailArguments';
+import type { RequestBreakGlass } from './requestBreakGlass';
+import type { FormFieldValue } from './formFieldValue';
+import type { RequestTicket } from './requestTicket';
+import type { RequestRecurrence } from './requestRecurrence';
 
 /**
  * A request to access something made by an end user in Granted.
@@ -28,5 +33,16 @@ export interface RequestDetail {
   /** true if the requesting user is a reviewer of this request. */
   canReview: boolean;
   approvalMethod?: ApprovalMethod;
+  approvalProgress?: ApprovalProgress;
   arguments: RequestDetailArguments;
+  breakGlass?: RequestBreakGlass;
+  /** If the request is an extension, the ID of the request whose grant it extends. */
+  extensionOf?: string;
+  formData?: FormFieldValue[];
+  ticket?: RequestTicket;
+  /** If the request was made on behalf of the requestor, the ID of the user who made it. */
+  submittedBy?: string;
+  recurrence?: RequestRecurrence;
+  /** If the request is an occurrence of a recurring request, the ID of the recurring request. */
+  recurrenceOf?: string;
 }
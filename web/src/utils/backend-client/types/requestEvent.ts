@@ -29,4 +29,8 @@ export interface RequestEvent {
   grantFailureReason?: string;
   /** An event which was recorded relating to the grant. */
   recordedEvent?: RequestEventRecordedEvent;
+  /** The index of the approval stage the request moved from. */
+  fromApprovalStage?: number;
+  /** The index of the approval stage the request moved to. */
+  toApprovalStage?: number;
 }
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+import type { RateLimit } from './rateLimit';
+
+/**
+ * Limits on how many requests a user can make for an Access Rule.
+ */
+export interface RequestLimits {
+  /** The maximum number of pending requests a user can have for the Access Rule. */
+  maxPendingRequests?: number;
+  /** The maximum number of active or upcoming grants a user can have for the Access Rule. */
+  maxActiveGrants?: number;
+  rateLimit?: RateLimit;
+}
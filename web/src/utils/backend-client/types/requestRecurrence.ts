@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The recurrence of a recurring request.
+ */
+export interface RequestRecurrence {
+  /** The RRULE which the request repeats on. */
+  rule: string;
+  /** The start times of each occurrence. */
+  occurrences: string[];
+  /** The IDs of the requests created for each occurrence once the recurring request is approved. */
+  occurrenceRequestIds?: string[];
+}
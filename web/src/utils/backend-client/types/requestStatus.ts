@@ -19,4 +19,5 @@ export const RequestStatus = {
   PENDING: 'PENDING',
   CANCELLED: 'CANCELLED',
   DECLINED: 'DECLINED',
+  EXPIRED: 'EXPIRED',
 } as const;
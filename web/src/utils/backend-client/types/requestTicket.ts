@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+import type { TicketSystem } from './ticketSystem';
+
+/**
+ * The ticket linked to a request, as it was when the request was made.
+ */
+export interface RequestTicket {
+  system: TicketSystem;
+  id: string;
+  url: string;
+  summary: string;
+  status: string;
+}
@@ -0,0 +1,23 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * Controls what happens when a pending request for an Access Rule isn't reviewed. Each duration is measured from when the request was made.
+ */
+export interface ReviewSLA {
+  /** Reviewers are reminded about the request after this many seconds. */
+  reminderAfterSeconds?: number;
+  /** The escalation approvers are added as reviewers of the request after this many seconds. */
+  escalateAfterSeconds?: number;
+  /** The user IDs of the escalation approvers. */
+  escalationUsers?: string[];
+  /** The IDs of groups whose members are escalation approvers. */
+  escalationGroups?: string[];
+  /** The request is closed with the EXPIRED status after this many seconds. */
+  expireAfterSeconds?: number;
+}
@@ -0,0 +1,24 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+import type { TestAccessRulePolicyRequestWith } from './testAccessRulePolicyRequestWith';
+import type { RequestTiming } from './requestTiming';
+import type { PolicyHistory } from './policyHistory';
+
+/**
+ * A sample request to evaluate an Access Rule policy against.
+ */
+export interface TestAccessRulePolicyRequest {
+  /** The CEL expression to evaluate. */
+  policy: string;
+  /** The ID of the user making the sample request. */
+  userId: string;
+  /** The selected arguments of the sample request. */
+  with?: TestAccessRulePolicyRequestWith;
+  timing: RequestTiming;
+  history?: PolicyHistory;
+}
@@ -0,0 +1,12 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The selected arguments of the sample request.
+ */
+export type TestAccessRulePolicyRequestWith = {[key: string]: string};
@@ -0,0 +1,12 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+import type { PolicyDecision } from './policyDecision';
+
+export interface TestAccessRulePolicyResponse {
+  decision: PolicyDecision;
+}
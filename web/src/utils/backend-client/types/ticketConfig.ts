@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+import type { TicketSystem } from './ticketSystem';
+
+/**
+ * Requires requests for an Access Rule to link a ticket, which is verified against a ticketing system. The ticketing system must be configured for the deployment.
+ */
+export interface TicketConfig {
+  system: TicketSystem;
+  /** The ticket must be in one of these statuses, such as "In Progress". If empty, tickets in any status are allowed. */
+  allowedStatuses?: string[];
+  /** The ticket must be assigned to the requesting user. */
+  requireAssignee?: boolean;
+}
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+
+/**
+ * The ticketing system which tickets linked to requests are verified against.
+ */
+export type TicketSystem = typeof TicketSystem[keyof typeof TicketSystem];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const TicketSystem = {
+  jira: 'jira',
+  linear: 'linear',
+} as const;
@@ -5,6 +5,7 @@
  * Granted Approvals API
  * OpenAPI spec version: 1.0
  */
+import type { AllowedWindows } from './allowedWindows';
 
 /**
  * Time configuration for an Access Rule.
@@ -12,4 +13,11 @@
 export interface TimeConstraints {
   /** The maximum duration in seconds the access is allowed for. */
   maxDurationSeconds: number;
+  /** The minimum duration in seconds the access is allowed for. */
+  minDurationSeconds?: number;
+  /** The duration in seconds used when a request doesn't specify a duration. */
+  defaultDurationSeconds?: number;
+  /** How far ahead in seconds the start time of a scheduled request may be. If omitted, access can be scheduled any time in the future. */
+  maxScheduleAheadSeconds?: number;
+  allowedWindows?: AllowedWindows;
 }
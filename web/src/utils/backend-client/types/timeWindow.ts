@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v6.9.6 🍺
+ * Do not edit manually.
+ * Approvals
+ * Granted Approvals API
+ * OpenAPI spec version: 1.0
+ */
+import type { Weekday } from './weekday';
+
+/**
+ * A window of hours on days of the week, such as 09:00 to 17:00 on weekdays.
+ */
+export interface TimeWindow {
+  days: Weekday[];
+  /** The hour of the day that the window starts at, inclusive. */
+  startHour: number;
+  /** The hour of the day that the window ends at, exclusive. Use 24 for the end of the day. */
+  endHour: number;
+}
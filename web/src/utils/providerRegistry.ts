@@ -26,6 +26,11 @@ export const registeredProviders: RegisteredProvider[] = [
     shortType: "azure-ad",
     name: "Azure AD Groups",
   },
+  {
+    type: "commonfate/github-teams",
+    shortType: "github-teams",
+    name: "GitHub Teams",
+  },
   {
     type: "commonfate/aws-eks-roles-sso",
     shortType: "aws-eks-roles-sso",
@@ -38,6 +43,21 @@ export const registeredProviders: RegisteredProvider[] = [
     name: "ECS Exec (with AWS SSO)",
     alpha: true,
   },
+  {
+    type: "commonfate/postgres",
+    shortType: "postgres",
+    name: "PostgreSQL",
+  },
+  {
+    type: "commonfate/ssh-certificate",
+    shortType: "ssh-certificate",
+    name: "SSH Certificates",
+  },
+  {
+    type: "commonfate/webhook",
+    shortType: "webhook",
+    name: "Webhook",
+  },
   {
     type: "commonfate/testvault",
     shortType: "testvault",